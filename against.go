@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// checkAgainst 基準のgitのrefを一時的なworktreeに展開し、同じ設定・同じディレクトリをチェックする
+// 現在のツリーと比較するため、重要度フィルター適用後のレポートを返す
+func checkAgainst(ctx context.Context, cfg *rules.Config, targetDir, ref string) (*report.Report, error) {
+	top, err := gitOutput(targetDir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	rel, err := filepath.Rel(top, targetDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.MkdirTemp("", "go-standards-against-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	worktree := filepath.Join(tmp, "tree")
+	if _, err := gitOutput(top, "worktree", "add", "--detach", worktree, ref); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _, err := gitOutput(top, "worktree", "remove", "--force", worktree); err != nil {
+			fprintf(os.Stderr, "Warning: 一時的なworktreeの削除に失敗しました: %v\n", err)
+		}
+	}()
+
+	// 基準側はキャッシュを使わない（worktreeは毎回作り直すため）
+	rep, err := checker.New(cfg).Run(ctx, filepath.Join(worktree, rel))
+	if err != nil {
+		return nil, err
+	}
+	return rep.Filter(rules.ParseSeverity(cfg.Settings.MinSeverity)), nil
+}
+
+// printRegressionGate 基準と現在の重み付き違反数を比較して出力し、増えていればtrueを返す
+func printRegressionGate(w io.Writer, ref string, base, current *report.Report) bool {
+	counts := func(r *report.Report) string {
+		return fmt.Sprintf("🔴 %d / 🟡 %d / 🔵 %d (weighted %d)",
+			r.Summary.BySeverity[string(rules.SeverityError)],
+			r.Summary.BySeverity[string(rules.SeverityWarning)],
+			r.Summary.BySeverity[string(rules.SeverityInfo)],
+			r.WeightedTotal())
+	}
+	fmt.Fprintf(w, "\n📏 Regression gate (against %s)\n", ref)
+	fmt.Fprintf(w, "   base:    %s\n", counts(base))
+	fmt.Fprintf(w, "   current: %s\n", counts(current))
+
+	before, after := base.WeightedTotal(), current.WeightedTotal()
+	if after > before {
+		fprintf(w, "❌ 違反が増えました（weighted %d → %d, %+d）\n", before, after, after-before)
+		return true
+	}
+	fprintf(w, "✅ 違反は増えていません（weighted %d → %d, %+d）\n", before, after, after-before)
+	return false
+}
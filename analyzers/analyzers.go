@@ -0,0 +1,267 @@
+// Package analyzers Go Standards Checkerのルールを golang.org/x/tools/go/analysis のAnalyzerとして提供する
+//
+// go vet -vettool・golangci-lint・multichecker等、analysisのドライバーからルールを実行する場合に使用する。
+// チェッカーはパッケージごとに1回だけ、ドライバーが解析したAST・型情報（pass.Files・pass.TypesInfo）で実行し（Analyzer）、
+// カテゴリごとのAnalyzerがそのカテゴリの違反を pass.Report で報告する
+//
+//	func main() {
+//		unitchecker.Main(analyzers.All()...)
+//	}
+package analyzers
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// Result Analyzerの結果（パッケージのファイルに報告された違反）
+type Result struct {
+	Violations []report.Violation
+}
+
+// Analyzer チェッカーをパッケージごとに実行し、違反を結果として返す（自身は報告しない）
+//
+// フラグ:
+//
+//	-config    設定ファイルのパス（省略時はパッケージのディレクトリからモジュールのルートまで go-standards.yaml を探す）
+//	-severity  報告する最小重要度（error, warning, info。省略時は設定の settings.min_severity）
+var Analyzer = &analysis.Analyzer{
+	Name:       "standards",
+	Doc:        "run the Go Standards Checker on the package and collect its violations",
+	Run:        run,
+	ResultType: reflect.TypeOf((*Result)(nil)),
+}
+
+// Categories 組み込みルールのカテゴリ（それぞれ同じ名前のAnalyzerで報告する）
+var Categories = []string{
+	"naming",
+	"structure",
+	"error_handling",
+	"logging",
+	"performance",
+	"context",
+	"concurrency",
+	"security",
+	"struct_tags",
+	"architecture",
+	"aws_lambda",
+	"testing",
+	"grpc",
+	"comments",
+	"documentation",
+	"interfaces",
+}
+
+// Custom カスタムルール・プロジェクト固有ルール・プラグイン・抑制コメント等、Categories以外の違反を報告するAnalyzer
+var Custom = newCategoryAnalyzer("custom", "report violations of custom rules, project rules, plugins and other categories")
+
+// byCategory カテゴリ→そのカテゴリの違反を報告するAnalyzer
+var byCategory = func() map[string]*analysis.Analyzer {
+	m := make(map[string]*analysis.Analyzer, len(Categories))
+	for _, category := range Categories {
+		m[category] = newCategoryAnalyzer(category, "report violations of the "+category+" rules of the Go Standards Checker")
+	}
+	return m
+}()
+
+// For カテゴリの違反を報告するAnalyzer（Categories以外の場合はCustom）
+func For(category string) *analysis.Analyzer {
+	if a, ok := byCategory[category]; ok {
+		return a
+	}
+	return Custom
+}
+
+// analyzerName 違反のカテゴリを報告するAnalyzerの名前
+func analyzerName(category string) string {
+	for _, c := range Categories {
+		if c == category {
+			return c
+		}
+	}
+	return "custom"
+}
+
+// All Analyzerとすべてのカテゴリのアナライザー（Categoriesの順、最後にCustom）
+// Analyzerのフラグをドライバーに登録するため、Analyzer自体も含める（-standards.config 等）
+func All() []*analysis.Analyzer {
+	all := make([]*analysis.Analyzer, 0, len(Categories)+2)
+	all = append(all, Analyzer)
+	for _, category := range Categories {
+		all = append(all, byCategory[category])
+	}
+	return append(all, Custom)
+}
+
+// options Analyzerのフラグの値
+var options struct {
+	config   string
+	severity string
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&options.config, "config", "", "設定ファイルのパス（デフォルト: パッケージから上位のディレクトリに向かって go-standards.yaml を探す）")
+	Analyzer.Flags.StringVar(&options.severity, "severity", "", "最小重要度フィルター (error, warning, info)")
+}
+
+// newCategoryAnalyzer Analyzerの結果のうちカテゴリの違反を報告するAnalyzer
+func newCategoryAnalyzer(category, doc string) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:     category,
+		Doc:      doc,
+		Requires: []*analysis.Analyzer{Analyzer},
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, v := range pass.ResultOf[Analyzer].(*Result).Violations {
+				if analyzerName(v.Category) != category {
+					continue
+				}
+				if pos := violationPos(pass, v); pos.IsValid() {
+					pass.Report(analysis.Diagnostic{
+						Pos:      pos,
+						Category: v.Rule,
+						Message:  fmt.Sprintf("[%s] %s", v.Rule, v.Message),
+					})
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+// run ドライバーが解析したパッケージのファイルをチェックし、パッケージのファイルに報告された違反を返す
+// ディレクトリ構成等、パッケージのファイル以外に報告された違反は含めない
+func run(pass *analysis.Pass) (any, error) {
+	if len(pass.Files) == 0 {
+		return &Result{}, nil
+	}
+	readFile := pass.ReadFile
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+	srcs := make(map[string][]byte, len(pass.Files))
+	for _, f := range pass.Files {
+		path := pass.Fset.Position(f.Package).Filename
+		src, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		srcs[path] = src
+	}
+
+	dir := filepath.Dir(pass.Fset.Position(pass.Files[0].Package).Filename)
+	root := findUp(dir, "go.mod")
+	if root == "" {
+		root = dir
+	}
+	cfg, err := loadConfig(options.config, dir, root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	minSeverity := options.severity
+	if minSeverity == "" {
+		minSeverity = cfg.Settings.MinSeverity
+	}
+
+	logger := log.New(os.Stderr, "", 0)
+	pkg := checker.WithPackage(pass.Fset, pass.Files, srcs, pass.TypesInfo)
+	rep, err := checker.New(cfg, pkg, checker.WithLogger(logger)).Run(context.Background(), root)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{}
+	for _, v := range rep.Filter(rules.ParseSeverity(minSeverity)).Violations {
+		if _, ok := srcs[v.File]; ok {
+			result.Violations = append(result.Violations, v)
+		}
+	}
+	return result, nil
+}
+
+// violationPos 違反の行・列の位置（ファイルの範囲外の行は行の先頭、行の無い違反はpackage句）
+func violationPos(pass *analysis.Pass, v report.Violation) token.Pos {
+	var file *ast.File
+	for _, f := range pass.Files {
+		if pass.Fset.Position(f.Package).Filename == v.File {
+			file = f
+			break
+		}
+	}
+	if file == nil {
+		return token.NoPos
+	}
+	tf := pass.Fset.File(file.Package)
+	if v.Line < 1 || v.Line > tf.LineCount() {
+		return file.Package
+	}
+	pos := tf.LineStart(v.Line)
+	if v.Column > 1 {
+		end := tf.Base() + tf.Size()
+		if v.Line < tf.LineCount() {
+			end = int(tf.LineStart(v.Line + 1))
+		}
+		pos = token.Pos(min(int(pos)+v.Column-1, end-1))
+	}
+	return pos
+}
+
+// configs 読み込んだ設定ファイルのキャッシュ（パス→設定。同じプロセスで複数のパッケージを解析する場合）
+var configs sync.Map
+
+// loadConfig 設定ファイルを読み込む（未指定の場合はパッケージのディレクトリからモジュールのルートまで探す）
+func loadConfig(configPath, dir, root string) (*rules.Config, error) {
+	if configPath == "" {
+		configPath = findConfig(dir, root)
+	}
+	if configPath == "" {
+		return rules.DefaultConfig(), nil
+	}
+	if cfg, ok := configs.Load(configPath); ok {
+		return cfg.(*rules.Config), nil
+	}
+	cfg, err := rules.LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	configs.Store(configPath, cfg)
+	return cfg, nil
+}
+
+// findConfig dirからrootまで上位のディレクトリに向かって設定ファイルを探す（無ければ空）
+func findConfig(dir, root string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		for _, name := range []string{"go-standards.yaml", "go-standards.yml", ".go-standards.yaml", ".go-standards.yml"} {
+			path := filepath.Join(d, name)
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+		if d == root || d == filepath.Dir(d) {
+			return ""
+		}
+	}
+}
+
+// findUp dirから上位のディレクトリに向かってnameを探し、見つかったディレクトリを返す（無ければ空）
+func findUp(dir, name string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, name)); err == nil {
+			return d
+		}
+		if d == filepath.Dir(d) {
+			return ""
+		}
+	}
+}
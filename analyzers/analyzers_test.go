@@ -0,0 +1,102 @@
+package analyzers
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestCategoryAnalyzers(t *testing.T) {
+	dir, cleanup, err := analysistest.WriteFiles(map[string]string{
+		"bad_pkg/a.go": "package bad_pkg // want `\\[package_name\\]`\n",
+		"good/a.go":    "package good\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	tests := []struct {
+		name     string
+		analyzer *analysis.Analyzer
+		pkg      string
+	}{
+		{name: "reports its category", analyzer: For("naming"), pkg: "bad_pkg"},
+		{name: "no violations", analyzer: For("naming"), pkg: "good"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysistest.Run(t, dir, tt.analyzer, tt.pkg)
+		})
+	}
+}
+
+// TestOtherCategoryNotReported 他のカテゴリのAnalyzerは違反を報告しない（want の無いファイルで報告があれば失敗する）
+func TestOtherCategoryNotReported(t *testing.T) {
+	dir, cleanup, err := analysistest.WriteFiles(map[string]string{
+		"bad_pkg/a.go": "package bad_pkg\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	analysistest.Run(t, dir, For("structure"), "bad_pkg")
+	analysistest.Run(t, dir, Custom, "bad_pkg")
+}
+
+func TestAnalyzerName(t *testing.T) {
+	tests := []struct {
+		category string
+		want     string
+	}{
+		{"naming", "naming"},
+		{"struct_tags", "struct_tags"},
+		{"custom", "custom"},
+		{"plugin", "custom"},
+		{"suppression", "custom"},
+		{"directory", "custom"},
+	}
+	for _, tt := range tests {
+		if got := analyzerName(tt.category); got != tt.want {
+			t.Errorf("analyzerName(%q) = %q, want %q", tt.category, got, tt.want)
+		}
+		if got := For(tt.category).Name; got != tt.want {
+			t.Errorf("For(%q).Name = %q, want %q", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestAllValid(t *testing.T) {
+	if err := analysis.Validate(All()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestUsesPassTypesInfo ドライバーの型情報で型を必要とするルールを実行する（設定で typed を有効にしなくても報告する）
+func TestUsesPassTypesInfo(t *testing.T) {
+	dir, cleanup, err := analysistest.WriteFiles(map[string]string{
+		"locks/go-standards.yaml": "concurrency:\n  enabled: true\n  rules:\n    lock_copy:\n      enabled: true\n      severity: \"error\"\n",
+		"locks/a.go": `package locks
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *counter) get() int { return c.n }
+
+func copyCounter(c *counter) int {
+	copied := *c // want ` + "`\\[lock_copy\\]`" + `
+	return copied.n
+}
+`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	analysistest.Run(t, dir, For("concurrency"), "locks")
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// TODO/FIXMEのバックログ
+// ========================================
+
+// setTodoAges git blameでバックログの各項目の追加日・経過日数を求める
+// ターゲットディレクトリがgitで管理されていない場合は何もしない
+func setTodoAges(rep *report.Report, targetDir string, now time.Time) {
+	root, err := gitOutput(targetDir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return
+	}
+	// gitはシンボリックリンクを解決したパスを返すため、ターゲットディレクトリのリポジトリ内の位置から求める
+	prefix, err := gitOutput(targetDir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return
+	}
+
+	blamed := make(map[string]map[int]blameLine)
+	rep.SetTodoAges(func(item report.TodoItem) (time.Time, bool) {
+		rel, err := filepath.Rel(targetDir, item.File)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return time.Time{}, false
+		}
+		rel = prefix + filepath.ToSlash(rel)
+		lines, ok := blamed[rel]
+		if !ok {
+			lines = gitBlame(root, rel)
+			blamed[rel] = lines
+		}
+		line := lines[item.Line]
+		return line.time, !line.time.IsZero()
+	}, now)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-standards-checker/report"
+)
+
+// runWriteBaseline すべての違反をベースラインとして書き込む（-baseline write <file>）
+// 中断した場合は一部のファイルの違反しか無いため書き込まない
+func runWriteBaseline(rep *report.Report, path string, interrupted bool, status io.Writer) int {
+	if interrupted {
+		fprintln(os.Stderr, "Error: 中断したためベースラインを書き込みませんでした")
+		return 130
+	}
+	b := rep.Baseline()
+	if err := report.WriteBaseline(path, b); err != nil {
+		fprintf(os.Stderr, "Error: ベースラインの書き込みに失敗しました: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(status, "📎 Baseline written: %s (%d violations)\n", path, len(b.Violations))
+	return 0
+}
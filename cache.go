@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-standards-checker/pkg/checker"
+)
+
+// cacheOptions 解析結果のキャッシュディレクトリのオプション（-no-cache の場合は無し）
+//
+// dirが空の場合はユーザーのキャッシュディレクトリ（~/.cache/go-standards-checker 等）を使う。
+// clearCacheがtrueの場合はチェック前にキャッシュディレクトリ内のエントリを削除する
+func cacheOptions(dir string, noCache, clearCache bool, status io.Writer) ([]checker.Option, error) {
+	if dir == "" {
+		var err error
+		if dir, err = checker.DefaultCacheDir(); err != nil {
+			if noCache {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("キャッシュディレクトリを決定できません（settings.cache_dir または -cache-dir を指定してください）: %w", err)
+		}
+	}
+	if clearCache {
+		if err := checker.ClearCache(dir); err != nil {
+			return nil, fmt.Errorf("キャッシュの削除に失敗しました: %w", err)
+		}
+		fmt.Fprintf(status, "🧹 Cleared cache: %s\n", dir)
+	}
+	if noCache {
+		return nil, nil
+	}
+	return []checker.Option{checker.WithCacheDir(dir)}, nil
+}
@@ -0,0 +1,141 @@
+package checker
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 略語チェック（acronyms）
+// ========================================
+
+// checkAcronyms ファイルで宣言した識別子の略語が大文字で記述されているか（HttpClient → HTTPClient）
+// 宣言のみを対象とし、外部パッケージの識別子の使用は報告しない
+func (c *Checker) checkAcronyms(file *ast.File, filePath string) {
+	acronyms := make(map[string]bool, len(c.config.Naming.Rules.Acronyms.Words))
+	for _, word := range c.config.Naming.Rules.Acronyms.Words {
+		acronyms[strings.ToUpper(word)] = true
+	}
+	if len(acronyms) == 0 {
+		return
+	}
+	for _, ident := range declaredIdents(file) {
+		if fixed, words := fixAcronyms(ident.Name, acronyms); len(words) > 0 {
+			c.reportAcronym(ident, fixed, words, filePath)
+		}
+	}
+}
+
+// declaredIdents ファイルで宣言した識別子（関数・型・定数・変数・引数・フィールド・メソッド）
+func declaredIdents(file *ast.File) []*ast.Ident {
+	var idents []*ast.Ident
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			idents = append(idents, n.Name)
+		case *ast.TypeSpec:
+			idents = append(idents, n.Name)
+		case *ast.ValueSpec:
+			idents = append(idents, n.Names...)
+		case *ast.Field:
+			idents = append(idents, n.Names...)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				idents = append(idents, definedIdents(n)...)
+			}
+		}
+		return true
+	})
+	return idents
+}
+
+// definedIdents := で新たに宣言した識別子（再代入される既存の変数は除く）
+func definedIdents(as *ast.AssignStmt) []*ast.Ident {
+	var idents []*ast.Ident
+	for _, lhs := range as.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+		if ident.Obj == nil || ident.Obj.Pos() == ident.Pos() {
+			idents = append(idents, ident)
+		}
+	}
+	return idents
+}
+
+// fixAcronyms 識別子の略語を大文字にした名前と、修正した単語（Http・Idsなど）
+// 単語は小文字→大文字の境界で区切り、先頭のみ大文字の単語が略語の場合に大文字にする（先頭の小文字の単語 http・id はそのまま）
+// 複数形の s は小文字のまま残す（Ids → IDs）
+func fixAcronyms(name string, acronyms map[string]bool) (string, []string) {
+	var b strings.Builder
+	var words []string
+	for _, word := range camelWords(name) {
+		fixed := word
+		if isTitleWord(word) {
+			upper := strings.ToUpper(word)
+			switch {
+			case acronyms[upper]:
+				fixed = upper
+			case strings.HasSuffix(word, "s") && acronyms[upper[:len(upper)-1]]:
+				fixed = upper[:len(upper)-1] + "s"
+			}
+		}
+		if fixed != word {
+			words = append(words, word)
+		}
+		b.WriteString(fixed)
+	}
+	return b.String(), words
+}
+
+// camelWords 識別子を小文字（数字）→大文字の境界で区切る（HTTPServer は1語、userIdList は user・Id・List）
+func camelWords(name string) []string {
+	var words []string
+	start := 0
+	runes := []rune(name)
+	for i := 1; i < len(runes); i++ {
+		prev := runes[i-1]
+		if unicode.IsUpper(runes[i]) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
+
+// isTitleWord 先頭のみ大文字の単語か（Http・Id）
+func isTitleWord(word string) bool {
+	runes := []rune(word)
+	if len(runes) < 2 || !unicode.IsUpper(runes[0]) {
+		return false
+	}
+	for _, r := range runes[1:] {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// reportAcronym 略語の違反を識別子の位置に報告する
+func (c *Checker) reportAcronym(ident *ast.Ident, fixed string, words []string, filePath string) {
+	rule := c.config.Naming.Rules.Acronyms
+	pos := c.fset.Position(ident.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "acronyms",
+		Category:   "naming",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    "'" + ident.Name + "' の略語（" + strings.Join(words, ", ") + "）は大文字で記述してください",
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "'" + fixed + "' に名前を変更してください",
+	})
+}
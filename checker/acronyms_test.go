@@ -0,0 +1,39 @@
+package checker
+
+import "testing"
+
+func TestAcronyms(t *testing.T) {
+	const config = `
+naming:
+  enabled: true
+  rules:
+    acronyms:
+      enabled: true
+      words: ["ID", "URL", "HTTP"]
+      severity: "info"
+`
+	runRuleTests(t, config, "acronyms", []ruleTest{
+		{
+			name: "mixed case acronyms",
+			files: map[string]string{"a.go": `package p
+
+type UserId string
+
+func fetchUrl(httpClient any, baseUrl string) {}
+`},
+			want: 3,
+		},
+		{
+			name: "acronyms kept upper case",
+			files: map[string]string{"a.go": `package p
+
+type UserID string
+
+func fetchURL(httpClient any, baseURL string) {}
+
+var identity, urls string
+`},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// アーキテクチャチェック
+// ========================================
+
+// clockFuncs 現在時刻に依存するtimeパッケージの関数
+var clockFuncs = []string{"Now", "Since", "Until"}
+
+// checkClockInjection サービス・ドメイン層（packagesに一致するパッケージ）でtime.Now等を直接呼び出していないか
+// 現在時刻に依存する処理は、時計のインタフェースを注入しないとテストで時刻を固定できない
+func (c *Checker) checkClockInjection(call *ast.CallExpr, callStr, filePath string) {
+	timePkg := importName(c.file, "time")
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if timePkg == "" || !ok || !isSelector(sel, timePkg, sel.Sel.Name) || !containsString(clockFuncs, sel.Sel.Name) {
+		return
+	}
+	rule := c.config.Architecture.Rules.ClockInjection
+	if !c.isAllowedIn(rule.Packages, filePath) || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "clock_injection",
+		Category:   "architecture",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("%s()を直接呼び出しています", callStr),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "Now() time.Time を持つ時計のインタフェースを構造体に注入し、テストでは固定の時刻を返す実装に差し替えてください",
+	})
+}
+
+// checkLayerDependencies ファイルのimportがレイヤー間の依存方向（can_import・cannot_import）に違反していないか
+// モジュール内のパッケージのimportのみを対象とし、どのレイヤーにも属さないパッケージは判定しない
+func (c *Checker) checkLayerDependencies(file *ast.File, filePath string) {
+	rule := c.config.Architecture.Rules.LayerDependencies
+	if c.modulePath == "" || len(rule.Layers) == 0 {
+		return
+	}
+	names := layerNames(rule.Layers)
+	from := c.layerOf(rule.Layers, names, c.importPath(filePath))
+	layer, ok := findLayer(rule.Layers, from)
+	if !ok {
+		return
+	}
+
+	for _, imp := range file.Imports {
+		pkg, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || (pkg != c.modulePath && !strings.HasPrefix(pkg, c.modulePath+"/")) {
+			continue
+		}
+		to := c.layerOf(rule.Layers, names, pkg)
+		if to == "" || to == from {
+			continue
+		}
+
+		var message string
+		switch {
+		case containsString(layer.CannotImport, to):
+			message = fmt.Sprintf("レイヤー '%s' から '%s' をimportしています（cannot_import）", from, to)
+		case len(layer.CanImport) > 0 && !containsString(layer.CanImport, to):
+			message = fmt.Sprintf("レイヤー '%s' から '%s' をimportしています（can_import: %s）", from, to, strings.Join(layer.CanImport, ", "))
+		default:
+			continue
+		}
+		pos := c.fset.Position(imp.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "layer_dependencies",
+			Category:   "architecture",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    message,
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: fmt.Sprintf("'%s' への依存はインタフェースを '%s' 側に定義して注入する等、依存方向を逆転させてください", to, from),
+		})
+	}
+}
+
+// layerNames レイヤー名と、can_import・cannot_importに現れる名前（レイヤーとして定義していないパッケージを含む）
+func layerNames(layers []rules.LayerRule) []string {
+	var names []string
+	for _, layer := range layers {
+		for _, name := range append(append([]string{layer.Name}, layer.CanImport...), layer.CannotImport...) {
+			if !containsString(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// findLayer 名前のレイヤーの定義
+func findLayer(layers []rules.LayerRule, name string) (rules.LayerRule, bool) {
+	for _, layer := range layers {
+		if layer.Name == name {
+			return layer, true
+		}
+	}
+	return rules.LayerRule{}, false
+}
+
+// layerOf パッケージ（importパス）が属するレイヤー（属さない場合は空）
+// pathsを指定したレイヤーはモジュールからの相対パスとの照合を優先し、
+// それ以外は最も浅い階層にあるレイヤー名と同じ名前のディレクトリで判定する（internal/service/model は service）
+func (c *Checker) layerOf(layers []rules.LayerRule, names []string, pkg string) string {
+	rel := strings.TrimPrefix(strings.TrimPrefix(pkg, c.modulePath), "/")
+	for _, layer := range layers {
+		for _, pattern := range layer.Paths {
+			if matchGlob(pattern, rel) || matchGlob(pattern, rel+"/") || matchGlob(pattern, pkg) {
+				return layer.Name
+			}
+		}
+	}
+	for _, dir := range strings.Split(rel, "/") {
+		if containsString(names, dir) {
+			return dir
+		}
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+package checker
+
+import "testing"
+
+func TestClockInjection(t *testing.T) {
+	const config = `
+architecture:
+  enabled: true
+  rules:
+    clock_injection:
+      enabled: true
+      severity: "info"
+      packages: ["**/service/**"]
+      allowed_in: ["**/infrastructure/**"]
+`
+	runRuleTests(t, config, "clock_injection", []ruleTest{
+		{
+			name: "time.Now and time.Since in service",
+			files: map[string]string{"internal/service/order.go": `package service
+
+import "time"
+
+func expired(created time.Time) bool {
+	return time.Now().After(created.Add(time.Hour)) || time.Since(created) > time.Hour
+}
+`},
+			want: 2,
+		},
+		{
+			name: "injected clock and other layers",
+			files: map[string]string{
+				"internal/service/order.go": `package service
+
+import "time"
+
+type Clock interface{ Now() time.Time }
+
+type OrderService struct{ clock Clock }
+
+func (s *OrderService) expired(created time.Time) bool {
+	return s.clock.Now().After(created.Add(time.Hour))
+}
+`,
+				"internal/infrastructure/clock.go": "package infrastructure\n\nimport \"time\"\n\nfunc Now() time.Time { return time.Now() }\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestLayerDependencies(t *testing.T) {
+	const config = `
+architecture:
+  enabled: true
+  rules:
+    layer_dependencies:
+      enabled: true
+      severity: "error"
+      layers:
+        - name: "handler"
+          can_import: ["service"]
+          cannot_import: ["repository"]
+        - name: "service"
+          can_import: ["repository"]
+          cannot_import: ["handler"]
+        - name: "repository"
+          cannot_import: ["handler", "service"]
+`
+	const goMod = "module example.com/p\n\ngo 1.21\n"
+	runRuleTests(t, config, "layer_dependencies", []ruleTest{
+		{
+			name: "handler imports repository and repository imports service",
+			files: map[string]string{
+				"go.mod":                          goMod,
+				"internal/handler/user.go":        "package handler\n\nimport _ \"example.com/p/internal/repository\"\n",
+				"internal/repository/user.go":     "package repository\n\nimport _ \"example.com/p/internal/service\"\n",
+				"internal/service/placeholder.go": "package service\n",
+			},
+			want: 2,
+		},
+		{
+			name: "handler to service to repository",
+			files: map[string]string{
+				"go.mod":                      goMod,
+				"internal/handler/user.go":    "package handler\n\nimport (\n\t_ \"example.com/p/internal/service\"\n\t_ \"fmt\"\n)\n",
+				"internal/service/user.go":    "package service\n\nimport _ \"example.com/p/internal/repository\"\n",
+				"internal/repository/user.go": "package repository\n",
+			},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package checker
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// ループ内のtime.Tick・selectでの送信のブロック
+// ========================================
+
+// checkTickInLoop ループ本体（関数リテラルを除く）のtime.Tickを検出
+// 反復ごとに停止できないTickerが作られるため、ループの外でtime.NewTickerを作りdeferでStopする
+func (c *Checker) checkTickInLoop(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.Concurrency.Rules.TickInLoop
+	if fn.Body == nil || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	reported := make(map[*ast.CallExpr]bool)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		switch loop := n.(type) {
+		case *ast.ForStmt:
+			body = loop.Body
+		case *ast.RangeStmt:
+			body = loop.Body
+		default:
+			return true
+		}
+		for _, call := range c.callsTo(body, "time.Tick") {
+			if reported[call] {
+				continue
+			}
+			reported[call] = true
+			c.reportBlocking(call, "tick_in_loop", rule.Severity,
+				"ループ内でtime.Tickを呼び出しています（反復ごとに停止できないTickerが作られます）",
+				"ループの外でtime.NewTickerを作成し、defer ticker.Stop()で停止してください", filePath)
+		}
+		return true
+	})
+}
+
+// checkSelectSendTimeout defaultも、タイムアウト・キャンセルの受信ケースも無いselectでのチャネル送信を検出
+// 受信側がいなくなると送信したgoroutineが永久にブロックする
+func (c *Checker) checkSelectSendTimeout(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.Concurrency.Rules.SelectSendTimeout
+	if fn.Body == nil || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectStmt)
+		if !ok || c.canGiveUp(sel) {
+			return true
+		}
+		for _, stmt := range sel.Body.List {
+			clause, ok := stmt.(*ast.CommClause)
+			if !ok {
+				continue
+			}
+			if send, ok := clause.Comm.(*ast.SendStmt); ok {
+				c.reportBlocking(send, "select_send_timeout", rule.Severity,
+					"selectでのチャネル '"+c.nodeText(filePath, send.Chan)+"' への送信にdefault・タイムアウト・キャンセルのケースがありません",
+					"case <-ctx.Done(): または case <-time.After(d): を追加するか、defaultで送信できない場合を処理してください", filePath)
+			}
+		}
+		return true
+	})
+}
+
+// canGiveUp selectがdefault、またはタイムアウト・キャンセルの受信ケース
+// （ctx.Done()・time.After・Timer/TickerのC・done/quit/stopチャネル）を持つか
+func (c *Checker) canGiveUp(sel *ast.SelectStmt) bool {
+	for _, stmt := range sel.Body.List {
+		clause, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+		if clause.Comm == nil {
+			return true
+		}
+		if ch := receivedChan(clause.Comm); ch != nil && c.isCancelChan(ch) {
+			return true
+		}
+	}
+	return false
+}
+
+// receivedChan 受信ケース（<-ch / v := <-ch / v, ok = <-ch）の受信するチャネル
+func receivedChan(stmt ast.Stmt) ast.Expr {
+	var expr ast.Expr
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = s.X
+	case *ast.AssignStmt:
+		if len(s.Rhs) == 1 {
+			expr = s.Rhs[0]
+		}
+	}
+	if u, ok := ast.Unparen(expr).(*ast.UnaryExpr); ok && u.Op == token.ARROW {
+		return u.X
+	}
+	return nil
+}
+
+// isCancelChan タイムアウト・キャンセルを通知するチャネルか
+func (c *Checker) isCancelChan(ch ast.Expr) bool {
+	switch e := ast.Unparen(ch).(type) {
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Done" {
+			return true
+		}
+		return c.getCallExprString(e) == "time.After"
+	case *ast.SelectorExpr:
+		if e.Sel.Name == "C" {
+			return true
+		}
+	}
+	return isDoneChannel(ch)
+}
+
+// reportBlocking ブロックのルールの違反を報告する
+func (c *Checker) reportBlocking(node ast.Node, rule, severity, message, suggestion, filePath string) {
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "concurrency",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
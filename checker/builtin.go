@@ -0,0 +1,262 @@
+package checker
+
+import (
+	"go/ast"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 組み込みルール
+// ========================================
+
+// builtinRule 組み込みルール
+// 検査対象ごとのフックを持ち、ノード単位のフックはファイルごとに1回の走査で呼び出される
+type builtinRule struct {
+	name     string
+	category string
+	enabled  func(cfg *rules.Config) bool // 省略時は設定ファイルの enabled で判定
+
+	file         func(c *Checker, file *ast.File, filePath string)
+	funcDecl     func(c *Checker, fn *ast.FuncDecl, filePath string)
+	genDecl      func(c *Checker, gd *ast.GenDecl, filePath string)
+	typeSpec     func(c *Checker, ts *ast.TypeSpec, filePath string)
+	assign       func(c *Checker, as *ast.AssignStmt, filePath string)
+	call         func(c *Checker, call *ast.CallExpr, callStr, filePath string)
+	exprStmt     func(c *Checker, stmt *ast.ExprStmt, filePath string)
+	goStmt       func(c *Checker, stmt *ast.GoStmt, filePath string)
+	compositeLit func(c *Checker, lit *ast.CompositeLit, filePath string)
+	project      func(c *Checker, ctx *ProjectContext)
+}
+
+func (r *builtinRule) Name() string     { return r.name }
+func (r *builtinRule) Category() string { return r.category }
+
+// CheckFile ファイル単位のフックを呼び出す
+func (r *builtinRule) CheckFile(ctx *FileContext) {
+	if r.file != nil {
+		r.file(ctx.c, ctx.File, ctx.Path)
+	}
+}
+
+// CheckNode ノードの種類に対応するフックを呼び出す
+func (r *builtinRule) CheckNode(ctx *FileContext, node ast.Node) {
+	c, filePath := ctx.c, ctx.Path
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if r.funcDecl != nil {
+			r.funcDecl(c, n, filePath)
+		}
+	case *ast.GenDecl:
+		if r.genDecl != nil {
+			r.genDecl(c, n, filePath)
+		}
+	case *ast.TypeSpec:
+		if r.typeSpec != nil {
+			r.typeSpec(c, n, filePath)
+		}
+	case *ast.AssignStmt:
+		if r.assign != nil {
+			r.assign(c, n, filePath)
+		}
+	case *ast.CallExpr:
+		if r.call != nil {
+			r.call(c, n, c.getCallExprString(n), filePath)
+		}
+	case *ast.ExprStmt:
+		if r.exprStmt != nil {
+			r.exprStmt(c, n, filePath)
+		}
+	case *ast.GoStmt:
+		if r.goStmt != nil {
+			r.goStmt(c, n, filePath)
+		}
+	case *ast.CompositeLit:
+		if r.compositeLit != nil {
+			r.compositeLit(c, n, filePath)
+		}
+	}
+}
+
+// CheckProject プロジェクト単位のフックを呼び出す
+func (r *builtinRule) CheckProject(ctx *ProjectContext) {
+	if r.project != nil {
+		r.project(ctx.c, ctx)
+	}
+}
+
+// hasNodeHook ノード単位のフックを持つか
+func (r *builtinRule) hasNodeHook() bool {
+	return r.funcDecl != nil || r.genDecl != nil || r.typeSpec != nil || r.assign != nil ||
+		r.call != nil || r.exprStmt != nil || r.goStmt != nil || r.compositeLit != nil
+}
+
+// withFilePath (call, filePath) 形式の検査を呼び出しフックに変換
+func withFilePath(check func(c *Checker, call *ast.CallExpr, filePath string)) func(c *Checker, call *ast.CallExpr, callStr, filePath string) {
+	return func(c *Checker, call *ast.CallExpr, _, filePath string) {
+		check(c, call, filePath)
+	}
+}
+
+// builtinRules 組み込みルールの一覧（名前は設定ファイルのキー）
+func builtinRules() []Rule {
+	return []Rule{
+		// 命名規則
+		&builtinRule{name: "file_name", category: "naming",
+			file: func(c *Checker, _ *ast.File, filePath string) { c.checkFileName(filePath) }},
+		&builtinRule{name: "package_name", category: "naming", file: (*Checker).checkPackageName},
+		&builtinRule{name: "exported_names", category: "naming", funcDecl: (*Checker).checkExportedFuncName},
+		&builtinRule{name: "acronyms", category: "naming", file: (*Checker).checkAcronyms},
+		&builtinRule{name: "interface_name", category: "naming", typeSpec: (*Checker).checkInterfaceName},
+		&builtinRule{name: "error_var", category: "naming", genDecl: (*Checker).checkGenDecl},
+		&builtinRule{name: "receiver_name", category: "naming", project: (*Checker).checkReceiverNames},
+		&builtinRule{name: "receiver_type", category: "naming", project: (*Checker).checkReceiverTypes},
+
+		// コード構造
+		&builtinRule{name: "max_function_lines", category: "structure", funcDecl: (*Checker).checkFunctionLines},
+		&builtinRule{name: "max_parameters", category: "structure", funcDecl: (*Checker).checkParameterCount},
+		&builtinRule{name: "max_return_values", category: "structure", funcDecl: (*Checker).checkReturnValueCount},
+		&builtinRule{name: "max_nesting_level", category: "structure", funcDecl: (*Checker).checkFunctionNesting},
+		&builtinRule{name: "max_cyclomatic_complexity", category: "structure", funcDecl: (*Checker).checkCyclomaticComplexity},
+		&builtinRule{name: "max_file_lines", category: "structure", file: (*Checker).checkFileLines},
+		&builtinRule{name: "no_global_vars", category: "structure", file: (*Checker).checkGlobalVars},
+		&builtinRule{name: "no_init_func", category: "structure", funcDecl: (*Checker).checkInitFunc},
+		&builtinRule{name: "no_magic_numbers", category: "structure", file: (*Checker).checkMagicNumbers},
+		&builtinRule{name: "no_hardcoded_urls", category: "structure", file: (*Checker).checkHardcodedURLs},
+
+		// エラーハンドリング
+		&builtinRule{name: "no_ignored_errors", category: "error_handling", assign: (*Checker).checkAssignment,
+			exprStmt: (*Checker).checkIgnoredErrorStmt},
+		&builtinRule{name: "error_wrapping", category: "error_handling", call: (*Checker).checkErrorWrapping},
+		&builtinRule{name: "no_panic", category: "error_handling", call: (*Checker).checkPanic},
+		&builtinRule{name: "error_constructor", category: "error_handling", call: (*Checker).checkErrorConstructor},
+		&builtinRule{name: "wrap_context", category: "error_handling", funcDecl: (*Checker).checkWrapContext},
+		&builtinRule{name: "http_error_response", category: "error_handling", funcDecl: (*Checker).checkHTTPErrorResponse},
+		&builtinRule{name: "iterator_err", category: "error_handling", funcDecl: (*Checker).checkIteratorErr},
+
+		// ログ出力
+		&builtinRule{name: "no_std_log", category: "logging", file: (*Checker).checkStdLog},
+		&builtinRule{name: "no_fmt_println", category: "logging", call: (*Checker).checkFmtPrintln},
+		&builtinRule{name: "no_builtin_print", category: "logging", call: withFilePath((*Checker).checkBuiltinPrint)},
+		&builtinRule{name: "log_field_keys", category: "logging",
+			call:     withFilePath((*Checker).checkLogFieldKeyStyle),
+			exprStmt: (*Checker).checkLogFieldKeyDuplicatesStmt},
+		&builtinRule{name: "sensitive_data", category: "logging", call: withFilePath((*Checker).checkSensitiveLogData)},
+		&builtinRule{name: "context_logger", category: "logging", funcDecl: (*Checker).checkContextLogger},
+		&builtinRule{name: "no_std_write", category: "logging", call: (*Checker).checkStdWrite},
+		&builtinRule{name: "mixed_loggers", category: "logging",
+			file:    (*Checker).collectLoggerImports,
+			project: func(c *Checker, _ *ProjectContext) { c.checkMixedLoggers() }},
+
+		// パフォーマンス
+		&builtinRule{name: "sprintf_concat", category: "performance", call: (*Checker).checkSprintfConcat},
+
+		// context.Context
+		&builtinRule{name: "context_first_param", category: "context", funcDecl: (*Checker).checkContextParam},
+		&builtinRule{name: "context_in_struct", category: "context", typeSpec: (*Checker).checkContextInStructSpec},
+		&builtinRule{name: "context_background", category: "context", call: (*Checker).checkContextBackground},
+		&builtinRule{name: "context_key_type", category: "context", call: (*Checker).checkContextKey},
+
+		// 並行処理
+		&builtinRule{name: "goroutine_leak", category: "concurrency", goStmt: (*Checker).checkGoroutineLeak},
+		&builtinRule{name: "unbounded_goroutines", category: "concurrency", goStmt: (*Checker).checkUnboundedGoroutines},
+		&builtinRule{name: "lock_copy", category: "concurrency",
+			funcDecl: (*Checker).checkLockCopyFunc,
+			assign:   (*Checker).checkLockCopyAssign,
+			call:     withFilePath((*Checker).checkLockCopyArgs)},
+		&builtinRule{name: "sleep_retry", category: "concurrency", funcDecl: (*Checker).checkSleepRetry},
+		&builtinRule{name: "channel_ownership", category: "concurrency", funcDecl: (*Checker).checkChannelOwnership},
+		&builtinRule{name: "shared_map", category: "concurrency", file: (*Checker).checkSharedMaps},
+		&builtinRule{name: "tick_in_loop", category: "concurrency", funcDecl: (*Checker).checkTickInLoop},
+		&builtinRule{name: "select_send_timeout", category: "concurrency", funcDecl: (*Checker).checkSelectSendTimeout},
+
+		// セキュリティ
+		&builtinRule{name: "sql_injection", category: "security", call: withFilePath((*Checker).checkSQLInjection)},
+		&builtinRule{name: "command_injection", category: "security", call: (*Checker).checkCommandInjection},
+		&builtinRule{name: "insecure_tls", category: "security",
+			assign:       (*Checker).checkInsecureTLSAssign,
+			compositeLit: (*Checker).checkInsecureTLSConfig,
+			call:         (*Checker).checkOutboundURL},
+		&builtinRule{name: "weak_random", category: "security", funcDecl: (*Checker).checkWeakRandom},
+		&builtinRule{name: "hardcoded_secrets", category: "security", file: (*Checker).checkHardcodedSecrets},
+		&builtinRule{name: "file_permissions", category: "security", call: (*Checker).checkFilePermissions},
+		&builtinRule{name: "default_http_client", category: "security", file: (*Checker).checkDefaultHTTPClient},
+		&builtinRule{name: "missing_timeout", category: "security",
+			compositeLit: (*Checker).checkMissingTimeoutLit,
+			funcDecl:     (*Checker).checkHandlerTimeout},
+
+		// コメント
+		&builtinRule{name: "todo", category: "comments", file: (*Checker).checkTodo},
+
+		// ドキュメントコメント
+		&builtinRule{name: "exported_doc", category: "documentation",
+			funcDecl: (*Checker).checkExportedFuncDoc,
+			genDecl:  (*Checker).checkExportedGenDeclDoc},
+		&builtinRule{name: "doc_prefix", category: "documentation",
+			funcDecl: (*Checker).checkFuncDocPrefix,
+			genDecl:  (*Checker).checkGenDeclDocPrefix},
+		&builtinRule{name: "package_comment", category: "documentation", project: (*Checker).checkPackageComment},
+
+		// インタフェース
+		&builtinRule{name: "max_methods", category: "interfaces", typeSpec: (*Checker).checkInterfaceMaxMethods},
+		&builtinRule{name: "single_implementation", category: "interfaces", project: (*Checker).checkSingleImplementation},
+		&builtinRule{name: "param_names", category: "interfaces", typeSpec: (*Checker).checkInterfaceParamNames},
+
+		// アーキテクチャ
+		&builtinRule{name: "layer_dependencies", category: "architecture", file: (*Checker).checkLayerDependencies},
+		&builtinRule{name: "clock_injection", category: "architecture", call: (*Checker).checkClockInjection},
+
+		// ディレクトリ構成
+		&builtinRule{name: "required_dirs", category: "directory",
+			project: func(c *Checker, ctx *ProjectContext) { c.checkRequiredDirs(ctx.Root) }},
+		&builtinRule{name: "recommended_dirs", category: "directory",
+			project: func(c *Checker, ctx *ProjectContext) { c.checkRecommendedDirs(ctx.Root) }},
+
+		// 構造体タグ
+		&builtinRule{name: "json_tag", category: "struct_tags", typeSpec: (*Checker).checkJSONTags},
+		&builtinRule{name: "validation_tag", category: "struct_tags", typeSpec: (*Checker).checkValidationTags},
+		&builtinRule{name: "tag_style", category: "struct_tags", typeSpec: (*Checker).checkTagStyles},
+		&builtinRule{name: "config_tag", category: "struct_tags", typeSpec: (*Checker).checkConfigTags},
+		&builtinRule{name: "validate_syntax", category: "struct_tags", typeSpec: (*Checker).checkValidateSyntax},
+		&builtinRule{name: "tag_field_name", category: "struct_tags", typeSpec: (*Checker).checkTagFieldNames},
+		&builtinRule{name: "dto_json_tag", category: "struct_tags", typeSpec: (*Checker).checkDTOJSONTags},
+		&builtinRule{name: "duplicate_tag", category: "struct_tags", typeSpec: (*Checker).checkDuplicateTags},
+		&builtinRule{name: "tag_format", category: "struct_tags", typeSpec: (*Checker).checkTagFormat},
+		&builtinRule{name: "validation_call", category: "struct_tags", funcDecl: (*Checker).checkValidationCall},
+		&builtinRule{name: "required_tags", category: "struct_tags", typeSpec: (*Checker).checkRequiredTags},
+		&builtinRule{name: "omitempty", category: "struct_tags", typeSpec: (*Checker).checkOmitempty},
+
+		// AWS Lambda
+		&builtinRule{name: "init_aws_clients", category: "aws_lambda", funcDecl: (*Checker).checkInitAWSClients},
+		&builtinRule{name: "context_propagation", category: "aws_lambda", funcDecl: (*Checker).checkLambdaContextPropagation},
+		&builtinRule{name: "sqs_batch_failures", category: "aws_lambda", funcDecl: (*Checker).checkSQSBatchFailures},
+		&builtinRule{name: "env_access", category: "aws_lambda",
+			funcDecl: (*Checker).checkLambdaEnvAccess,
+			file:     (*Checker).checkLambdaEnvValidation},
+
+		// テスト
+		&builtinRule{name: "test_file_exists", category: "testing", project: (*Checker).checkTestFileExists},
+		&builtinRule{name: "t_helper", category: "testing", project: (*Checker).checkTHelper},
+		&builtinRule{name: "table_driven", category: "testing", project: (*Checker).checkTableDriven},
+		&builtinRule{name: "test_package", category: "testing", project: (*Checker).checkTestPackage},
+		&builtinRule{name: "test_ratio", category: "testing", project: (*Checker).checkTestRatio},
+		&builtinRule{name: "test_func_name", category: "testing", project: (*Checker).checkTestFuncNames},
+		&builtinRule{name: "t_parallel", category: "testing", project: (*Checker).checkTParallel},
+
+		// gRPC
+		&builtinRule{name: "server_context", category: "grpc", funcDecl: (*Checker).checkGRPCServerContext},
+		&builtinRule{name: "status_error", category: "grpc", funcDecl: (*Checker).checkGRPCStatusError},
+		&builtinRule{name: "server_panic", category: "grpc", funcDecl: (*Checker).checkGRPCServerPanic},
+		&builtinRule{name: "pb_edited", category: "grpc", project: (*Checker).checkPBEdited},
+
+		// カスタムルール（各ルールの enabled で判定）
+		&builtinRule{name: "custom_rules", category: "custom",
+			enabled: func(cfg *rules.Config) bool { return len(cfg.CustomRules) > 0 },
+			file:    func(c *Checker, _ *ast.File, _ string) { c.checkCustomRules(c.ctx) }},
+
+		// プロジェクト固有ルール（各ルールの enabled で判定）
+		&builtinRule{name: "project_rules", category: "custom",
+			enabled: func(cfg *rules.Config) bool { return len(cfg.ProjectRules) > 0 },
+			file:    (*Checker).checkProjectRules},
+	}
+}
@@ -0,0 +1,403 @@
+package checker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// 解析結果のキャッシュ
+// ========================================
+//
+// ファイル内容のハッシュと設定のハッシュをキーに、ファイル単位のチェック結果を保存する
+// 内容が変わっていないファイルは次回以降の実行で解析・チェックを省略する
+//
+// 保存先は1つのキャッシュファイル（SetCache）またはキャッシュディレクトリ（SetCacheDir）。
+// キャッシュディレクトリにはキーごとに1ファイルを作成するため、複数のプロジェクト・設定で共有できる
+
+// cacheVersion キャッシュ形式・組み込みルールの判定が変わったときに上げる
+const cacheVersion = "11"
+
+// DefaultCacheFile キャッシュファイルの既定名
+const DefaultCacheFile = ".gostandards-cache"
+
+// cacheDirName ユーザーのキャッシュディレクトリ内のディレクトリ名
+const cacheDirName = "go-standards-checker"
+
+// analysisCache ファイル単位のチェック結果のキャッシュ
+type analysisCache struct {
+	Version    string                `json:"version"`
+	ConfigHash string                `json:"config_hash"`
+	Files      map[string]cacheEntry `json:"files"` // ルートからの相対パス→結果
+
+	path string
+	dir  string // キャッシュディレクトリ（空の場合はpathのファイルに保存）
+	root string
+	mu   sync.Mutex
+	seen map[string]bool // 今回の実行でチェックしたファイル
+}
+
+// cacheEntry 1ファイルのチェック結果
+type cacheEntry struct {
+	Hash          string               `json:"hash"`
+	Violations    []report.Violation   `json:"violations"` // Fileはルートからの相対パス
+	LoggerImports []cachedLoggerImport `json:"logger_imports,omitempty"`
+	Backlog       []report.TodoItem    `json:"backlog,omitempty"` // Fileはルートからの相対パス
+	Suppressed    int                  `json:"suppressed,omitempty"`
+	Suppressions  []cachedSuppression  `json:"suppressions,omitempty"`
+}
+
+// cachedLoggerImport ロギングライブラリのimport箇所（プロジェクト単位のルール用）
+type cachedLoggerImport struct {
+	Library string `json:"library"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Code    string `json:"code"`
+}
+
+// cachedSuppression ファイルの抑制コメント（プロジェクト単位のルール・未使用の報告用）
+type cachedSuppression struct {
+	Line   int      `json:"line"`
+	Column int      `json:"column"`
+	Code   string   `json:"code"`
+	Rules  []string `json:"rules"`
+	From   int      `json:"from"`
+	To     int      `json:"to"`
+	Used   bool     `json:"used,omitempty"`
+}
+
+// SetCache キャッシュファイルのパスを設定（空の場合はキャッシュしない）
+//
+// 型情報付き解析・fs.FS・SetFixを設定した場合はキャッシュしない（他ファイル・依存パッケージの変更を検知できないため）
+func (c *Checker) SetCache(path string) {
+	c.cachePath = path
+}
+
+// SetFix 自動修正のための編集を、他のファイルを変更するもの（参照の名前の変更）も含めて求める
+//
+// 他のファイルの内容はキャッシュのキーに含まれないため、有効な場合はキャッシュしない
+func (c *Checker) SetFix(enabled bool) {
+	c.fixing = enabled
+}
+
+// SetCacheDir キャッシュディレクトリを設定（空の場合はキャッシュしない、SetCacheより優先）
+//
+// ファイルごとの結果を、ファイル内容・設定・ルートディレクトリのハッシュをキーとしたファイルに保存する
+func (c *Checker) SetCacheDir(dir string) {
+	c.cacheDir = dir
+}
+
+// DefaultCacheDir キャッシュディレクトリの既定値（ユーザーのキャッシュディレクトリ配下、例: ~/.cache/go-standards-checker）
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheDirName), nil
+}
+
+// ClearCache キャッシュディレクトリ内のエントリを削除する（存在しない場合は何もしない）
+//
+// 削除するのはこのツールが作成した形式（xx/<キーのハッシュ>.json と書き込み途中の一時ファイル）のみで、
+// 他のファイルとディレクトリ自体は残す（cache_dir にプロジェクトやホームディレクトリを指定した場合の保護）
+func ClearCache(dir string) error {
+	if dir == "" {
+		return errors.New("refusing to clear cache directory " + strconv.Quote(dir))
+	}
+	subdirs, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, sub := range subdirs {
+		if !sub.IsDir() || !isHex(sub.Name(), 2) {
+			continue
+		}
+		subPath := filepath.Join(dir, sub.Name())
+		entries, err := os.ReadDir(subPath)
+		if err != nil {
+			return err
+		}
+		kept := 0
+		for _, entry := range entries {
+			if entry.IsDir() || !isCacheEntryName(sub.Name(), entry.Name()) {
+				kept++
+				continue
+			}
+			if err := os.Remove(filepath.Join(subPath, entry.Name())); err != nil {
+				return err
+			}
+		}
+		if kept == 0 {
+			if err := os.Remove(subPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isCacheEntryName prefixのディレクトリ内のエントリ・一時ファイルの名前か
+func isCacheEntryName(prefix, name string) bool {
+	if strings.HasPrefix(name, ".tmp-") {
+		return true
+	}
+	key, ok := strings.CutSuffix(name, ".json")
+	return ok && strings.HasPrefix(key, prefix) && isHex(key, sha256.Size*2)
+}
+
+// isHex sがn文字の小文字の16進数か
+func isHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// loadCache キャッシュを読み込む（設定が変わっている場合は空のキャッシュ）
+func (c *Checker) loadCache(root string) *analysisCache {
+	if c.cachePath == "" && c.cacheDir == "" || c.fsys != nil || c.config.Settings.Typed || c.fixing {
+		return nil
+	}
+
+	cache := &analysisCache{
+		Version:    cacheVersion,
+		ConfigHash: c.configHash(),
+		Files:      make(map[string]cacheEntry),
+		path:       c.cachePath,
+		dir:        c.cacheDir,
+		root:       root,
+		seen:       make(map[string]bool),
+	}
+	if cache.dir != "" {
+		// エントリはlookupのたびにディレクトリから読み込む
+		return cache
+	}
+	data, err := os.ReadFile(c.cachePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			c.warnf("failed to read cache %s: %v", c.cachePath, err)
+		}
+		return cache
+	}
+	var saved analysisCache
+	if err := json.Unmarshal(data, &saved); err != nil {
+		c.warnf("ignoring invalid cache %s: %v", c.cachePath, err)
+		return cache
+	}
+	if saved.Version == cache.Version && saved.ConfigHash == cache.ConfigHash && saved.Files != nil {
+		cache.Files = saved.Files
+	}
+	return cache
+}
+
+// saveCache キャッシュを書き込む（全ファイルをチェックした場合は存在しないファイルの結果を削除）
+func (c *Checker) saveCache(cache *analysisCache) {
+	if cache == nil || cache.dir != "" {
+		return
+	}
+	if c.files == nil {
+		for rel := range cache.Files {
+			if !cache.seen[rel] {
+				delete(cache.Files, rel)
+			}
+		}
+	}
+	data, err := json.Marshal(cache)
+	if err != nil {
+		c.warnf("failed to write cache %s: %v", cache.path, err)
+		return
+	}
+	if err := os.WriteFile(cache.path, data, 0644); err != nil {
+		c.warnf("failed to write cache %s: %v", cache.path, err)
+	}
+}
+
+// configHash 設定・有効なルール・秘密情報の許可リストのハッシュ
+func (c *Checker) configHash() string {
+	h := sha256.New()
+	h.Write([]byte(cacheVersion))
+	if data, err := json.Marshal(c.config); err == nil {
+		h.Write(data)
+	}
+	if c.secretAllow != nil {
+		h.Write(c.secretAllow.data)
+	}
+	var names []string
+	for _, rule := range append(append(RegisteredRules(), c.extraRules...), c.pluginRules...) {
+		names = append(names, rule.Category()+"/"+rule.Name())
+	}
+	sort.Strings(names)
+	h.Write([]byte(strings.Join(names, ",")))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fileHash ファイル内容と、結果に影響する他ファイルの情報（同じディレクトリのLambdaハンドラ）のハッシュ
+func (c *Checker) fileHash(filePath string, src []byte) string {
+	h := sha256.New()
+	h.Write(src)
+	dir := filepath.Dir(filePath)
+	for _, names := range []map[string]bool{c.lambdaHandlers[dir], c.lambdaCallTree[dir]} {
+		list := make([]string, 0, len(names))
+		for name := range names {
+			list = append(list, name)
+		}
+		sort.Strings(list)
+		h.Write([]byte("\x00" + strings.Join(list, ",")))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// lookup キャッシュ済みの結果を返す
+func (cache *analysisCache) lookup(job fileJob, hash string) (fileResult, bool) {
+	entry, ok := cache.entry(job, hash)
+	if !ok {
+		return fileResult{}, false
+	}
+	result := fileResult{index: job.index, path: job.path, suppressed: entry.Suppressed}
+	for _, v := range entry.Violations {
+		v.File = cache.abs(v.File)
+		result.violations = append(result.violations, v)
+	}
+	for _, item := range entry.Backlog {
+		item.File = cache.abs(item.File)
+		result.backlog = append(result.backlog, item)
+	}
+	for _, imp := range entry.LoggerImports {
+		result.loggerImports = append(result.loggerImports, loggerImport{
+			library: imp.Library,
+			file:    job.path,
+			pos:     token.Position{Filename: job.path, Line: imp.Line, Column: imp.Column},
+			code:    imp.Code,
+		})
+	}
+	for _, s := range entry.Suppressions {
+		result.suppressions = append(result.suppressions, &suppression{
+			line: s.Line, column: s.Column, code: s.Code, rules: s.Rules, from: s.From, to: s.To, used: s.Used,
+		})
+	}
+	return result, true
+}
+
+// entry ファイルのキャッシュ済みの結果（内容が変わっている場合は無し）
+func (cache *analysisCache) entry(job fileJob, hash string) (cacheEntry, bool) {
+	rel := cache.rel(job.path)
+	if cache.dir != "" {
+		data, err := os.ReadFile(cache.entryPath(rel, hash))
+		if err != nil {
+			return cacheEntry{}, false
+		}
+		var entry cacheEntry
+		return entry, json.Unmarshal(data, &entry) == nil && entry.Hash == hash
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.seen[rel] = true
+	entry, ok := cache.Files[rel]
+	return entry, ok && entry.Hash == hash
+}
+
+// store チェック結果を保存（キャッシュディレクトリの場合は書き込みのエラーを返す）
+func (cache *analysisCache) store(job fileJob, hash string, result fileResult) error {
+	entry := cacheEntry{Hash: hash, Suppressed: result.suppressed}
+	for _, v := range result.violations {
+		v.File = cache.rel(v.File)
+		entry.Violations = append(entry.Violations, v)
+	}
+	for _, item := range result.backlog {
+		item.File = cache.rel(item.File)
+		entry.Backlog = append(entry.Backlog, item)
+	}
+	for _, imp := range result.loggerImports {
+		entry.LoggerImports = append(entry.LoggerImports, cachedLoggerImport{
+			Library: imp.library,
+			Line:    imp.pos.Line,
+			Column:  imp.pos.Column,
+			Code:    imp.code,
+		})
+	}
+	for _, s := range result.suppressions {
+		entry.Suppressions = append(entry.Suppressions, cachedSuppression{
+			Line: s.line, Column: s.column, Code: s.code, Rules: s.rules, From: s.from, To: s.to, Used: s.used,
+		})
+	}
+
+	rel := cache.rel(job.path)
+	if cache.dir != "" {
+		return cache.writeEntry(cache.entryPath(rel, hash), entry)
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.seen[rel] = true
+	cache.Files[rel] = entry
+	return nil
+}
+
+// entryPath キャッシュディレクトリ内のエントリのパス（設定・ルート・相対パス・内容のハッシュをキーとする）
+func (cache *analysisCache) entryPath(rel, hash string) string {
+	h := sha256.New()
+	for _, part := range []string{cacheVersion, cache.ConfigHash, cache.root, rel, hash} {
+		h.Write([]byte(part + "\x00"))
+	}
+	key := hex.EncodeToString(h.Sum(nil))
+	return filepath.Join(cache.dir, key[:2], key+".json")
+}
+
+// writeEntry エントリを書き込む（並行する実行が読み込み途中のファイルを見ないよう一時ファイルから置き換える）
+func (cache *analysisCache) writeEntry(path string, entry cacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// rel ルートからの相対パス
+func (cache *analysisCache) rel(path string) string {
+	if rel, err := filepath.Rel(cache.root, path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
+
+// abs 相対パスをルート基準のパスに戻す
+func (cache *analysisCache) abs(rel string) string {
+	if filepath.IsAbs(rel) {
+		return rel
+	}
+	return filepath.Join(cache.root, filepath.FromSlash(rel))
+}
@@ -0,0 +1,260 @@
+package checker
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// markCachedDir キャッシュディレクトリ内のエントリの違反のメッセージを書き換える（キャッシュから読んだ結果の判別用）
+func markCachedDir(t *testing.T, dir string) {
+	t.Helper()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var entry cacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return err
+		}
+		for i := range entry.Violations {
+			entry.Violations[i].Message = "cached"
+		}
+		if data, err = json.Marshal(entry); err != nil {
+			return err
+		}
+		return os.WriteFile(path, data, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// cachedMessages 違反のうちキャッシュから読んだ（markCachedで書き換えた）ものの数と全体の数
+func cachedMessages(t *testing.T, c *Checker, root string) (cached, total int) {
+	t.Helper()
+	rep, err := c.Check(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range rep.Violations {
+		if v.Message == "cached" {
+			cached++
+		}
+	}
+	return cached, len(rep.Violations)
+}
+
+// markCachedFile キャッシュファイル内の違反のメッセージを書き換える
+func markCachedFile(t *testing.T, path string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cache analysisCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		t.Fatal(err)
+	}
+	for rel, entry := range cache.Files {
+		for i := range entry.Violations {
+			entry.Violations[i].Message = "cached"
+		}
+		cache.Files[rel] = entry
+	}
+	if data, err = json.Marshal(&cache); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCacheFileInvalidation(t *testing.T) {
+	const src = "package rp\n\nimport \"errors\"\n\nvar NotFoundError error = errors.New(\"not found\")\n"
+	tests := []struct {
+		name       string
+		change     func(t *testing.T, root string, c *Checker)
+		wantCached int
+	}{
+		{
+			name:       "unchanged",
+			change:     func(t *testing.T, root string, c *Checker) {},
+			wantCached: 2,
+		},
+		{
+			name: "one file changed",
+			change: func(t *testing.T, root string, c *Checker) {
+				writeTree(t, root, map[string]string{"a.go": src + "\n// changed\n"})
+			},
+			wantCached: 1,
+		},
+		{
+			name: "config changed",
+			change: func(t *testing.T, root string, c *Checker) {
+				c.config.Naming.Rules.ErrorVar.Pattern = "^Err"
+				c.config.Naming.Rules.ErrorVar.Severity = "error"
+			},
+			wantCached: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			cachePath := filepath.Join(t.TempDir(), DefaultCacheFile)
+			writeTree(t, root, map[string]string{"a.go": src, "b.go": strings.Replace(src, "NotFound", "Missing", 1)})
+
+			c := NewChecker(errorVarConfig())
+			c.SetCache(cachePath)
+			if _, err := c.Check(root); err != nil {
+				t.Fatal(err)
+			}
+			markCachedFile(t, cachePath)
+
+			c = NewChecker(errorVarConfig())
+			c.SetCache(cachePath)
+			tt.change(t, root, c)
+			cached, total := cachedMessages(t, c, root)
+			if total != 2 {
+				t.Fatalf("violations = %d, want 2", total)
+			}
+			if cached != tt.wantCached {
+				t.Errorf("cached violations = %d, want %d", cached, tt.wantCached)
+			}
+		})
+	}
+}
+
+// TestCacheFilePrunesRemovedFiles 全ファイルをチェックした場合は削除したファイルの結果をキャッシュから削除する
+func TestCacheFilePrunesRemovedFiles(t *testing.T) {
+	root := t.TempDir()
+	cachePath := filepath.Join(t.TempDir(), DefaultCacheFile)
+	writeTree(t, root, map[string]string{"a.go": "package rp\n", "b.go": "package rp\n"})
+
+	for _, remove := range []string{"", "b.go"} {
+		if remove != "" {
+			if err := os.Remove(filepath.Join(root, remove)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		c := NewChecker(errorVarConfig())
+		c.SetCache(cachePath)
+		if _, err := c.Check(root); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cache analysisCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Files["b.go"]; ok || len(cache.Files) != 1 {
+		t.Errorf("cached files = %v, want only a.go", cache.Files)
+	}
+}
+
+func TestCacheDirInvalidation(t *testing.T) {
+	const src = "package rp\n\nimport \"errors\"\n\nvar NotFoundError error = errors.New(\"not found\")\n"
+	tests := []struct {
+		name    string
+		change  func(t *testing.T, root string, c *Checker)
+		wantHit bool
+	}{
+		{
+			name:    "unchanged",
+			change:  func(t *testing.T, root string, c *Checker) {},
+			wantHit: true,
+		},
+		{
+			name: "file content changed",
+			change: func(t *testing.T, root string, c *Checker) {
+				writeTree(t, root, map[string]string{"a.go": src + "\n// changed\n"})
+			},
+		},
+		{
+			name: "config changed",
+			change: func(t *testing.T, root string, c *Checker) {
+				c.config.Naming.Rules.ErrorVar.Severity = "error"
+			},
+		},
+		{
+			name: "fixing",
+			change: func(t *testing.T, root string, c *Checker) {
+				c.SetFix(true)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, cacheDir := t.TempDir(), t.TempDir()
+			writeTree(t, root, map[string]string{"a.go": src})
+
+			c := NewChecker(errorVarConfig())
+			c.SetCacheDir(cacheDir)
+			if _, err := c.Check(root); err != nil {
+				t.Fatal(err)
+			}
+			markCachedDir(t, cacheDir)
+
+			c = NewChecker(errorVarConfig())
+			c.SetCacheDir(cacheDir)
+			tt.change(t, root, c)
+			cached, total := cachedMessages(t, c, root)
+			if total != 1 {
+				t.Fatalf("violations = %d, want 1", total)
+			}
+			if hit := cached == total; hit != tt.wantHit {
+				t.Errorf("cache hit = %v, want %v", hit, tt.wantHit)
+			}
+		})
+	}
+}
+
+func TestClearCache(t *testing.T) {
+	dir := t.TempDir()
+	entry := strings.Repeat("ab", 32) + ".json"
+	writeTree(t, dir, map[string]string{
+		"ab/" + entry:            "{}",
+		"ab/.tmp-123":            "",
+		"cd/" + "cd" + entry[2:]: "{}",
+		"cd/notes.txt":           "keep",
+		"go.mod":                 "module example.com/rp\n",
+		"src/main.go":            "package main\n",
+		"zz/" + entry:            "{}",
+	})
+
+	if err := ClearCache(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, wantExist := range map[string]bool{
+		"ab":                     false,
+		"cd/" + "cd" + entry[2:]: false,
+		"cd/notes.txt":           true,
+		"go.mod":                 true,
+		"src/main.go":            true,
+		"zz/" + entry:            true,
+	} {
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+		if exist := err == nil; exist != wantExist {
+			t.Errorf("%s exists = %v, want %v", name, exist, wantExist)
+		}
+	}
+
+	if err := ClearCache(filepath.Join(dir, "missing")); err != nil {
+		t.Errorf("ClearCache(missing) = %v, want nil", err)
+	}
+}
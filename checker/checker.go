@@ -2,14 +2,22 @@ package checker
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
-	"os"
+	"go/types"
+	"io/fs"
+	"log"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/go-standards-checker/report"
 	"github.com/go-standards-checker/rules"
@@ -20,7 +28,52 @@ type Checker struct {
 	config  *rules.Config
 	report  *report.Report
 	fset    *token.FileSet
-	fileMap map[string][]string // ファイル名→行内容のマップ
+	mu      *sync.Mutex          // fileMap・srcMap・astMapの保護（ワーカー間で共有）
+	fileMap map[string][]string  // ファイル名→行内容のマップ（プロジェクト単位の検査用）
+	srcMap  map[string][]byte    // ファイル名→ソースのマップ（プロジェクト単位の検査用）
+	astMap  map[string]*ast.File // ファイル名→ASTのマップ（事前解析・プロジェクト単位の検査用）
+	info    *types.Info          // 型情報（-typed モード時のみ）
+
+	// ワーカーごとのコピーのみが持つファイル単位の状態
+	ctx          *FileContext   // チェック中のファイルのコンテキスト
+	file         *ast.File      // チェック中のファイル
+	suppressions []*suppression // チェック中のファイルの抑制コメント
+	clock        *ruleClock     // チェック中のファイルのルールごとの処理時間（計測時のみ）
+
+	rootDir    string // チェック対象のルートディレクトリ
+	modulePath string // go.modのモジュールパス（無ければ空）
+
+	fsys     fs.FS             // チェック対象のファイルシステム（nilの場合はOS）
+	files    map[string]bool   // SetFilesで限定したチェック対象（nilの場合はすべて）
+	pkgFiles []string          // SetPackageで指定した解析済みのファイル（nilの場合はディレクトリを走査する）
+	overlay  map[string][]byte // SetOverlayで指定したファイルの内容（ディスク上の内容より優先）
+	logger   *log.Logger       // 警告の出力先（nilの場合は標準出力）
+	sink     report.Sink       // 違反の逐次出力先（nilの場合はレポートに保持）
+
+	timings *Timings // ルール・ファイルごとの処理時間の集計（nilの場合は計測しない）
+
+	cachePath string         // 解析結果のキャッシュファイル（空の場合はキャッシュしない）
+	cacheDir  string         // 解析結果のキャッシュディレクトリ（空でなければcachePathより優先）
+	cache     *analysisCache // 読み込んだキャッシュ
+
+	fixing bool // 他のファイルを変更する修正も求める（SetFix）
+
+	loggerImports    []loggerImport             // ロギングライブラリのimport箇所
+	fileSuppressions map[string][]*suppression  // ファイル名→抑制コメント（プロジェクト単位の検査用）
+	lambdaHandlers   map[string]map[string]bool // ディレクトリ→Lambdaハンドラ名
+	lambdaCallTree   map[string]map[string]bool // ディレクトリ→ハンドラから到達できる関数名
+
+	extraRules  []Rule           // AddRuleで追加されたルール
+	pluginRules []Rule           // 設定のpluginsから読み込んだルール
+	plugins     []*processPlugin // 起動中の外部プロセスのプラグイン
+	ruleSet     ruleSet          // 有効なルール
+	patterns    *rules.Patterns  // 設定中のコンパイル済みの正規表現
+	customRules []customRule     // 有効なカスタムルール
+	secretAllow *secretAllowlist // hardcoded_secretsの許可リスト（nilの場合は許可しない）
+
+	projectRules []projectRule // 有効なプロジェクト固有ルール
+
+	overrideConfigs map[string]*overrideConfig // 一致したoverridesの組み合わせ→適用した設定（muで保護、ワーカー間で共有）
 }
 
 // NewChecker チェッカーを作成
@@ -28,154 +81,248 @@ func NewChecker(config *rules.Config) *Checker {
 	return &Checker{
 		config:  config,
 		fset:    token.NewFileSet(),
+		mu:      &sync.Mutex{},
 		fileMap: make(map[string][]string),
+		srcMap:  make(map[string][]byte),
+		astMap:  make(map[string]*ast.File),
 	}
 }
 
 // Check ディレクトリをチェック
 func (c *Checker) Check(targetDir string) (*report.Report, error) {
-	c.report = report.NewReport(targetDir)
+	return c.CheckContext(context.Background(), targetDir)
+}
 
-	// ディレクトリ構成チェック
-	if c.config.Directory.Enabled {
-		c.checkDirectory(targetDir)
+// CheckContext ディレクトリをチェック
+//
+// ctxがキャンセルされた場合は走査・チェックを打ち切り、それまでにチェックを終えたファイルの結果の
+// レポートとctx.Err()を返す（プロジェクト単位のチェックとキャッシュの保存は行わない）
+func (c *Checker) CheckContext(ctx context.Context, targetDir string) (*report.Report, error) {
+	c.report = report.NewReport(targetDir)
+	c.report.SetLanguage(c.config.Settings.Language)
+	if c.sink != nil {
+		c.report.SetSink(c.sink)
 	}
+	c.rootDir = targetDir
+	c.modulePath = c.readModulePath(targetDir)
 
-	// Goファイルを収集
-	goFiles, err := c.collectGoFiles(targetDir)
+	// 外部ルールプラグインを読み込む（読み込みに失敗した場合も起動済みのプロセスは終了する）
+	defer c.closePlugins()
+	if err := c.loadPlugins(); err != nil {
+		return nil, fmt.Errorf("failed to load plugins: %w", err)
+	}
+	patterns, err := c.config.Patterns()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	c.patterns = patterns
+	c.ruleSet = c.resolveRules()
+	c.compileCustomRules()
+	if err := c.compileProjectRules(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if err := c.compileOverrides(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if err := c.loadSecretAllowlist(targetDir); err != nil {
+		return nil, fmt.Errorf("invalid secrets allowlist: %w", err)
+	}
+	c.cache = c.loadCache(targetDir)
+
+	// ファイルの収集 → ワーカーでの解析・チェック → 結果の集約
+	result, err := c.runPipeline(ctx, targetDir)
+	if err := ctx.Err(); err != nil {
+		c.report.TotalFiles = result.checked
+		c.report.SkippedGenerated = result.generated
+		c.report.Finalize()
+		return c.report, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to collect Go files: %w", err)
 	}
-
-	c.report.TotalFiles = len(goFiles)
-
-	// 各ファイルをチェック
-	for _, filePath := range goFiles {
-		if err := c.checkFile(filePath); err != nil {
-			fmt.Printf("Warning: failed to check %s: %v\n", filePath, err)
-		}
+	c.report.TotalFiles = len(result.files)
+	c.report.SkippedGenerated = result.generated
+
+	// プロジェクト単位のチェック
+	projectCtx := &ProjectContext{
+		Root:       targetDir,
+		ModulePath: c.modulePath,
+		Files:      result.files,
+		Config:     c.config,
+		c:          c,
 	}
-
-	// カスタムルールチェック
-	for _, filePath := range goFiles {
-		c.checkCustomRules(filePath)
+	if err := c.runProjectRules(ctx, projectCtx); err != nil {
+		c.report.Finalize()
+		return c.report, err
 	}
 
+	c.saveCache(c.cache)
+
 	c.report.Finalize()
 	return c.report, nil
 }
 
-// collectGoFiles Goファイルを収集
-func (c *Checker) collectGoFiles(dir string) ([]string, error) {
-	var files []string
-
-	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
+// runProjectRules プロジェクト単位のルールを実行し、違反に抑制コメントを適用してレポートに加える
+func (c *Checker) runProjectRules(ctx context.Context, projectCtx *ProjectContext) error {
+	reported := c.report
+	c.report = report.NewReport(projectCtx.Root)
+	defer func() {
+		project := c.report
+		c.report = reported
+		c.applyProjectSuppressions(projectCtx.Files, project.Violations, ctx.Err() == nil)
+	}()
+
+	for _, rule := range c.ruleSet.project {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
+		start := c.timings.now()
+		rule.CheckProject(projectCtx)
+		c.timings.addRule(rule, start)
+	}
+	return nil
+}
 
-		// ディレクトリはスキップ判定のみ
-		if info.IsDir() {
-			// 除外パターンにマッチするディレクトリをスキップ
-			for _, pattern := range c.config.Settings.ExcludePatterns {
-				if matched, _ := filepath.Match(pattern, info.Name()); matched {
-					return filepath.SkipDir
-				}
-				if matched, _ := filepath.Match(pattern, path); matched {
-					return filepath.SkipDir
-				}
-			}
-			return nil
-		}
+// collectGoFiles Goファイルを収集（戻り値は収集したファイルとスキップした自動生成ファイルの数）
+func (c *Checker) collectGoFiles(ctx context.Context, dir string) ([]string, int, error) {
+	var files []string
+	generated, err := c.walkGoFiles(ctx, dir, func(path string) {
+		files = append(files, path)
+	})
+	return files, generated, err
+}
 
-		// .goファイルのみ
-		if !strings.HasSuffix(path, ".go") {
-			return nil
-		}
+// checkFile 単一ファイルをチェック（ワーカーごとのコピーで呼び出す）
+// ctxがキャンセルされた場合は関数宣言の単位で打ち切り、ctx.Err()を返す
+func (c *Checker) checkFile(ctx context.Context, filePath string) error {
+	file, err := c.parseFile(filePath)
+	if err != nil {
+		return err
+	}
+	fctx := c.fileContext(filePath, file)
+	c.ctx = fctx
+	c.file = file
+	c.suppressions = c.parseSuppressions(file, filePath)
+	defer c.applySuppressions(filePath)
+
+	// ルールごとの処理時間の計測（計測しない場合はnil）
+	nFile := len(c.ruleSet.file)
+	clock := c.timings.newClock(c.ruleSet)
+	c.clock = clock
+
+	// ファイル単位のチェック
+	for i, rule := range c.ruleSet.file {
+		start := clock.start()
+		rule.CheckFile(fctx)
+		clock.stop(i, start)
+	}
 
-		// 除外パターンチェック
-		relPath, _ := filepath.Rel(dir, path)
-		for _, pattern := range c.config.Settings.ExcludePatterns {
-			if matched, _ := filepath.Match(pattern, filepath.Base(path)); matched {
-				return nil
+	// ノード単位のチェック（全ルールで1回の走査を共有）
+	if len(c.ruleSet.node) > 0 {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if n == nil {
+				return true
 			}
-			if matched, _ := filepath.Match(pattern, relPath); matched {
-				return nil
+			if _, ok := n.(*ast.FuncDecl); ok && ctx.Err() != nil {
+				return false
 			}
-		}
-
-		files = append(files, path)
-		return nil
-	})
+			for i, rule := range c.ruleSet.node {
+				start := clock.start()
+				rule.CheckNode(fctx, n)
+				clock.stop(nFile+i, start)
+			}
+			return true
+		})
+	}
 
-	return files, err
+	return ctx.Err()
 }
 
-// checkFile 単一ファイルをチェック
-func (c *Checker) checkFile(filePath string) error {
+// parseFile ファイルを読み込んでASTを返す（解析済みであれば再利用）
+func (c *Checker) parseFile(filePath string) (*ast.File, error) {
+	c.mu.Lock()
+	file, ok := c.astMap[filePath]
+	c.mu.Unlock()
+	if ok {
+		return file, nil
+	}
+
 	// ファイル内容を読み込み
-	lines, err := c.readFileLines(filePath)
+	src, err := c.loadFile(filePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c.fileMap[filePath] = lines
 
 	// AST解析
-	file, err := parser.ParseFile(c.fset, filePath, nil, parser.ParseComments)
+	file, err = parser.ParseFile(c.fset, filePath, src, parser.ParseComments)
 	if err != nil {
-		return fmt.Errorf("parse error: %w", err)
+		return nil, fmt.Errorf("parse error: %w", err)
 	}
+	c.mu.Lock()
+	c.astMap[filePath] = file
+	c.mu.Unlock()
+	return file, nil
+}
 
-	// ファイル名チェック
-	if c.config.Naming.Enabled && c.config.Naming.Rules.FileName.Enabled {
-		c.checkFileName(filePath)
+// loadFile ファイルを読み込んでソースを返す（読み込み済みであれば再利用）
+func (c *Checker) loadFile(filePath string) ([]byte, error) {
+	c.mu.Lock()
+	src, ok := c.srcMap[filePath]
+	c.mu.Unlock()
+	if ok {
+		return src, nil
 	}
 
-	// パッケージ名チェック
-	if c.config.Naming.Enabled && c.config.Naming.Rules.PackageName.Enabled {
-		c.checkPackageName(file, filePath)
+	src, lines, err := c.readFile(filePath)
+	if err != nil {
+		return nil, err
 	}
-
-	// 各種チェック
-	ast.Inspect(file, func(n ast.Node) bool {
-		switch node := n.(type) {
-		case *ast.FuncDecl:
-			c.checkFunction(node, filePath)
-		case *ast.GenDecl:
-			c.checkGenDecl(node, filePath)
-		case *ast.TypeSpec:
-			c.checkTypeSpec(node, filePath)
-		case *ast.AssignStmt:
-			c.checkAssignment(node, filePath)
-		case *ast.CallExpr:
-			c.checkCallExpr(node, filePath)
-		}
-		return true
-	})
-
-	return nil
+	c.mu.Lock()
+	c.fileMap[filePath] = lines
+	c.srcMap[filePath] = src
+	c.mu.Unlock()
+	return src, nil
 }
 
-// readFileLines ファイルを行単位で読み込み
-func (c *Checker) readFileLines(filePath string) ([]string, error) {
-	file, err := os.Open(filePath)
+// readFile ファイルを読み込み、ソースと行単位の内容を返す
+func (c *Checker) readFile(filePath string) ([]byte, []string, error) {
+	src, err := c.readSource(filePath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer file.Close()
 
 	var lines []string
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(bytes.NewReader(src))
 	for scanner.Scan() {
 		lines = append(lines, scanner.Text())
 	}
-	return lines, scanner.Err()
+	return src, lines, scanner.Err()
+}
+
+// fileLines ファイルの行単位の内容（チェック中のファイルはコンテキストから取得）
+func (c *Checker) fileLines(filePath string) []string {
+	if c.ctx != nil && c.ctx.Path == filePath {
+		return c.ctx.Lines
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fileMap[filePath]
+}
+
+// fileSource ファイルのソース（チェック中のファイルはコンテキストから取得）
+func (c *Checker) fileSource(filePath string) []byte {
+	if c.ctx != nil && c.ctx.Path == filePath {
+		return c.ctx.Src
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.srcMap[filePath]
 }
 
 // getCodeLine 指定行のコードを取得
 func (c *Checker) getCodeLine(filePath string, line int) string {
-	lines, ok := c.fileMap[filePath]
-	if !ok || line < 1 || line > len(lines) {
+	lines := c.fileLines(filePath)
+	if line < 1 || line > len(lines) {
 		return ""
 	}
 	return lines[line-1]
@@ -189,12 +336,15 @@ func (c *Checker) checkFileName(filePath string) {
 	fileName := filepath.Base(filePath)
 	rule := c.config.Naming.Rules.FileName
 
-	pattern, err := regexp.Compile(rule.Pattern)
-	if err != nil {
-		return
-	}
-
-	if !pattern.MatchString(fileName) {
+	if !c.patterns.FileName.MatchString(fileName) {
+		newName := toSnakeCase(strings.TrimSuffix(fileName, ".go")) + ".go"
+		var fix *report.Fix
+		if c.patterns.FileName.MatchString(newName) {
+			fix = &report.Fix{
+				Description: fmt.Sprintf("ファイル名を%sに変更", newName),
+				Rename:      filepath.Join(filepath.Dir(filePath), newName),
+			}
+		}
 		c.report.AddViolation(report.Violation{
 			File:       filePath,
 			Line:       1,
@@ -202,7 +352,8 @@ func (c *Checker) checkFileName(filePath string) {
 			Category:   "naming",
 			Severity:   rules.ParseSeverity(rule.Severity),
 			Message:    rule.Message,
-			Suggestion: fmt.Sprintf("Rename to: %s", toSnakeCase(strings.TrimSuffix(fileName, ".go"))+".go"),
+			Suggestion: fmt.Sprintf("Rename to: %s", newName),
+			Fix:        fix,
 		})
 	}
 }
@@ -215,22 +366,17 @@ func (c *Checker) checkPackageName(file *ast.File, filePath string) {
 	rule := c.config.Naming.Rules.PackageName
 	pkgName := file.Name.Name
 
-	pattern, err := regexp.Compile(rule.Pattern)
-	if err != nil {
-		return
-	}
-
-	if !pattern.MatchString(pkgName) {
+	if !c.patterns.PackageName.MatchString(pkgName) {
 		pos := c.fset.Position(file.Name.Pos())
 		c.report.AddViolation(report.Violation{
-			File:       filePath,
-			Line:       pos.Line,
-			Column:     pos.Column,
-			Rule:       "package_name",
-			Category:   "naming",
-			Severity:   rules.ParseSeverity(rule.Severity),
-			Message:    fmt.Sprintf("%s: '%s'", rule.Message, pkgName),
-			Code:       c.getCodeLine(filePath, pos.Line),
+			File:     filePath,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Rule:     "package_name",
+			Category: "naming",
+			Severity: rules.ParseSeverity(rule.Severity),
+			Message:  fmt.Sprintf("%s: '%s'", rule.Message, pkgName),
+			Code:     c.getCodeLine(filePath, pos.Line),
 		})
 	}
 }
@@ -239,111 +385,161 @@ func (c *Checker) checkPackageName(file *ast.File, filePath string) {
 // 関数チェック
 // ========================================
 
-func (c *Checker) checkFunction(fn *ast.FuncDecl, filePath string) {
+// checkExportedFuncName 公開関数名のPascalCaseチェック
+func (c *Checker) checkExportedFuncName(fn *ast.FuncDecl, filePath string) {
+	funcName := fn.Name.Name
+	if !ast.IsExported(funcName) || isPascalCase(funcName) {
+		return
+	}
+	pos := c.fset.Position(fn.Pos())
+	c.report.AddViolation(report.Violation{
+		File:     filePath,
+		Line:     pos.Line,
+		Column:   pos.Column,
+		Rule:     "exported_name",
+		Category: "naming",
+		Severity: rules.ParseSeverity(c.config.Naming.Rules.ExportedNames.Severity),
+		Message:  fmt.Sprintf("公開関数 '%s' はPascalCaseで命名してください", funcName),
+		Code:     c.getCodeLine(filePath, pos.Line),
+	})
+}
+
+// checkFunctionLines 関数行数チェック
+func (c *Checker) checkFunctionLines(fn *ast.FuncDecl, filePath string) {
 	pos := c.fset.Position(fn.Pos())
 	endPos := c.fset.Position(fn.End())
-	funcName := fn.Name.Name
+	lineCount := endPos.Line - pos.Line
+	limit := c.config.Structure.Rules.MaxFunctionLines.Limit
 
-	// 関数名チェック（公開/非公開）
-	if c.config.Naming.Enabled && c.config.Naming.Rules.ExportedNames.Enabled {
-		if ast.IsExported(funcName) {
-			// PascalCaseチェック
-			if !isPascalCase(funcName) {
-				c.report.AddViolation(report.Violation{
-					File:     filePath,
-					Line:     pos.Line,
-					Column:   pos.Column,
-					Rule:     "exported_name",
-					Category: "naming",
-					Severity: rules.ParseSeverity(c.config.Naming.Rules.ExportedNames.Severity),
-					Message:  fmt.Sprintf("公開関数 '%s' はPascalCaseで命名してください", funcName),
-					Code:     c.getCodeLine(filePath, pos.Line),
-				})
-			}
-		}
+	if lineCount > limit {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Rule:       "max_function_lines",
+			Category:   "structure",
+			Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxFunctionLines.Severity),
+			Message:    fmt.Sprintf("関数 '%s' は%d行あります（上限: %d行）", fn.Name.Name, lineCount, limit),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "関数を分割してください",
+		})
 	}
+}
 
-	// 関数行数チェック
-	if c.config.Structure.Enabled && c.config.Structure.Rules.MaxFunctionLines.Enabled {
-		lineCount := endPos.Line - pos.Line
-		limit := c.config.Structure.Rules.MaxFunctionLines.Limit
+// checkParameterCount パラメータ数チェック
+func (c *Checker) checkParameterCount(fn *ast.FuncDecl, filePath string) {
+	if fn.Type.Params == nil {
+		return
+	}
+	pos := c.fset.Position(fn.Pos())
+	paramCount := len(fn.Type.Params.List)
+	limit := c.config.Structure.Rules.MaxParameters.Limit
 
-		if lineCount > limit {
-			c.report.AddViolation(report.Violation{
-				File:       filePath,
-				Line:       pos.Line,
-				Rule:       "max_function_lines",
-				Category:   "structure",
-				Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxFunctionLines.Severity),
-				Message:    fmt.Sprintf("関数 '%s' は%d行あります（上限: %d行）", funcName, lineCount, limit),
-				Code:       c.getCodeLine(filePath, pos.Line),
-				Suggestion: "関数を分割してください",
-			})
-		}
+	if paramCount > limit {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Rule:       "max_parameters",
+			Category:   "structure",
+			Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxParameters.Severity),
+			Message:    fmt.Sprintf("関数 '%s' のパラメータ数は%d個です（上限: %d個）", fn.Name.Name, paramCount, limit),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "パラメータを構造体にまとめることを検討してください",
+		})
 	}
+}
 
-	// パラメータ数チェック
-	if c.config.Structure.Enabled && c.config.Structure.Rules.MaxParameters.Enabled {
-		if fn.Type.Params != nil {
-			paramCount := len(fn.Type.Params.List)
-			limit := c.config.Structure.Rules.MaxParameters.Limit
-
-			if paramCount > limit {
-				c.report.AddViolation(report.Violation{
-					File:       filePath,
-					Line:       pos.Line,
-					Rule:       "max_parameters",
-					Category:   "structure",
-					Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxParameters.Severity),
-					Message:    fmt.Sprintf("関数 '%s' のパラメータ数は%d個です（上限: %d個）", funcName, paramCount, limit),
-					Code:       c.getCodeLine(filePath, pos.Line),
-					Suggestion: "パラメータを構造体にまとめることを検討してください",
-				})
-			}
-		}
+// checkReturnValueCount 戻り値数チェック
+func (c *Checker) checkReturnValueCount(fn *ast.FuncDecl, filePath string) {
+	if fn.Type.Results == nil {
+		return
 	}
+	pos := c.fset.Position(fn.Pos())
+	resultCount := len(fn.Type.Results.List)
+	limit := c.config.Structure.Rules.MaxReturnValues.Limit
 
-	// 戻り値数チェック
-	if c.config.Structure.Enabled && c.config.Structure.Rules.MaxReturnValues.Enabled {
-		if fn.Type.Results != nil {
-			resultCount := len(fn.Type.Results.List)
-			limit := c.config.Structure.Rules.MaxReturnValues.Limit
-
-			if resultCount > limit {
-				c.report.AddViolation(report.Violation{
-					File:       filePath,
-					Line:       pos.Line,
-					Rule:       "max_return_values",
-					Category:   "structure",
-					Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxReturnValues.Severity),
-					Message:    fmt.Sprintf("関数 '%s' の戻り値数は%d個です（上限: %d個）", funcName, resultCount, limit),
-					Code:       c.getCodeLine(filePath, pos.Line),
-					Suggestion: "戻り値を構造体にまとめることを検討してください",
-				})
-			}
-		}
+	if resultCount > limit {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Rule:       "max_return_values",
+			Category:   "structure",
+			Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxReturnValues.Severity),
+			Message:    fmt.Sprintf("関数 '%s' の戻り値数は%d個です（上限: %d個）", fn.Name.Name, resultCount, limit),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "戻り値を構造体にまとめることを検討してください",
+		})
 	}
+}
 
-	// ネストレベルチェック
-	if c.config.Structure.Enabled && c.config.Structure.Rules.MaxNestingLevel.Enabled {
-		maxNest := c.checkNestingLevel(fn.Body, 0)
-		limit := c.config.Structure.Rules.MaxNestingLevel.Limit
+// checkFunctionNesting ネストレベルチェック
+func (c *Checker) checkFunctionNesting(fn *ast.FuncDecl, filePath string) {
+	pos := c.fset.Position(fn.Pos())
+	maxNest := c.checkNestingLevel(fn.Body, 0)
+	limit := c.config.Structure.Rules.MaxNestingLevel.Limit
 
-		if maxNest > limit {
-			c.report.AddViolation(report.Violation{
-				File:       filePath,
-				Line:       pos.Line,
-				Rule:       "max_nesting_level",
-				Category:   "structure",
-				Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxNestingLevel.Severity),
-				Message:    fmt.Sprintf("関数 '%s' のネストレベルは%dです（上限: %d）", funcName, maxNest, limit),
-				Code:       c.getCodeLine(filePath, pos.Line),
-				Suggestion: "早期リターンを使用してネストを浅くしてください",
-			})
-		}
+	if maxNest > limit {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Rule:       "max_nesting_level",
+			Category:   "structure",
+			Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxNestingLevel.Severity),
+			Message:    fmt.Sprintf("関数 '%s' のネストレベルは%dです（上限: %d）", fn.Name.Name, maxNest, limit),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "早期リターンを使用してネストを浅くしてください",
+		})
+	}
+}
+
+// checkCyclomaticComplexity 循環的複雑度チェック
+func (c *Checker) checkCyclomaticComplexity(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil {
+		return
+	}
+	pos := c.fset.Position(fn.Pos())
+	complexity := cyclomaticComplexity(fn.Body)
+	limit := c.config.Structure.Rules.MaxCyclomatic.Limit
+
+	if complexity > limit {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Rule:       "max_cyclomatic_complexity",
+			Category:   "structure",
+			Severity:   rules.ParseSeverity(c.config.Structure.Rules.MaxCyclomatic.Severity),
+			Message:    fmt.Sprintf("関数 '%s' の循環的複雑度は%dです（上限: %d）", fn.Name.Name, complexity, limit),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "条件分岐を小さな関数に分割するか、テーブル駆動・早期リターンで分岐を減らしてください",
+		})
 	}
 }
 
+// cyclomaticComplexity 循環的複雑度（1 + if・for・range・case・select のcase・&&・|| の数）
+// 関数リテラル内の分岐も含める
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if n.List != nil { // defaultは数えない
+				complexity++
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
 // checkNestingLevel ネストレベルを計算
 func (c *Checker) checkNestingLevel(block *ast.BlockStmt, currentLevel int) int {
 	if block == nil {
@@ -395,42 +591,33 @@ func (c *Checker) checkNestingLevel(block *ast.BlockStmt, currentLevel int) int
 // 型定義チェック
 // ========================================
 
-func (c *Checker) checkTypeSpec(ts *ast.TypeSpec, filePath string) {
+// checkInterfaceName インタフェース名のサフィックスチェック
+func (c *Checker) checkInterfaceName(ts *ast.TypeSpec, filePath string) {
+	if _, ok := ts.Type.(*ast.InterfaceType); !ok {
+		return
+	}
 	pos := c.fset.Position(ts.Pos())
 	typeName := ts.Name.Name
-
-	// インタフェース名チェック
-	if _, ok := ts.Type.(*ast.InterfaceType); ok {
-		if c.config.Naming.Enabled && c.config.Naming.Rules.InterfaceName.Enabled {
-			rule := c.config.Naming.Rules.InterfaceName
-			validSuffix := false
-			for _, suffix := range rule.Suffixes {
-				if strings.HasSuffix(typeName, suffix) {
-					validSuffix = true
-					break
-				}
-			}
-
-			if !validSuffix && ast.IsExported(typeName) {
-				c.report.AddViolation(report.Violation{
-					File:       filePath,
-					Line:       pos.Line,
-					Column:     pos.Column,
-					Rule:       "interface_name",
-					Category:   "naming",
-					Severity:   rules.ParseSeverity(rule.Severity),
-					Message:    fmt.Sprintf("インタフェース '%s' は標準的なサフィックス(%v)を使用してください", typeName, rule.Suffixes),
-					Code:       c.getCodeLine(filePath, pos.Line),
-				})
-			}
+	rule := c.config.Naming.Rules.InterfaceName
+	validSuffix := false
+	for _, suffix := range rule.Suffixes {
+		if strings.HasSuffix(typeName, suffix) {
+			validSuffix = true
+			break
 		}
 	}
 
-	// 構造体タグチェック
-	if st, ok := ts.Type.(*ast.StructType); ok {
-		if c.config.StructTags.Enabled {
-			c.checkStructTags(st, typeName, filePath)
-		}
+	if !validSuffix && ast.IsExported(typeName) {
+		c.report.AddViolation(report.Violation{
+			File:     filePath,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Rule:     "interface_name",
+			Category: "naming",
+			Severity: rules.ParseSeverity(rule.Severity),
+			Message:  fmt.Sprintf("インタフェース '%s' は標準的なサフィックス(%v)を使用してください", typeName, rule.Suffixes),
+			Code:     c.getCodeLine(filePath, pos.Line),
+		})
 	}
 }
 
@@ -438,57 +625,80 @@ func (c *Checker) checkTypeSpec(ts *ast.TypeSpec, filePath string) {
 // 構造体タグチェック
 // ========================================
 
-func (c *Checker) checkStructTags(st *ast.StructType, structName string, filePath string) {
-	if st.Fields == nil {
+// forEachTag 構造体のタグ付きフィールドごとにタグと位置を渡す
+func (c *Checker) forEachTag(ts *ast.TypeSpec, fn func(tagValue string, pos token.Position)) {
+	c.forEachTagLit(ts, func(tag *ast.BasicLit, pos token.Position) {
+		fn(tag.Value, pos)
+	})
+}
+
+// forEachTagLit 構造体のタグ付きフィールドごとにタグのリテラルと位置を渡す（タグを書き換える修正用）
+func (c *Checker) forEachTagLit(ts *ast.TypeSpec, fn func(tag *ast.BasicLit, pos token.Position)) {
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
 		return
 	}
-
 	for _, field := range st.Fields.List {
-		if field.Tag == nil {
-			continue
+		if field.Tag != nil {
+			fn(field.Tag, c.fset.Position(field.Pos()))
 		}
+	}
+}
 
-		pos := c.fset.Position(field.Pos())
-		tagValue := field.Tag.Value
-
-		// JSONタグチェック
-		if c.config.StructTags.Rules.JSONTag.Enabled {
-			c.checkJSONTag(tagValue, structName, filePath, pos)
-		}
+// checkJSONTags JSONタグチェック
+func (c *Checker) checkJSONTags(ts *ast.TypeSpec, filePath string) {
+	c.forEachTagLit(ts, func(tag *ast.BasicLit, pos token.Position) {
+		c.checkJSONTag(tag, ts.Name.Name, filePath, pos)
+	})
+}
 
-		// バリデーションタグチェック
-		if c.config.StructTags.Rules.ValidationTag.Enabled {
-			c.checkValidationTag(tagValue, structName, filePath, pos)
-		}
-	}
+// checkValidationTags バリデーションタグチェック
+func (c *Checker) checkValidationTags(ts *ast.TypeSpec, filePath string) {
+	c.forEachTag(ts, func(tagValue string, pos token.Position) {
+		c.checkValidationTag(tagValue, ts.Name.Name, filePath, pos)
+	})
 }
 
-func (c *Checker) checkJSONTag(tagValue, structName, filePath string, pos token.Position) {
+// jsonTagRe タグ内のjsonキーの値
+var jsonTagRe = regexp.MustCompile(`json:"([^"]+)"`)
+
+func (c *Checker) checkJSONTag(tag *ast.BasicLit, structName, filePath string, pos token.Position) {
 	rule := c.config.StructTags.Rules.JSONTag
 
 	// json:"xxx" を抽出
-	jsonTagRe := regexp.MustCompile(`json:"([^"]+)"`)
-	matches := jsonTagRe.FindStringSubmatch(tagValue)
-	if len(matches) < 2 {
+	matches := jsonTagRe.FindStringSubmatchIndex(tag.Value)
+	if matches == nil {
 		return
 	}
 
-	jsonName := strings.Split(matches[1], ",")[0]
+	jsonName := strings.Split(tag.Value[matches[2]:matches[3]], ",")[0]
 	if jsonName == "-" || jsonName == "" {
 		return
 	}
 
 	var isValid bool
+	newName := jsonName
 	switch rule.Style {
 	case "snake_case":
 		isValid = isSnakeCase(jsonName)
+		newName = toSnakeCase(jsonName)
 	case "camelCase":
 		isValid = isCamelCase(jsonName)
+		newName = toCamelCase(toSnakeCase(jsonName))
 	default:
 		isValid = true
 	}
 
 	if !isValid {
+		// バッククォートのタグのみ名前を置き換える（変換しても規則を満たさない場合は修正しない）
+		var fix *report.Fix
+		if strings.HasPrefix(tag.Value, "`") && (isSnakeCase(newName) || isCamelCase(newName)) {
+			start := c.fset.Position(tag.Pos()).Offset + matches[2]
+			fix = &report.Fix{
+				Description: fmt.Sprintf("jsonタグの名前を%sに変更", newName),
+				Edits:       []report.TextEdit{{Start: start, End: start + len(jsonName), NewText: newName}},
+			}
+		}
 		c.report.AddViolation(report.Violation{
 			File:       filePath,
 			Line:       pos.Line,
@@ -498,7 +708,8 @@ func (c *Checker) checkJSONTag(tagValue, structName, filePath string, pos token.
 			Severity:   rules.ParseSeverity(rule.Severity),
 			Message:    fmt.Sprintf("JSONタグ '%s' は%sで命名してください", jsonName, rule.Style),
 			Code:       c.getCodeLine(filePath, pos.Line),
-			Suggestion: fmt.Sprintf("json:\"%s\"", toSnakeCase(jsonName)),
+			Suggestion: fmt.Sprintf("json:\"%s\"", newName),
+			Fix:        fix,
 		})
 	}
 }
@@ -522,14 +733,14 @@ func (c *Checker) checkValidationTag(tagValue, structName, filePath string, pos
 	// validateタグがあるかチェック
 	if !strings.Contains(tagValue, `validate:"`) {
 		c.report.AddViolation(report.Violation{
-			File:       filePath,
-			Line:       pos.Line,
-			Column:     pos.Column,
-			Rule:       "validation_tag",
-			Category:   "struct_tags",
-			Severity:   rules.ParseSeverity(rule.Severity),
-			Message:    rule.Message,
-			Code:       c.getCodeLine(filePath, pos.Line),
+			File:     filePath,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Rule:     "validation_tag",
+			Category: "struct_tags",
+			Severity: rules.ParseSeverity(rule.Severity),
+			Message:  rule.Message,
+			Code:     c.getCodeLine(filePath, pos.Line),
 		})
 	}
 }
@@ -549,20 +760,35 @@ func (c *Checker) checkGenDecl(gd *ast.GenDecl, filePath string) {
 			continue
 		}
 
-		for _, name := range vs.Names {
-			// センチネルエラーチェック
-			if c.config.Naming.Enabled && c.config.Naming.Rules.ErrorVar.Enabled {
-				// エラー型の変数かチェック
-				if vs.Type != nil {
-					if ident, ok := vs.Type.(*ast.Ident); ok && ident.Name == "error" {
-						c.checkErrorVarName(name, filePath)
-					}
-				}
+		// センチネルエラーチェック（エラー型の変数、またはerrors.New・fmt.Errorfで初期化した変数）
+		for i, name := range vs.Names {
+			if c.isErrorVarSpec(vs, i) {
+				c.checkErrorVarName(name, filePath)
 			}
 		}
 	}
 }
 
+// isErrorVarSpec 宣言のi番目の変数がエラー型か（型の指定が無い場合は初期化式から判断）
+func (c *Checker) isErrorVarSpec(vs *ast.ValueSpec, i int) bool {
+	if vs.Type != nil {
+		ident, ok := vs.Type.(*ast.Ident)
+		return ok && ident.Name == "error"
+	}
+	if len(vs.Values) != len(vs.Names) {
+		return false
+	}
+	call, ok := vs.Values[i].(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	switch c.getCallExprString(call) {
+	case "errors.New", "fmt.Errorf":
+		return true
+	}
+	return false
+}
+
 func (c *Checker) checkErrorVarName(name *ast.Ident, filePath string) {
 	rule := c.config.Naming.Rules.ErrorVar
 	pos := c.fset.Position(name.Pos())
@@ -571,12 +797,15 @@ func (c *Checker) checkErrorVarName(name *ast.Ident, filePath string) {
 		return // 非公開エラーは対象外
 	}
 
-	pattern, err := regexp.Compile(rule.Pattern)
-	if err != nil {
-		return
-	}
-
-	if !pattern.MatchString(name.Name) {
+	if !c.patterns.ErrorVar.MatchString(name.Name) {
+		newName := "Err" + strings.TrimSuffix(strings.TrimPrefix(name.Name, "err"), "Error")
+		// 参照の変更はモジュール全体を走査するため、修正する場合のみ求める
+		var fix *report.Fix
+		if c.fixing && c.patterns.ErrorVar.MatchString(newName) {
+			if edits := c.renameEdits(filePath, name, newName); edits != nil {
+				fix = &report.Fix{Description: newName + "に名前を変更", Edits: edits}
+			}
+		}
 		c.report.AddViolation(report.Violation{
 			File:       filePath,
 			Line:       pos.Line,
@@ -586,7 +815,8 @@ func (c *Checker) checkErrorVarName(name *ast.Ident, filePath string) {
 			Severity:   rules.ParseSeverity(rule.Severity),
 			Message:    fmt.Sprintf("エラー変数 '%s' はErrプレフィックスで命名してください", name.Name),
 			Code:       c.getCodeLine(filePath, pos.Line),
-			Suggestion: fmt.Sprintf("Err%s", strings.TrimPrefix(name.Name, "err")),
+			Suggestion: newName,
+			Fix:        fix,
 		})
 	}
 }
@@ -596,10 +826,6 @@ func (c *Checker) checkErrorVarName(name *ast.Ident, filePath string) {
 // ========================================
 
 func (c *Checker) checkAssignment(as *ast.AssignStmt, filePath string) {
-	if !c.config.ErrorHandling.Enabled || !c.config.ErrorHandling.Rules.NoIgnoredErrors.Enabled {
-		return
-	}
-
 	// _ への代入をチェック
 	for i, lhs := range as.Lhs {
 		ident, ok := lhs.(*ast.Ident)
@@ -608,89 +834,147 @@ func (c *Checker) checkAssignment(as *ast.AssignStmt, filePath string) {
 		}
 
 		// 右辺がエラーを返す可能性のある関数呼び出しかチェック
-		if i < len(as.Rhs) {
-			if call, ok := as.Rhs[i].(*ast.CallExpr); ok {
-				// 許可パターンをチェック
-				callStr := c.getCallExprString(call)
-				rule := c.config.ErrorHandling.Rules.NoIgnoredErrors
-				allowed := false
-				for _, pattern := range rule.AllowedPatterns {
-					if matched, _ := regexp.MatchString(pattern, callStr); matched {
-						allowed = true
-						break
-					}
-				}
+		call, ignored, known := c.discardedResult(as, i)
+		if call == nil || (known && !isErrorType(ignored)) {
+			continue
+		}
 
-				if !allowed {
-					pos := c.fset.Position(as.Pos())
-					c.report.AddViolation(report.Violation{
-						File:       filePath,
-						Line:       pos.Line,
-						Column:     pos.Column,
-						Rule:       "no_ignored_errors",
-						Category:   "error_handling",
-						Severity:   rules.ParseSeverity(rule.Severity),
-						Message:    rule.Message,
-						Code:       c.getCodeLine(filePath, pos.Line),
-						Suggestion: "エラーを適切にハンドリングしてください",
-					})
-				}
-			}
+		// 許可パターンをチェック
+		rule := c.config.ErrorHandling.Rules.NoIgnoredErrors
+		if !c.isAllowedIgnoredError(c.getCallExprString(call)) {
+			pos := c.fset.Position(as.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "no_ignored_errors",
+				Category:   "error_handling",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    rule.Message,
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: "エラーを適切にハンドリングしてください",
+			})
+		}
+	}
+}
+
+// discardedResult 左辺i番目の_に代入される関数呼び出しと、その値の型を返す
+// 型情報がある場合は v, _ := f() 形式の多値代入も対象とし、knownがtrueになる
+func (c *Checker) discardedResult(as *ast.AssignStmt, i int) (call *ast.CallExpr, typ types.Type, known bool) {
+	if len(as.Lhs) == len(as.Rhs) {
+		call, ok := as.Rhs[i].(*ast.CallExpr)
+		if !ok {
+			return nil, nil, false
 		}
+		typ := c.typeOf(call)
+		return call, typ, typ != nil
+	}
+
+	// 多値代入は型情報がある場合のみ判定
+	if len(as.Rhs) != 1 {
+		return nil, nil, false
+	}
+	call, ok := as.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	tuple, ok := c.typeOf(call).(*types.Tuple)
+	if !ok || i >= tuple.Len() {
+		return nil, nil, false
 	}
+	return call, tuple.At(i).Type(), true
 }
 
 // ========================================
 // 関数呼び出しチェック
 // ========================================
 
-func (c *Checker) checkCallExpr(call *ast.CallExpr, filePath string) {
-	callStr := c.getCallExprString(call)
+// checkPanic panicの使用チェック
+func (c *Checker) checkPanic(call *ast.CallExpr, callStr, filePath string) {
+	if callStr != "panic" {
+		return
+	}
+	rule := c.config.ErrorHandling.Rules.NoPanic
+	// 許可されたファイル・パッケージ・関数かチェック
+	allowed := c.isAllowedIn(rule.AllowedIn, filePath)
+	if fn := c.enclosingFunc(call.Pos()); fn != nil && !allowed {
+		allowed = matchesFuncName(rule.AllowedFunctions, fn)
+	}
+	if allowed {
+		return
+	}
+
 	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Rule:       "no_panic",
+		Category:   "error_handling",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    rule.Message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "エラーを返却してください",
+	})
+}
 
-	// panic チェック
-	if c.config.ErrorHandling.Enabled && c.config.ErrorHandling.Rules.NoPanic.Enabled {
-		if callStr == "panic" {
-			rule := c.config.ErrorHandling.Rules.NoPanic
-			// 許可されたファイルかチェック
-			allowed := false
-			for _, pattern := range rule.AllowedIn {
-				if matched, _ := filepath.Match(pattern, filepath.Base(filePath)); matched {
-					allowed = true
-					break
-				}
-			}
+// checkFmtPrintln fmt.Print系の使用チェック
+func (c *Checker) checkFmtPrintln(call *ast.CallExpr, callStr, filePath string) {
+	if !strings.HasPrefix(callStr, "fmt.Print") {
+		return
+	}
+	rule := c.config.Logging.Rules.NoFmtPrintln
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Rule:       "no_fmt_println",
+		Category:   "logging",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    rule.Message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "構造化ログライブラリ（zerolog等）を使用してください",
+		Fix:        c.fmtPrintlnFix(call, callStr, filePath),
+	})
+}
 
-			if !allowed {
-				c.report.AddViolation(report.Violation{
-					File:       filePath,
-					Line:       pos.Line,
-					Rule:       "no_panic",
-					Category:   "error_handling",
-					Severity:   rules.ParseSeverity(rule.Severity),
-					Message:    rule.Message,
-					Code:       c.getCodeLine(filePath, pos.Line),
-					Suggestion: "エラーを返却してください",
-				})
-			}
-		}
+// fmtPrintlnFix fmt.Print系の呼び出しを設定のロガー（replacement）の呼び出しに置き換える修正
+// ロガーにはメッセージの文字列1つを渡す（fmt.Printfはfmt.Sprintfで、文字列リテラル以外の1引数はfmt.Sprintで文字列にする）
+func (c *Checker) fmtPrintlnFix(call *ast.CallExpr, callStr, filePath string) *report.Fix {
+	rule := c.config.Logging.Rules.NoFmtPrintln
+	if rule.Replacement == "" || c.ctx == nil || len(call.Args) == 0 {
+		return nil
+	}
+	src := c.fileSource(filePath)
+	args := string(src[c.fset.Position(call.Lparen).Offset+1 : c.fset.Position(call.Rparen).Offset])
+
+	var msg string
+	lit, _ := call.Args[0].(*ast.BasicLit)
+	switch {
+	case callStr == "fmt.Printf":
+		msg = "fmt.Sprintf(" + args + ")"
+	case len(call.Args) != 1 || call.Ellipsis.IsValid():
+		return nil // Printlnの複数引数は区切りが変わるため修正しない
+	case lit != nil && lit.Kind == token.STRING:
+		msg = args
+	default:
+		msg = "fmt.Sprint(" + args + ")"
 	}
 
-	// fmt.Println チェック
-	if c.config.Logging.Enabled && c.config.Logging.Rules.NoFmtPrintln.Enabled {
-		if strings.HasPrefix(callStr, "fmt.Print") {
-			rule := c.config.Logging.Rules.NoFmtPrintln
-			c.report.AddViolation(report.Violation{
-				File:       filePath,
-				Line:       pos.Line,
-				Rule:       "no_fmt_println",
-				Category:   "logging",
-				Severity:   rules.ParseSeverity(rule.Severity),
-				Message:    rule.Message,
-				Code:       c.getCodeLine(filePath, pos.Line),
-				Suggestion: "構造化ログライブラリ（zerolog等）を使用してください",
-			})
-		}
+	edits := []report.TextEdit{c.replaceEdit(call, rule.Replacement+"("+msg+")")}
+	if rule.ReplacementImport != "" {
+		edits = append(edits, c.addImportEdits(c.ctx.File, rule.ReplacementImport)...)
+	}
+	return &report.Fix{Description: rule.Replacement + "に置き換え", Edits: edits}
+}
+
+// ========================================
+// 式文チェック
+// ========================================
+
+// checkLogFieldKeyDuplicatesStmt 式文のログ呼び出し内のフィールドキー重複チェック
+func (c *Checker) checkLogFieldKeyDuplicatesStmt(stmt *ast.ExprStmt, filePath string) {
+	if call, ok := stmt.X.(*ast.CallExpr); ok {
+		c.checkLogFieldKeyDuplicates(call, filePath)
 	}
 }
 
@@ -710,41 +994,39 @@ func (c *Checker) getCallExprString(call *ast.CallExpr) string {
 // ディレクトリ構成チェック
 // ========================================
 
-func (c *Checker) checkDirectory(targetDir string) {
-	// 必須ディレクトリ
-	if c.config.Directory.Rules.RequiredDirs.Enabled {
-		rule := c.config.Directory.Rules.RequiredDirs
-		for _, dir := range rule.Dirs {
-			path := filepath.Join(targetDir, dir)
-			if _, err := os.Stat(path); os.IsNotExist(err) {
-				c.report.AddViolation(report.Violation{
-					File:       targetDir,
-					Line:       1,
-					Rule:       "required_dirs",
-					Category:   "directory",
-					Severity:   rules.ParseSeverity(rule.Severity),
-					Message:    fmt.Sprintf("必須ディレクトリ '%s' が見つかりません", dir),
-					Suggestion: fmt.Sprintf("mkdir -p %s", path),
-				})
-			}
+// checkRequiredDirs 必須ディレクトリチェック
+func (c *Checker) checkRequiredDirs(targetDir string) {
+	rule := c.config.Directory.Rules.RequiredDirs
+	for _, dir := range rule.Dirs {
+		path := filepath.Join(targetDir, dir)
+		if _, err := c.statPath(path); errors.Is(err, fs.ErrNotExist) {
+			c.report.AddViolation(report.Violation{
+				File:       targetDir,
+				Line:       1,
+				Rule:       "required_dirs",
+				Category:   "directory",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("必須ディレクトリ '%s' が見つかりません", dir),
+				Suggestion: fmt.Sprintf("mkdir -p %s", path),
+			})
 		}
 	}
+}
 
-	// 推奨ディレクトリ
-	if c.config.Directory.Rules.RecommendedDirs.Enabled {
-		rule := c.config.Directory.Rules.RecommendedDirs
-		for _, dir := range rule.Dirs {
-			path := filepath.Join(targetDir, dir)
-			if _, err := os.Stat(path); os.IsNotExist(err) {
-				c.report.AddViolation(report.Violation{
-					File:       targetDir,
-					Line:       1,
-					Rule:       "recommended_dirs",
-					Category:   "directory",
-					Severity:   rules.ParseSeverity(rule.Severity),
-					Message:    fmt.Sprintf("推奨ディレクトリ '%s' が見つかりません", dir),
-				})
-			}
+// checkRecommendedDirs 推奨ディレクトリチェック
+func (c *Checker) checkRecommendedDirs(targetDir string) {
+	rule := c.config.Directory.Rules.RecommendedDirs
+	for _, dir := range rule.Dirs {
+		path := filepath.Join(targetDir, dir)
+		if _, err := c.statPath(path); errors.Is(err, fs.ErrNotExist) {
+			c.report.AddViolation(report.Violation{
+				File:     targetDir,
+				Line:     1,
+				Rule:     "recommended_dirs",
+				Category: "directory",
+				Severity: rules.ParseSeverity(rule.Severity),
+				Message:  fmt.Sprintf("推奨ディレクトリ '%s' が見つかりません", dir),
+			})
 		}
 	}
 }
@@ -753,51 +1035,146 @@ func (c *Checker) checkDirectory(targetDir string) {
 // カスタムルールチェック
 // ========================================
 
-func (c *Checker) checkCustomRules(filePath string) {
-	for _, rule := range c.config.CustomRules {
-		if !rule.Enabled {
-			continue
+// customRule コンパイル済みのカスタムルール
+type customRule struct {
+	rules.CustomRule
+	pattern *regexp.Regexp
+}
+
+// compileCustomRules 有効なカスタムルールとコンパイル済みのパターンを組にする（チェック開始時に1回）
+func (c *Checker) compileCustomRules() {
+	c.customRules = nil
+	for i, rule := range c.config.CustomRules {
+		if rule.Enabled {
+			c.customRules = append(c.customRules, customRule{CustomRule: rule, pattern: c.patterns.CustomRules[i]})
 		}
+	}
+}
 
-		// 除外ファイルチェック
+func (c *Checker) checkCustomRules(ctx *FileContext) {
+	// 除外ファイル以外のルール
+	var active []customRule
+	codeOnly := false
+	for _, rule := range c.customRules {
 		excluded := false
 		for _, pattern := range rule.ExcludeFiles {
-			if matched, _ := filepath.Match(pattern, filepath.Base(filePath)); matched {
+			if matched, _ := filepath.Match(pattern, filepath.Base(ctx.Path)); matched {
 				excluded = true
 				break
 			}
 		}
-		if excluded {
-			continue
+		if !excluded {
+			active = append(active, rule)
+			codeOnly = codeOnly || rule.CodeOnly
+		}
+	}
+	if len(active) == 0 {
+		return
+	}
+
+	// 文字列リテラル・コメントを除いた行（code_onlyのルールがある場合のみ）
+	var codeLines []string
+	if codeOnly {
+		codeLines = maskStringsAndComments(ctx.Src)
+	}
+
+	for i, line := range ctx.Lines {
+		codeLine := ""
+		if i < len(codeLines) {
+			codeLine = codeLines[i]
 		}
 
-		// パターンマッチ
-		pattern, err := rule.Compile()
-		if err != nil {
+		// どのルールにも一致しない行は個別の照合を省略（全パターンを連結した正規表現で1回だけ照合する）
+		if combined := c.patterns.CustomAny; combined != nil && !combined.MatchString(line) && (!codeOnly || !combined.MatchString(codeLine)) {
 			continue
 		}
 
-		lines := c.fileMap[filePath]
-		for i, line := range lines {
-			if pattern.MatchString(line) {
-				c.report.AddViolation(report.Violation{
-					File:     filePath,
-					Line:     i + 1,
-					Rule:     rule.Name,
-					Category: "custom",
-					Severity: rules.ParseSeverity(rule.Severity),
-					Message:  rule.Message,
-					Code:     strings.TrimSpace(line),
-				})
+		for _, rule := range active {
+			target := line
+			if rule.CodeOnly {
+				target = codeLine
+			}
+			if !rule.pattern.MatchString(target) {
+				continue
+			}
+			c.report.AddViolation(report.Violation{
+				File:     ctx.Path,
+				Line:     i + 1,
+				Rule:     rule.Name,
+				Category: "custom",
+				Severity: rules.ParseSeverity(rule.Severity),
+				Message:  rule.Message,
+				Code:     strings.TrimSpace(line),
+			})
+		}
+	}
+}
+
+// maskStringsAndComments 文字列・文字リテラルとコメントを空白に置き換えた行を返す（行・桁の位置は保持）
+func maskStringsAndComments(src []byte) []string {
+	masked := make([]byte, len(src))
+	copy(masked, src)
+
+	fset := token.NewFileSet()
+	tf := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(tf, src, nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.STRING && tok != token.CHAR && tok != token.COMMENT {
+			continue
+		}
+		start := tf.Offset(pos)
+		for i := start; i < start+len(lit) && i < len(masked); i++ {
+			if masked[i] != '\n' {
+				masked[i] = ' '
 			}
 		}
 	}
+
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(masked))
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	return lines
 }
 
 // ========================================
 // ヘルパー関数
 // ========================================
 
+// contextParam context.Context型のパラメータを返す（無ければnil）
+func contextParam(ft *ast.FuncType) *ast.Field {
+	if ft == nil || ft.Params == nil {
+		return nil
+	}
+	for _, field := range ft.Params.List {
+		if isContextType(field.Type) {
+			return field
+		}
+	}
+	return nil
+}
+
+// isContextType 型式がcontext.Contextか
+func isContextType(expr ast.Expr) bool {
+	return isSelector(expr, "context", "Context")
+}
+
+// isSelector 式が pkg.name 形式のセレクタか
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == pkg && sel.Sel.Name == name
+}
+
 func isPascalCase(s string) bool {
 	if len(s) == 0 {
 		return false
@@ -825,11 +1202,39 @@ func isSnakeCase(s string) bool {
 
 func toSnakeCase(s string) string {
 	var result strings.Builder
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			result.WriteRune('_')
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '-' {
+			r = '_'
+		}
+		// 略語の連続（UserID, HTTPServer）は1単語として扱う
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				result.WriteRune('_')
+			}
 		}
 		result.WriteRune(r)
 	}
 	return strings.ToLower(result.String())
 }
+
+func toCamelCase(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	if len(parts) == 0 {
+		return s
+	}
+
+	var result strings.Builder
+	for i, part := range parts {
+		runes := []rune(part)
+		if i == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		result.WriteString(string(runes))
+	}
+	return result.String()
+}
@@ -0,0 +1,141 @@
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// writeTree テスト用のファイルをディレクトリに作成
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, src := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// countRule ルールの違反の数
+func countRule(rep *report.Report, rule string) int {
+	n := 0
+	for _, v := range rep.Violations {
+		if v.Rule == rule {
+			n++
+		}
+	}
+	return n
+}
+
+// loadTestConfig YAMLの設定を読み込む
+func loadTestConfig(t *testing.T, config string) *rules.Config {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := rules.LoadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// checkConfig YAMLの設定を読み込み、ファイル（ルートからの相対パス→内容）を作成したディレクトリをチェックする
+func checkConfig(t *testing.T, config string, files map[string]string) *report.Report {
+	t.Helper()
+	root := t.TempDir()
+	writeTree(t, root, files)
+	rep, err := NewChecker(loadTestConfig(t, config)).Check(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rep
+}
+
+// ruleTest ルールの違反の数を確認するテストケース
+type ruleTest struct {
+	name  string
+	files map[string]string // ルートからの相対パス→内容
+	want  int               // ルールの違反の数
+}
+
+// runRuleTests 設定で各テストケースのファイルをチェックし、ルールの違反の数を確認する
+func runRuleTests(t *testing.T, config, rule string, tests []ruleTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rep := checkConfig(t, config, tt.files)
+			if got := countRule(rep, rule); got != tt.want {
+				t.Errorf("%s violations = %d, want %d: %+v", rule, got, tt.want, rep.Violations)
+			}
+		})
+	}
+}
+
+// errorVarConfig error_varのみを有効にした設定
+func errorVarConfig() *rules.Config {
+	cfg := rules.DefaultConfig()
+	cfg.Naming.Rules.ErrorVar = rules.PatternRule{
+		BaseRule: rules.BaseRule{Enabled: true, Severity: "warning"},
+		Pattern:  "^Err[A-Z]",
+	}
+	return cfg
+}
+
+func TestMaxCyclomaticComplexity(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    max_cyclomatic_complexity:
+      enabled: true
+      limit: 3
+      severity: "warning"
+`
+	runRuleTests(t, config, "max_cyclomatic_complexity", []ruleTest{
+		{
+			name: "branches and conditions over limit",
+			files: map[string]string{"a.go": `package p
+
+func f(a, b bool, xs []int) int {
+	n := 0
+	if a && b {
+		n++
+	}
+	for _, x := range xs {
+		if x > 0 {
+			n += x
+		}
+	}
+	return n
+}
+`},
+			want: 1,
+		},
+		{
+			name: "within limit",
+			files: map[string]string{"a.go": `package p
+
+func f(a bool, xs []int) int {
+	n := 0
+	if a {
+		n++
+	}
+	for _, x := range xs {
+		n += x
+	}
+	return n
+}
+`},
+			want: 0,
+		},
+	})
+}
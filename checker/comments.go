@@ -0,0 +1,134 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// コメントチェック
+// ========================================
+
+// defaultIssuePattern チケット番号の既定の形式（JIRA-123 等）
+var defaultIssuePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]+-[0-9]+$`)
+
+// issueLikeRe チケット番号を意図したと思われる語（形式の検証対象）
+var issueLikeRe = regexp.MustCompile(`^(?:[A-Za-z][A-Za-z0-9]*-[0-9]+|#[0-9]+)[.,;:]?$`)
+
+// todoComment コメント行から取り出したTODO
+type todoComment struct {
+	keyword string
+	owner   string
+	issue   string // issue_patternに一致したチケット番号
+	invalid string // チケット番号らしいがissue_patternに一致しない語
+	text    string
+}
+
+// checkTodo TODO/FIXMEコメントの担当者・チケット番号を検証し、バックログに追加する
+func (c *Checker) checkTodo(file *ast.File, filePath string) {
+	keywordRe := c.patterns.TodoKeyword
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			c.checkTodoComment(comment, keywordRe, filePath)
+		}
+	}
+}
+
+// checkTodoComment コメントの各行のTODOをバックログに追加し、形式を検証する
+func (c *Checker) checkTodoComment(comment *ast.Comment, keywordRe *regexp.Regexp, filePath string) {
+	for i, line := range strings.Split(commentText(comment.Text), "\n") {
+		m := keywordRe.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		todo := c.parseTodo(m[1], m[2], m[3])
+		pos := c.fset.Position(comment.Pos())
+		pos.Line += i
+		c.report.AddTodo(report.TodoItem{
+			File:    filePath,
+			Line:    pos.Line,
+			Keyword: todo.keyword,
+			Owner:   todo.owner,
+			Issue:   todo.issue,
+			Link:    issueLink(c.config.Comments.Rules.Todo.IssueURL, todo.issue),
+			Text:    todo.text,
+		})
+		c.reportTodo(todo, pos, filePath)
+	}
+}
+
+// commentText コメントの記号（//、/* */）を除いた本文
+func commentText(text string) string {
+	if strings.HasPrefix(text, "//") {
+		return text[2:]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+}
+
+// parseTodo キーワード・括弧内・本文からTODOを組み立てる
+// 括弧内がチケット番号の場合（TODO(JIRA-123)）は担当者ではなくチケット番号として扱う
+func (c *Checker) parseTodo(keyword, paren, text string) todoComment {
+	issueRe := defaultIssuePattern
+	if c.patterns != nil && c.patterns.TodoIssue != nil {
+		issueRe = c.patterns.TodoIssue
+	}
+	todo := todoComment{keyword: keyword, owner: strings.TrimSpace(paren), text: strings.TrimSpace(text)}
+	if issueRe.MatchString(todo.owner) {
+		todo.issue, todo.owner = todo.owner, ""
+		return todo
+	}
+	for _, word := range strings.Fields(todo.text) {
+		word = strings.TrimRight(word, ".,;:")
+		switch {
+		case issueRe.MatchString(word):
+			todo.issue = word
+			return todo
+		case todo.invalid == "" && issueLikeRe.MatchString(word):
+			todo.invalid = word
+		}
+	}
+	return todo
+}
+
+// issueLink チケットのURL（URLのテンプレート・チケット番号が無い場合は空）
+func issueLink(urlTemplate, issue string) string {
+	if urlTemplate == "" || issue == "" {
+		return ""
+	}
+	return strings.ReplaceAll(urlTemplate, "{issue}", strings.TrimPrefix(issue, "#"))
+}
+
+// reportTodo 担当者・チケット番号の不足と、チケット番号の形式の誤りを報告する
+func (c *Checker) reportTodo(todo todoComment, pos token.Position, filePath string) {
+	rule := c.config.Comments.Rules.Todo
+	var problems []string
+	if rule.RequireOwner && todo.owner == "" {
+		problems = append(problems, "担当者がありません")
+	}
+	if todo.issue == "" && todo.invalid != "" {
+		problems = append(problems, fmt.Sprintf("チケット番号 '%s' の形式が不正です", todo.invalid))
+	} else if rule.RequireIssue && todo.issue == "" {
+		problems = append(problems, "チケット番号がありません")
+	}
+	if len(problems) == 0 {
+		return
+	}
+
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "todo",
+		Category:   "comments",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("%s: %s", todo.keyword, strings.Join(problems, "、")),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: fmt.Sprintf("%s(担当者): JIRA-123 本文 の形式で記載してください", todo.keyword),
+	})
+}
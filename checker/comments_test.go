@@ -0,0 +1,46 @@
+package checker
+
+import "testing"
+
+func TestTodo(t *testing.T) {
+	const config = `
+comments:
+  enabled: true
+  rules:
+    todo:
+      enabled: true
+      severity: "info"
+      keywords: ["TODO", "FIXME"]
+      require_owner: true
+      issue_pattern: "^[A-Z][A-Z0-9]+-[0-9]+$"
+`
+	runRuleTests(t, config, "todo", []ruleTest{
+		{
+			name: "missing owner and malformed ticket",
+			files: map[string]string{"a.go": `package p
+
+// TODO: retry on timeout
+func f() {}
+
+// FIXME(yamada): see jira-12 before release
+func g() {}
+`},
+			want: 2,
+		},
+		{
+			name: "owner with ticket and plain comments",
+			files: map[string]string{"a.go": `package p
+
+// TODO(yamada): JIRA-123 retry on timeout
+func f() {}
+
+// FIXME(suzuki): OPS-7 remove after migration
+func g() {}
+
+// todos are handled elsewhere
+func h() {}
+`},
+			want: 0,
+		},
+	})
+}
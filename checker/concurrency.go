@@ -0,0 +1,982 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 並行処理チェック
+// ========================================
+
+// checkGoroutineLeak 終了手段を持たないgoroutineを検出
+// 以下のいずれかを満たせば管理されているとみなす
+//   - クロージャ内で ctx.Done() / wg.Done() を呼ぶ、またはdone/quit/stopチャネルを受信する
+//   - 起動する関数にcontextを渡している
+//   - 同じ関数内で wg.Wait() / g.Wait() を呼んでいる
+//   - 許可ヘルパーを呼び出している
+//
+// mainパッケージはinclude_mainを指定した場合のみ対象とする
+func (c *Checker) checkGoroutineLeak(stmt *ast.GoStmt, filePath string) {
+	rule := c.config.Concurrency.Rules.GoroutineLeak
+
+	if c.file.Name.Name == "main" && !rule.IncludeMain {
+		return
+	}
+	if c.matchesHelper(stmt.Call, rule.Helpers) {
+		return
+	}
+	for _, arg := range stmt.Call.Args {
+		if c.isContextValue(arg) {
+			return
+		}
+	}
+	if lit, ok := stmt.Call.Fun.(*ast.FuncLit); ok && c.hasLifecycleSignal(lit.Body, rule.Helpers) {
+		return
+	}
+	if fn := c.enclosingFunc(stmt.Pos()); fn != nil && callsMethod(fn.Body, "Wait") {
+		return
+	}
+
+	pos := c.fset.Position(stmt.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "goroutine_leak",
+		Category:   "concurrency",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("goroutine（%s）の終了を管理する仕組みがありません", c.goroutineDescription(stmt)),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "ctx.Done()をselectで監視するか、errgroup/sync.WaitGroupで終了を待機してください",
+	})
+}
+
+// hasLifecycleSignal ブロック内に終了シグナルの監視・通知があるか
+func (c *Checker) hasLifecycleSignal(block *ast.BlockStmt, helpers []string) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			// ctx.Done() / wg.Done() / 許可ヘルパー
+			if sel, ok := node.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Done" {
+				found = true
+			} else if c.matchesHelper(node, helpers) {
+				found = true
+			}
+		case *ast.UnaryExpr:
+			// <-done / <-quit / <-stop
+			if node.Op == token.ARROW && isDoneChannel(node.X) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isDoneChannel 終了通知用のチャネル名か
+func isDoneChannel(expr ast.Expr) bool {
+	var name string
+	switch e := expr.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		name = e.Sel.Name
+	default:
+		return false
+	}
+	name = strings.ToLower(name)
+	for _, word := range []string{"done", "quit", "stop", "closing", "shutdown"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// isContextValue 式がcontext.Context値か（型情報が無い場合はctxという名前で判定）
+func (c *Checker) isContextValue(expr ast.Expr) bool {
+	if t := c.typeOf(expr); t != nil {
+		return isNamedType(t, "context", "Context")
+	}
+	ident, ok := expr.(*ast.Ident)
+	return ok && (ident.Name == "ctx" || strings.HasSuffix(ident.Name, "Ctx"))
+}
+
+// callsMethod ブロック内で指定名のメソッド呼び出しがあるか
+func callsMethod(block *ast.BlockStmt, name string) bool {
+	if block == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// matchesHelper 呼び出しが許可ヘルパー（pkg.Func または関数・メソッド名）か
+func (c *Checker) matchesHelper(call *ast.CallExpr, helpers []string) bool {
+	if len(helpers) == 0 {
+		return false
+	}
+	if containsString(helpers, c.getCallExprString(call)) {
+		return true
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		return containsString(helpers, sel.Sel.Name)
+	}
+	return false
+}
+
+// goroutineDescription go文の起動対象の説明
+func (c *Checker) goroutineDescription(stmt *ast.GoStmt) string {
+	if _, ok := stmt.Call.Fun.(*ast.FuncLit); ok {
+		return "無名関数"
+	}
+	return fmt.Sprintf("'%s'", c.getCallExprString(stmt.Call))
+}
+
+// ========================================
+// ロックの値コピーチェック
+// 代入・引数渡しは型情報が必要、レシーバ・引数・戻り値は型情報が無い場合は同じファイルの宣言から判定
+// ========================================
+
+// lockTypes 値コピーしてはならないsyncパッケージの型
+var lockTypes = map[string]bool{
+	"Mutex": true, "RWMutex": true, "WaitGroup": true, "Once": true, "Cond": true,
+}
+
+// lockPath 型がロックを値で含む場合、その経路（例: "mu sync.Mutex"）を返す
+func lockPath(t types.Type) string {
+	return findLock(t, make(map[types.Type]bool))
+}
+
+func findLock(t types.Type, visited map[types.Type]bool) string {
+	if t == nil || visited[t] {
+		return ""
+	}
+	visited[t] = true
+
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "sync" && lockTypes[obj.Name()] {
+			return "sync." + obj.Name()
+		}
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if path := findLock(u.Field(i).Type(), visited); path != "" {
+				return u.Field(i).Name() + " " + path
+			}
+		}
+	case *types.Array:
+		return findLock(u.Elem(), visited)
+	}
+	return ""
+}
+
+// lockTypeOf 型式がロックを値で含む場合にその経路を返す関数
+// 型情報が無い場合はsyncパッケージの型と、同じファイルで宣言した構造体のフィールドから判定する
+func (c *Checker) lockTypeOf() func(ast.Expr) string {
+	if c.info != nil {
+		return func(expr ast.Expr) string { return lockPath(c.typeOf(expr)) }
+	}
+	structs := fileStructs(c.file)
+	return func(expr ast.Expr) string { return syntaxLockPath(expr, structs, make(map[string]bool)) }
+}
+
+// fileStructs ファイルで宣言した構造体（型パラメータを持つものを除く）
+func fileStructs(file *ast.File) map[string]*ast.StructType {
+	structs := make(map[string]*ast.StructType)
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || ts.TypeParams != nil {
+				continue
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				structs[ts.Name.Name] = st
+			}
+		}
+	}
+	return structs
+}
+
+// syntaxLockPath 型式がロックを値で含む場合、その経路（例: "mu sync.Mutex"）を返す（型情報を使わない）
+func syntaxLockPath(expr ast.Expr, structs map[string]*ast.StructType, visited map[string]bool) string {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return syntaxLockPath(e.X, structs, visited)
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return syntaxLockPath(e.Elt, structs, visited)
+		}
+	case *ast.SelectorExpr:
+		if isSelector(e, "sync", e.Sel.Name) && lockTypes[e.Sel.Name] {
+			return "sync." + e.Sel.Name
+		}
+	case *ast.Ident:
+		st := structs[e.Name]
+		if st == nil || visited[e.Name] {
+			return ""
+		}
+		visited[e.Name] = true
+		for _, field := range st.Fields.List {
+			if path := syntaxLockPath(field.Type, structs, visited); path != "" {
+				return fieldName(field) + " " + path
+			}
+		}
+	}
+	return ""
+}
+
+// fieldName 構造体のフィールド名（埋め込みフィールドは型名）
+func fieldName(field *ast.Field) string {
+	if len(field.Names) > 0 {
+		return field.Names[0].Name
+	}
+	switch t := field.Type.(type) {
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+	return "_"
+}
+
+// isFreshValue 式が新しい値を生成するもの（コピーにならない）か
+func isFreshValue(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CompositeLit, *ast.CallExpr:
+		return true
+	case *ast.UnaryExpr:
+		return e.Op == token.AND
+	}
+	return false
+}
+
+// checkLockCopyFunc 値レシーバ・値引数・値の戻り値でのロックのコピーを検出
+func (c *Checker) checkLockCopyFunc(fn *ast.FuncDecl, filePath string) {
+	lockOf := c.lockTypeOf()
+	if fn.Recv != nil {
+		for _, field := range fn.Recv.List {
+			if path := lockOf(field.Type); path != "" {
+				c.reportLockCopy(field, filePath,
+					fmt.Sprintf("メソッド '%s' の値レシーバがロック（%s）をコピーしています", fn.Name.Name, path),
+					"ポインタレシーバを使用してください")
+			}
+		}
+	}
+	for _, field := range fn.Type.Params.List {
+		if path := lockOf(field.Type); path != "" {
+			c.reportLockCopy(field, filePath,
+				fmt.Sprintf("関数 '%s' の引数がロック（%s）を値で受け取っています", fn.Name.Name, path),
+				"ポインタで受け渡してください")
+		}
+	}
+	if fn.Type.Results == nil {
+		return
+	}
+	for _, field := range fn.Type.Results.List {
+		if path := lockOf(field.Type); path != "" {
+			c.reportLockCopy(field, filePath,
+				fmt.Sprintf("関数 '%s' がロック（%s）を含む値を返しています", fn.Name.Name, path),
+				"ポインタを返してください")
+		}
+	}
+}
+
+// checkLockCopyAssign 代入によるロックのコピーを検出
+func (c *Checker) checkLockCopyAssign(as *ast.AssignStmt, filePath string) {
+	if c.info == nil || len(as.Lhs) != len(as.Rhs) {
+		return
+	}
+	for i, rhs := range as.Rhs {
+		if ident, ok := as.Lhs[i].(*ast.Ident); ok && ident.Name == "_" {
+			continue
+		}
+		if isFreshValue(rhs) {
+			continue
+		}
+		if path := lockPath(c.typeOf(rhs)); path != "" {
+			c.reportLockCopy(rhs, filePath,
+				fmt.Sprintf("代入によりロック（%s）がコピーされています", path),
+				"ポインタを代入してください")
+		}
+	}
+}
+
+// checkLockCopyArgs 関数呼び出しの引数によるロックのコピーを検出
+func (c *Checker) checkLockCopyArgs(call *ast.CallExpr, filePath string) {
+	if c.info == nil {
+		return
+	}
+	// 型変換・組み込み関数は対象外
+	if tv, ok := c.info.Types[call.Fun]; ok && (tv.IsType() || tv.IsBuiltin()) {
+		return
+	}
+	for _, arg := range call.Args {
+		if isFreshValue(arg) {
+			continue
+		}
+		if path := lockPath(c.typeOf(arg)); path != "" {
+			c.reportLockCopy(arg, filePath,
+				fmt.Sprintf("引数としてロック（%s）を含む値がコピーされています", path),
+				"ポインタで受け渡してください")
+		}
+	}
+}
+
+func (c *Checker) reportLockCopy(node ast.Node, filePath, message, suggestion string) {
+	rule := c.config.Concurrency.Rules.LockCopy
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "lock_copy",
+		Category:   "concurrency",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// ========================================
+// ループ内の無制限なgoroutine起動チェック
+// ========================================
+
+// limitWords ワーカー数・並行数の上限を表す識別子に含まれる語
+var limitWords = []string{"worker", "concurrency", "parallel", "pool", "limit", "max"}
+
+// checkUnboundedGoroutines ループ本体で直接起動され、並行数が制限されていないgoroutineを検出
+func (c *Checker) checkUnboundedGoroutines(stmt *ast.GoStmt, filePath string) {
+	rule := c.config.Concurrency.Rules.UnboundedGoroutines
+	fn := c.enclosingFunc(stmt.Pos())
+	if fn == nil || fn.Body == nil {
+		return
+	}
+
+	loop, body := loopContaining(fn.Body, stmt)
+	if loop == nil || isBoundedLoop(loop) {
+		return
+	}
+	// errgroup.SetLimit / セマフォ / 許可ヘルパー
+	if callsMethod(fn.Body, "SetLimit") || c.hasSemaphore(body, rule.Helpers) {
+		return
+	}
+	if lit, ok := stmt.Call.Fun.(*ast.FuncLit); ok && c.hasSemaphore(lit.Body, rule.Helpers) {
+		return
+	}
+
+	pos := c.fset.Position(stmt.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "unbounded_goroutines",
+		Category:   "concurrency",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    "ループ内で並行数の上限なくgoroutineを起動しています",
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "errgroup.SetLimit、セマフォ（バッファ付きチャネル）、ワーカープールで並行数を制限してください",
+	})
+}
+
+// loopContaining go文を本体の直下に持つfor/rangeループとその本体を返す
+func loopContaining(root *ast.BlockStmt, stmt ast.Stmt) (ast.Stmt, *ast.BlockStmt) {
+	var loop ast.Stmt
+	var body *ast.BlockStmt
+	ast.Inspect(root, func(n ast.Node) bool {
+		if loop != nil {
+			return false
+		}
+		var b *ast.BlockStmt
+		switch l := n.(type) {
+		case *ast.ForStmt:
+			b = l.Body
+		case *ast.RangeStmt:
+			b = l.Body
+		default:
+			return true
+		}
+		for _, s := range b.List {
+			if s == stmt {
+				loop, body = n.(ast.Stmt), b
+				return false
+			}
+		}
+		return true
+	})
+	return loop, body
+}
+
+// isBoundedLoop 反復回数が固定のワーカープール形式のループか
+//
+//	for i := 0; i < numWorkers; i++ / for range 10
+func isBoundedLoop(loop ast.Stmt) bool {
+	var limit ast.Expr
+	switch l := loop.(type) {
+	case *ast.ForStmt:
+		bin, ok := l.Cond.(*ast.BinaryExpr)
+		if !ok || (bin.Op != token.LSS && bin.Op != token.LEQ) {
+			return false
+		}
+		limit = bin.Y
+	case *ast.RangeStmt:
+		limit = l.X
+	}
+
+	switch e := limit.(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.INT
+	case *ast.Ident:
+		return isLimitName(e.Name)
+	case *ast.SelectorExpr:
+		return isLimitName(e.Sel.Name)
+	}
+	return false
+}
+
+func isLimitName(name string) bool {
+	name = strings.ToLower(name)
+	for _, word := range limitWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSemaphore ブロック内にセマフォの取得（チャネル送信・Acquire）や許可ヘルパーの呼び出しがあるか
+func (c *Checker) hasSemaphore(block *ast.BlockStmt, helpers []string) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			// 入れ子の関数リテラル内は対象外
+			return false
+		case *ast.SendStmt:
+			found = true
+		case *ast.CallExpr:
+			if sel, ok := node.Fun.(*ast.SelectorExpr); ok && (sel.Sel.Name == "Acquire" || sel.Sel.Name == "TryAcquire") {
+				found = true
+			} else if c.matchesHelper(node, helpers) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// ========================================
+// time.Sleepによるリトライループチェック
+// ========================================
+
+// checkSleepRetry エラーチェック・continueを含むループ内のtime.Sleepを検出
+func (c *Checker) checkSleepRetry(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.Concurrency.Rules.SleepRetry
+	if fn.Body == nil || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	reported := make(map[*ast.CallExpr]bool)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		switch loop := n.(type) {
+		case *ast.ForStmt:
+			body = loop.Body
+		case *ast.RangeStmt:
+			body = loop.Body
+		default:
+			return true
+		}
+		if !isRetryBody(body) {
+			return true
+		}
+
+		for _, call := range c.sleepCalls(body) {
+			if reported[call] {
+				continue
+			}
+			reported[call] = true
+
+			pos := c.fset.Position(call.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "sleep_retry",
+				Category:   "concurrency",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    "リトライループで固定間隔のtime.Sleepを使用しています",
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: "指数バックオフを用い、select { case <-ctx.Done(): case <-time.After(d): } でキャンセル可能に待機してください",
+			})
+		}
+		return true
+	})
+}
+
+// isRetryBody ループ本体がエラーチェックまたはcontinueを含むか
+func isRetryBody(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BranchStmt:
+			found = found || node.Tok == token.CONTINUE
+		case *ast.IfStmt:
+			found = found || isErrNilCompare(node.Cond)
+		}
+		return !found
+	})
+	return found
+}
+
+// isErrNilCompare err != nil / err == nil 形式の条件か
+func isErrNilCompare(expr ast.Expr) bool {
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	if bin.Op == token.EQL {
+		bin = &ast.BinaryExpr{X: bin.X, Op: token.NEQ, Y: bin.Y}
+	}
+	return isErrNotNilCond(bin)
+}
+
+// sleepCalls ブロック内（関数リテラルを除く）のtime.Sleep呼び出し
+func (c *Checker) sleepCalls(block *ast.BlockStmt) []*ast.CallExpr {
+	return c.callsTo(block, "time.Sleep")
+}
+
+// callsTo ブロック内（関数リテラルを除く）のname（例: time.Sleep）の呼び出し
+func (c *Checker) callsTo(block *ast.BlockStmt, name string) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if c.getCallExprString(node) == name {
+				calls = append(calls, node)
+			}
+		}
+		return true
+	})
+	return calls
+}
+
+// ========================================
+// チャネルの所有権チェック
+// ========================================
+
+// chanUse チャネルの使われ方
+type chanUse struct {
+	send  int // 送信（ch <- v）・close(ch)
+	recv  int // 受信（<-ch）・range ch
+	total int // 参照の総数
+}
+
+// channelUses ノード内での識別子ごとのチャネル操作を集計
+// skipGoがtrueの場合はgo文の内側を除く
+func channelUses(node ast.Node, skipGo bool) map[string]*chanUse {
+	uses := make(map[string]*chanUse)
+	get := func(expr ast.Expr) *chanUse {
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		if uses[ident.Name] == nil {
+			uses[ident.Name] = &chanUse{}
+		}
+		return uses[ident.Name]
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.GoStmt:
+			return !skipGo
+		case *ast.Ident:
+			if u := get(node); u != nil {
+				u.total++
+			}
+		case *ast.SendStmt:
+			if u := get(node.Chan); u != nil {
+				u.send++
+			}
+		case *ast.UnaryExpr:
+			if u := get(node.X); u != nil && node.Op == token.ARROW {
+				u.recv++
+			}
+		case *ast.RangeStmt:
+			if u := get(node.X); u != nil {
+				u.recv++
+			}
+		case *ast.CallExpr:
+			if ident, ok := node.Fun.(*ast.Ident); ok && ident.Name == "close" && len(node.Args) == 1 {
+				if u := get(node.Args[0]); u != nil {
+					u.send++
+				}
+			}
+		}
+		return true
+	})
+	return uses
+}
+
+// checkChannelOwnership チャネルの所有権の規約違反を検出
+//   - 受信しかしない関数でのclose(ch)
+//   - 送受信の一方しか行わない双方向チャネル引数（<-chan / chan<- で宣言する）
+//   - 送信先の無名goroutineに渡した非バッファチャネルを起動側が受信しない（goroutineが永久にブロックする）
+func (c *Checker) checkChannelOwnership(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil {
+		return
+	}
+	uses := channelUses(fn.Body, false)
+
+	// 受信側でのclose
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		fun, ok := call.Fun.(*ast.Ident)
+		ch, ok2 := call.Args[0].(*ast.Ident)
+		if !ok || !ok2 || fun.Name != "close" {
+			return true
+		}
+		// close自体がsendとして数えられているため、それ以外の送信が無いかを見る
+		if u := uses[ch.Name]; u != nil && u.recv > 0 && u.send == 1 {
+			c.reportChannel(call, filePath,
+				fmt.Sprintf("受信のみを行う関数 '%s' でチャネル '%s' をcloseしています", fn.Name.Name, ch.Name),
+				"チャネルは送信側（所有者）がcloseしてください")
+		}
+		return true
+	})
+
+	// 方向を宣言していないチャネル引数
+	for _, field := range fn.Type.Params.List {
+		ct, ok := field.Type.(*ast.ChanType)
+		if !ok || ct.Dir != ast.SEND|ast.RECV {
+			continue
+		}
+		for _, name := range field.Names {
+			u := uses[name.Name]
+			if u == nil || u.send+u.recv != u.total {
+				continue // 他の関数への受け渡し等
+			}
+			switch {
+			case u.recv > 0 && u.send == 0:
+				c.reportChannel(field, filePath,
+					fmt.Sprintf("引数 '%s' は受信のみに使われています", name.Name),
+					"受信専用チャネル（<-chan）として宣言してください")
+			case u.send > 0 && u.recv == 0:
+				c.reportChannel(field, filePath,
+					fmt.Sprintf("引数 '%s' は送信のみに使われています", name.Name),
+					"送信専用チャネル（chan<-）として宣言してください")
+			}
+		}
+	}
+
+	// 非バッファチャネルを受け取るfire-and-forgetのgoroutine
+	parent := channelUses(fn.Body, true)
+	for name := range unbufferedChans(fn.Body) {
+		if parent[name] != nil && parent[name].recv > 0 || isReturned(fn.Body, name) {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			stmt, ok := n.(*ast.GoStmt)
+			if !ok {
+				return true
+			}
+			if u := channelUses(stmt, false)[name]; u != nil && u.send > 0 {
+				c.reportChannel(stmt, filePath,
+					fmt.Sprintf("goroutineが非バッファチャネル '%s' に送信しますが、起動側で受信していません", name),
+					"起動側で受信するか、バッファ付きチャネルを使用してください")
+			}
+			return false
+		})
+	}
+}
+
+// unbufferedChans ch := make(chan T) 形式で作成された非バッファチャネル名
+func unbufferedChans(body *ast.BlockStmt) map[string]bool {
+	chans := make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || len(as.Lhs) != len(as.Rhs) {
+			return true
+		}
+		for i, rhs := range as.Rhs {
+			call, ok := rhs.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				continue
+			}
+			if fun, ok := call.Fun.(*ast.Ident); !ok || fun.Name != "make" {
+				continue
+			}
+			if _, ok := call.Args[0].(*ast.ChanType); !ok {
+				continue
+			}
+			if len(call.Args) > 1 {
+				if lit, ok := call.Args[1].(*ast.BasicLit); !ok || lit.Value != "0" {
+					continue
+				}
+			}
+			if ident, ok := as.Lhs[i].(*ast.Ident); ok {
+				chans[ident.Name] = true
+			}
+		}
+		return true
+	})
+	return chans
+}
+
+// isReturned 識別子がreturn文で返されているか
+func isReturned(body *ast.BlockStmt, name string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if ret, ok := n.(*ast.ReturnStmt); ok {
+			for _, r := range ret.Results {
+				if ident, ok := r.(*ast.Ident); ok && ident.Name == name {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *Checker) reportChannel(node ast.Node, filePath, message, suggestion string) {
+	rule := c.config.Concurrency.Rules.ChannelOwnership
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "channel_ownership",
+		Category:   "concurrency",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// ========================================
+// 同期されていない共有mapチェック
+// ========================================
+
+// checkSharedMaps goroutineから書き込まれる、ロックで保護されていないパッケージ変数・構造体フィールドのmapを検出
+// ファイル単位で判定し、mapの宣言行または書き込み行の //standards:ignore shared_map で抑制できる
+func (c *Checker) checkSharedMaps(file *ast.File, filePath string) {
+	globals, fields := c.unguardedMaps(file)
+	if len(globals) == 0 && len(fields) == 0 {
+		return
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			funcs[fn.Name.Name] = fn
+		}
+	}
+
+	reported := make(map[token.Pos]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.GoStmt)
+		if !ok {
+			return true
+		}
+
+		// goで起動される関数本体（無名関数・同一ファイル内の関数/メソッド）
+		var body *ast.BlockStmt
+		switch fun := stmt.Call.Fun.(type) {
+		case *ast.FuncLit:
+			body = fun.Body
+		case *ast.Ident:
+			if fn := funcs[fun.Name]; fn != nil {
+				body = fn.Body
+			}
+		case *ast.SelectorExpr:
+			if fn := funcs[fun.Sel.Name]; fn != nil && fn.Recv != nil {
+				body = fn.Body
+			}
+		}
+		if body == nil || callsMethod(body, "Lock") {
+			return true
+		}
+
+		for _, write := range mapWrites(body) {
+			var decl ast.Node
+			switch x := write.X.(type) {
+			case *ast.Ident:
+				decl = globals[x.Name]
+			case *ast.SelectorExpr:
+				decl = fields[x.Sel.Name]
+			}
+			if decl == nil || reported[write.Pos()] {
+				continue
+			}
+			if c.hasIgnoreComment(decl.Pos(), "shared_map") || c.hasIgnoreComment(write.Pos(), "shared_map") {
+				continue
+			}
+			reported[write.Pos()] = true
+
+			rule := c.config.Concurrency.Rules.SharedMap
+			pos := c.fset.Position(write.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "shared_map",
+				Category:   "concurrency",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("goroutineから共有map '%s' に同期なしで書き込んでいます", c.nodeText(filePath, write.X)),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: "sync.Mutex/RWMutexで保護するか、sync.Mapを使用してください",
+			})
+		}
+		return true
+	})
+}
+
+// unguardedMaps ロックで保護されていないmapのパッケージ変数・構造体フィールドを宣言ノードとともに返す
+// パッケージ変数は同じvar宣言ブロックに、構造体フィールドは同じ構造体にロックがあれば保護済みとみなす
+func (c *Checker) unguardedMaps(file *ast.File) (globals map[string]ast.Node, fields map[string]ast.Node) {
+	globals = make(map[string]ast.Node)
+	fields = make(map[string]ast.Node)
+
+	hasLock := func(gd *ast.GenDecl) bool {
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok && vs.Type != nil && isLockTypeExpr(vs.Type) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gd.Tok {
+		case token.VAR:
+			if hasLock(gd) {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for k, name := range vs.Names {
+					var value ast.Expr
+					if k < len(vs.Values) {
+						value = vs.Values[k]
+					}
+					if isMapExpr(vs.Type) || isMapExpr(value) {
+						globals[name.Name] = vs
+					}
+				}
+			}
+		case token.TYPE:
+			for _, spec := range gd.Specs {
+				st, ok := spec.(*ast.TypeSpec).Type.(*ast.StructType)
+				if !ok || st.Fields == nil {
+					continue
+				}
+				guarded := false
+				for _, field := range st.Fields.List {
+					guarded = guarded || isLockTypeExpr(field.Type)
+				}
+				if guarded {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					if _, ok := field.Type.(*ast.MapType); ok {
+						for _, name := range field.Names {
+							fields[name.Name] = field
+						}
+					}
+				}
+			}
+		}
+	}
+	return globals, fields
+}
+
+// isLockTypeExpr 型式がsync.Mutex/sync.RWMutex/sync.Map（ポインタ含む）か
+func isLockTypeExpr(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	return isSelector(expr, "sync", "Mutex") || isSelector(expr, "sync", "RWMutex") || isSelector(expr, "sync", "Map")
+}
+
+// isMapExpr 型式またはmap生成式（map[K]V{} / make(map[K]V)）か
+func isMapExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.MapType:
+		return true
+	case *ast.CompositeLit:
+		_, ok := e.Type.(*ast.MapType)
+		return ok
+	case *ast.CallExpr:
+		if fun, ok := e.Fun.(*ast.Ident); ok && fun.Name == "make" && len(e.Args) > 0 {
+			_, ok := e.Args[0].(*ast.MapType)
+			return ok
+		}
+	}
+	return false
+}
+
+// mapWrites ブロック内のm[k] = v / m[k]++ / delete(m, k) の書き込み先
+func mapWrites(body *ast.BlockStmt) []*ast.IndexExpr {
+	var writes []*ast.IndexExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range node.Lhs {
+				if idx, ok := lhs.(*ast.IndexExpr); ok {
+					writes = append(writes, idx)
+				}
+			}
+		case *ast.IncDecStmt:
+			if idx, ok := node.X.(*ast.IndexExpr); ok {
+				writes = append(writes, idx)
+			}
+		case *ast.CallExpr:
+			if fun, ok := node.Fun.(*ast.Ident); ok && fun.Name == "delete" && len(node.Args) == 2 {
+				writes = append(writes, &ast.IndexExpr{X: node.Args[0], Lbrack: node.Pos(), Index: node.Args[1]})
+			}
+		}
+		return true
+	})
+	return writes
+}
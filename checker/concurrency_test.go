@@ -0,0 +1,510 @@
+package checker
+
+import "testing"
+
+func TestGoroutineLeak(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    goroutine_leak:
+      enabled: true
+      severity: "warning"
+      helpers: ["safego.Go"]
+`
+	runRuleTests(t, config, "goroutine_leak", []ruleTest{
+		{
+			name: "fire and forget goroutine",
+			files: map[string]string{"a.go": `package p
+
+func work() {}
+
+func f() {
+	go work()
+	go func() {
+		for {
+			work()
+		}
+	}()
+}
+`},
+			want: 2,
+		},
+		{
+			name: "context, WaitGroup and helper",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"context"
+	"sync"
+)
+
+func work(ctx context.Context) {}
+
+func f(ctx context.Context) {
+	go work(ctx)
+	go func() {
+		<-ctx.Done()
+	}()
+}
+
+func g() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() { defer wg.Done() }()
+	wg.Wait()
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLockCopyTyped(t *testing.T) {
+	const config = `
+settings:
+  typed: true
+concurrency:
+  enabled: true
+  rules:
+    lock_copy:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "lock_copy", []ruleTest{
+		{
+			name: "value receiver and assignment",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c counter) Get() int { return c.n }
+
+func f(c *counter) int {
+	copied := *c
+	return copied.n
+}
+`,
+			},
+			want: 2,
+		},
+		{
+			name: "pointers only",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *counter) Get() int { return c.n }
+
+func f(c *counter) *counter {
+	p := c
+	return p
+}
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestUnboundedGoroutines(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    unbounded_goroutines:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "unbounded_goroutines", []ruleTest{
+		{
+			name: "goroutine per item",
+			files: map[string]string{"a.go": `package p
+
+func handle(s string) {}
+
+func f(items []string) {
+	for _, item := range items {
+		go handle(item)
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "semaphore and constant bound",
+			files: map[string]string{"a.go": `package p
+
+func handle(s string) {}
+
+func f(items []string) {
+	sem := make(chan struct{}, 8)
+	for _, item := range items {
+		sem <- struct{}{}
+		go func(s string) {
+			defer func() { <-sem }()
+			handle(s)
+		}(item)
+	}
+	for i := 0; i < 4; i++ {
+		go handle("worker")
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSleepRetry(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    sleep_retry:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "sleep_retry", []ruleTest{
+		{
+			name: "fixed sleep in retry loop",
+			files: map[string]string{"a.go": `package p
+
+import "time"
+
+func call() error { return nil }
+
+func f() error {
+	var err error
+	for i := 0; i < 3; i++ {
+		if err = call(); err == nil {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return err
+}
+`},
+			want: 1,
+		},
+		{
+			name: "sleep in polling loop without error check",
+			files: map[string]string{"a.go": `package p
+
+import "time"
+
+func poll() bool { return true }
+
+func f() {
+	for !poll() {
+		time.Sleep(time.Second)
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestChannelOwnership(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    channel_ownership:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "channel_ownership", []ruleTest{
+		{
+			name: "receiver closes channel",
+			files: map[string]string{"a.go": `package p
+
+func consume(ch <-chan int, done chan struct{}) int {
+	n := <-ch
+	<-done
+	close(done)
+	return n
+}
+`},
+			want: 1,
+		},
+		{
+			name: "direction not declared",
+			files: map[string]string{"a.go": `package p
+
+func drain(ch chan int) (n int) {
+	for v := range ch {
+		n += v
+	}
+	return n
+}
+`},
+			want: 1,
+		},
+		{
+			name: "unbuffered channel abandoned by launcher",
+			files: map[string]string{"a.go": `package p
+
+func compute() int { return 1 }
+
+func f() {
+	ch := make(chan int)
+	go func() { ch <- compute() }()
+}
+`},
+			want: 1,
+		},
+		{
+			name: "owner closes and directions declared",
+			files: map[string]string{"a.go": `package p
+
+func produce(out chan<- int) {
+	out <- 1
+}
+
+func consume(in <-chan int) int {
+	return <-in
+}
+
+func f() int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		ch <- 1
+	}()
+	return <-ch
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSharedMap(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    shared_map:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "shared_map", []ruleTest{
+		{
+			name: "package map written from goroutine",
+			files: map[string]string{"a.go": `package p
+
+var cache = map[string]int{}
+
+func f(key string) {
+	go func() {
+		cache[key] = 1
+	}()
+}
+`},
+			want: 1,
+		},
+		{
+			name: "guarded by mutex",
+			files: map[string]string{"a.go": `package p
+
+import "sync"
+
+type store struct {
+	mu   sync.Mutex
+	data map[string]int
+}
+
+func (s *store) set(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = 1
+}
+
+func f(s *store, key string) {
+	go s.set(key)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestTickInLoop(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    tick_in_loop:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "tick_in_loop", []ruleTest{
+		{
+			name: "time.Tick per iteration",
+			files: map[string]string{"a.go": `package p
+
+import "time"
+
+func f(done chan struct{}) {
+	for {
+		select {
+		case <-time.Tick(time.Second):
+		case <-done:
+			return
+		}
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "ticker created outside loop",
+			files: map[string]string{"a.go": `package p
+
+import "time"
+
+func f(done chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSelectSendTimeout(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    select_send_timeout:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "select_send_timeout", []ruleTest{
+		{
+			name: "send without way to give up",
+			files: map[string]string{"a.go": `package p
+
+func f(out chan<- int, in <-chan int) int {
+	select {
+	case out <- 1:
+		return 0
+	case v := <-in:
+		return v
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "send with cancellation and default",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func f(ctx context.Context, out chan int) error {
+	select {
+	case out <- 1:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func g(out chan int) {
+	select {
+	case out <- 1:
+	default:
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLockCopyUntyped(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    lock_copy:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "lock_copy", []ruleTest{
+		{
+			name: "value receiver of struct declared in file",
+			files: map[string]string{"a.go": `package p
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c counter) Get() int { return c.n }
+`},
+			want: 1,
+		},
+		{
+			name: "pointer receiver",
+			files: map[string]string{"a.go": `package p
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *counter) Get() int { return c.n }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestGoroutineLeakIncludeMain(t *testing.T) {
+	const config = `
+concurrency:
+  enabled: true
+  rules:
+    goroutine_leak:
+      enabled: true
+      severity: "warning"
+`
+	src := map[string]string{"main.go": "package main\n\nfunc work() {}\n\nfunc main() { go work() }\n"}
+	runRuleTests(t, config, "goroutine_leak", []ruleTest{
+		{name: "main package skipped by default", files: src, want: 0},
+	})
+	runRuleTests(t, config+"      include_main: true\n", "goroutine_leak", []ruleTest{
+		{name: "main package included", files: src, want: 1},
+	})
+}
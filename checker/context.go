@@ -0,0 +1,341 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// context.Context チェック
+// ========================================
+
+// isContextExpr 型式がcontext.Contextか（型情報があれば型で、無ければ名前で判定）
+func (c *Checker) isContextExpr(expr ast.Expr) bool {
+	if t := c.typeOf(expr); t != nil {
+		return isNamedType(t, "context", "Context")
+	}
+	return isContextType(expr)
+}
+
+// contextFreeMethods contextを受け取らなくてよい標準的なメソッド
+var contextFreeMethods = map[string]bool{
+	"String": true, "Error": true, "GoString": true,
+}
+
+// checkContextParam context.Contextは第1引数・ctxという名前で受け取るか
+// require_inにマッチするパッケージの公開関数・メソッドはcontextを受け取っているか
+func (c *Checker) checkContextParam(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.Context.Rules.FirstParam
+	pos := c.fset.Position(fn.Pos())
+
+	violation := func(message, suggestion string) {
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "context_first_param",
+			Category:   "context",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    message,
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: suggestion,
+		})
+	}
+
+	index := 0
+	for _, field := range fn.Type.Params.List {
+		if !c.isContextExpr(field.Type) {
+			index += max(len(field.Names), 1)
+			continue
+		}
+		if index > 0 {
+			violation(
+				fmt.Sprintf("関数 '%s' のcontext.Contextが第%d引数になっています", fn.Name.Name, index+1),
+				"context.Contextは第1引数で受け取ってください",
+			)
+		} else if len(field.Names) > 0 && field.Names[0].Name != "ctx" && field.Names[0].Name != "_" {
+			violation(
+				fmt.Sprintf("関数 '%s' のcontext.Context引数名が '%s' です", fn.Name.Name, field.Names[0].Name),
+				"context.Contextの引数名はctxにしてください",
+			)
+		}
+		return
+	}
+
+	// contextを受け取らない公開関数・メソッド
+	if !c.requiresContext(fn, filePath) {
+		return
+	}
+	name, kind := fn.Name.Name, "関数"
+	if fn.Recv != nil {
+		name, kind = receiverTypeName(fn)+"."+name, "メソッド"
+	}
+	violation(
+		fmt.Sprintf("公開%s '%s' がcontext.Contextを受け取っていません", kind, name),
+		"第1引数で ctx context.Context を受け取り、下位の呼び出しへ伝播してください",
+	)
+}
+
+// contextCarriers contextを取得できるリクエスト型（ハンドラはcontextを引数で受け取らなくてよい）
+var contextCarriers = map[string]bool{
+	"http.Request": true, "echo.Context": true, "gin.Context": true, "fiber.Ctx": true,
+}
+
+// requiresContext require_inにマッチするパッケージの公開関数・メソッドで、contextを受け取るべきものか
+// コンストラクタ（New〜）・String等の標準的なメソッド・リクエストからcontextを取得できるハンドラは除く
+func (c *Checker) requiresContext(fn *ast.FuncDecl, filePath string) bool {
+	if !ast.IsExported(fn.Name.Name) || !c.isAllowedIn(c.config.Context.Rules.FirstParam.RequireIn, filePath) {
+		return false
+	}
+	if fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "New") {
+		return false
+	}
+	if fn.Recv != nil && (contextFreeMethods[fn.Name.Name] || !ast.IsExported(receiverTypeName(fn))) {
+		return false
+	}
+	for _, field := range fn.Type.Params.List {
+		typ := field.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		sel, ok := typ.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		if x, ok := sel.X.(*ast.Ident); ok && contextCarriers[x.Name+"."+sel.Sel.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkContextBackground ctxが利用可能な関数内でのcontext.Background()/TODO()を検出
+// outside_mainの場合はctxが無い関数でも、mainパッケージ・テスト以外での使用を検出する
+func (c *Checker) checkContextBackground(call *ast.CallExpr, callStr, filePath string) {
+	if f := c.calleeFunc(call); f != nil {
+		if f.Pkg() == nil || f.Pkg().Path() != "context" {
+			return
+		}
+		callStr = "context." + f.Name()
+	}
+	if callStr != "context.Background" && callStr != "context.TODO" {
+		return
+	}
+
+	rule := c.config.Context.Rules.Background
+	fn := c.enclosingFunc(call.Pos())
+	if isMainOrInit(fn) || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	if fn != nil {
+		if ctxName := c.contextInScope(fn, call.Pos()); ctxName != "" {
+			c.reportContextBackground(call,
+				fmt.Sprintf("%sが利用可能な関数内で%s()を使用しています", ctxName, callStr),
+				fmt.Sprintf("%sを伝播してください（キャンセルを切り離す場合はcontext.WithoutCancel(%s)）", ctxName, ctxName),
+				filePath)
+			return
+		}
+	}
+	if rule.OutsideMain && c.file.Name.Name != "main" && !strings.HasSuffix(filePath, "_test.go") {
+		c.reportContextBackground(call,
+			callStr+"()はmainパッケージ・テスト以外では使用しないでください",
+			"呼び出し元からcontext.Contextを受け取ってください", filePath)
+	}
+}
+
+// isMainOrInit main・init関数か（contextを受け取れない起点の関数）
+func isMainOrInit(fn *ast.FuncDecl) bool {
+	return fn != nil && fn.Recv == nil && (fn.Name.Name == "main" || fn.Name.Name == "init")
+}
+
+// reportContextBackground context_backgroundの違反を報告する
+func (c *Checker) reportContextBackground(call *ast.CallExpr, message, suggestion, filePath string) {
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "context_background",
+		Category:   "context",
+		Severity:   rules.ParseSeverity(c.config.Context.Rules.Background.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// contextInScope 指定位置から参照できるcontext.Context引数の名前を返す（無ければ空）
+// 関数本体と、位置を含む関数リテラルの引数を対象とする
+func (c *Checker) contextInScope(fn *ast.FuncDecl, pos token.Pos) string {
+	name := ""
+	find := func(ft *ast.FuncType) {
+		for _, field := range ft.Params.List {
+			if !c.isContextExpr(field.Type) {
+				continue
+			}
+			for _, ident := range field.Names {
+				if ident.Name != "_" {
+					name = ident.Name
+					return
+				}
+			}
+		}
+	}
+
+	find(fn.Type)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		lit, ok := n.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		if lit.Pos() <= pos && pos < lit.End() {
+			find(lit.Type)
+			return true
+		}
+		return false
+	})
+	return name
+}
+
+// checkContextKey context.WithValueのキーに文字列等の基本型を使っていないか
+func (c *Checker) checkContextKey(call *ast.CallExpr, callStr, filePath string) {
+	if f := c.calleeFunc(call); f != nil {
+		if f.Pkg() == nil || f.Pkg().Path() != "context" {
+			return
+		}
+		callStr = "context." + f.Name()
+	}
+	if callStr != "context.WithValue" || len(call.Args) != 3 {
+		return
+	}
+
+	key := call.Args[1]
+	keyType := ""
+	if t := c.typeOf(key); t != nil {
+		if basic, ok := t.(*types.Basic); ok {
+			keyType = types.Default(basic).String()
+		}
+	} else {
+		keyType = basicKeyType(key)
+	}
+	if keyType == "" {
+		return
+	}
+
+	rule := c.config.Context.Rules.TypedKey
+	pos := c.fset.Position(key.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "context_key_type",
+		Category:   "context",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("context.WithValueのキーに%s型が使われています", keyType),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "type ctxKey struct{} のような非公開のキー型を定義して使用してください",
+	})
+}
+
+// basicKeyType 型情報なしでキー式が基本型か判定し、型名を返す
+// リテラル、基本型への変換、リテラルで初期化された型なし定数・変数を対象とする
+func basicKeyType(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return basicLitType(e)
+	case *ast.CallExpr:
+		// string("key") などの変換
+		if ident, ok := e.Fun.(*ast.Ident); ok && ident.Obj == nil && len(e.Args) == 1 {
+			if basicTypeNames[ident.Name] {
+				return ident.Name
+			}
+		}
+	case *ast.Ident:
+		if e.Obj == nil {
+			return ""
+		}
+		spec, ok := e.Obj.Decl.(*ast.ValueSpec)
+		if !ok {
+			return ""
+		}
+		if spec.Type != nil {
+			if ident, ok := spec.Type.(*ast.Ident); ok && basicTypeNames[ident.Name] {
+				return ident.Name
+			}
+			return ""
+		}
+		for i, name := range spec.Names {
+			if name.Name == e.Name && i < len(spec.Values) {
+				if lit, ok := spec.Values[i].(*ast.BasicLit); ok {
+					return basicLitType(lit)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// basicTypeNames 組み込みの基本型名
+var basicTypeNames = map[string]bool{
+	"string": true, "bool": true, "int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+	"float32": true, "float64": true, "byte": true, "rune": true,
+}
+
+// basicLitType リテラルのデフォルト型名
+func basicLitType(lit *ast.BasicLit) string {
+	switch lit.Kind {
+	case token.STRING:
+		return "string"
+	case token.INT:
+		return "int"
+	case token.FLOAT:
+		return "float64"
+	case token.CHAR:
+		return "rune"
+	}
+	return ""
+}
+
+// checkContextInStructSpec 構造体の型定義に対してcontext.Contextフィールドチェックを行う
+func (c *Checker) checkContextInStructSpec(ts *ast.TypeSpec, filePath string) {
+	if st, ok := ts.Type.(*ast.StructType); ok {
+		c.checkContextInStruct(st, ts.Name.Name, filePath)
+	}
+}
+
+// checkContextInStruct 構造体のcontext.Contextフィールドを検出
+func (c *Checker) checkContextInStruct(st *ast.StructType, structName, filePath string) {
+	rule := c.config.Context.Rules.InStruct
+	if st.Fields == nil || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	for _, field := range st.Fields.List {
+		if !c.isContextExpr(field.Type) {
+			continue
+		}
+		name := "(埋め込み)"
+		if len(field.Names) > 0 {
+			name = field.Names[0].Name
+		}
+		pos := c.fset.Position(field.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "context_in_struct",
+			Category:   "context",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("構造体 '%s' のフィールド %s にcontext.Contextを保持しています", structName, name),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "contextは構造体に保持せず、各メソッドの第1引数で受け取ってください",
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package checker
+
+import "testing"
+
+func TestContextFirstParam(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_first_param:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_first_param", []ruleTest{
+		{
+			name: "context not first and not named ctx",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func f(id string, ctx context.Context) {}
+
+func g(c context.Context, id string) {}
+`},
+			want: 2,
+		},
+		{
+			name: "ctx as first parameter",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func f(ctx context.Context, id string) {}
+
+func g(_ context.Context) {}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestContextInStruct(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_in_struct:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_in_struct", []ruleTest{
+		{
+			name: "context field",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+type worker struct {
+	ctx  context.Context
+	name string
+}
+`},
+			want: 1,
+		},
+		{
+			name: "no context field",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+type worker struct {
+	cancel context.CancelFunc
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestContextBackground(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_background:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_background", []ruleTest{
+		{
+			name: "new context while ctx is available",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func run(ctx context.Context) {}
+
+func f(ctx context.Context) {
+	run(context.Background())
+	go func() { run(context.TODO()) }()
+}
+`},
+			want: 2,
+		},
+		{
+			name: "main function",
+			files: map[string]string{"main.go": `package main
+
+import "context"
+
+func run(ctx context.Context) {}
+
+func main() { run(context.Background()) }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestContextKeyType(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_key_type:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_key_type", []ruleTest{
+		{
+			name: "string key",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func f(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, "user_id", id)
+}
+`},
+			want: 1,
+		},
+		{
+			name: "unexported key type",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+type userIDKey struct{}
+
+func f(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestContextRequireIn(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_first_param:
+      enabled: true
+      severity: "warning"
+      require_in: ["**/service/**"]
+`
+	runRuleTests(t, config, "context_first_param", []ruleTest{
+		{
+			name: "exported service function without context",
+			files: map[string]string{"internal/service/user.go": `package service
+
+type UserService struct{}
+
+func (s *UserService) Find(id string) error { return nil }
+`},
+			want: 1,
+		},
+		{
+			name: "constructor, handler and other packages",
+			files: map[string]string{
+				"internal/service/user.go": `package service
+
+import "net/http"
+
+type UserService struct{}
+
+func NewUserService() *UserService { return &UserService{} }
+
+func (s *UserService) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func (s *UserService) String() string { return "users" }
+`,
+				"internal/util/strings.go": "package util\n\nfunc Trim(s string) string { return s }\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestContextBackgroundOutsideMain(t *testing.T) {
+	const config = `
+context:
+  enabled: true
+  rules:
+    context_background:
+      enabled: true
+      severity: "warning"
+      outside_main: true
+`
+	runRuleTests(t, config, "context_background", []ruleTest{
+		{
+			name: "library function without ctx",
+			files: map[string]string{"lib/a.go": `package lib
+
+import "context"
+
+func run(ctx context.Context) {}
+
+func Start() { run(context.Background()) }
+`},
+			want: 1,
+		},
+		{
+			name: "main package",
+			files: map[string]string{"cmd/tool/main.go": `package main
+
+import "context"
+
+func run(ctx context.Context) {}
+
+func start() { run(context.TODO()) }
+
+func main() { start() }
+`},
+			want: 0,
+		},
+	})
+}
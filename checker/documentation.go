@@ -0,0 +1,281 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// ドキュメントコメントチェック
+// ========================================
+
+// docTarget ドキュメントコメントの対象の公開シンボル
+type docTarget struct {
+	kind    string            // 関数・メソッド・型・定数・変数
+	names   []string          // 宣言した名前（メソッドは Type.Method）
+	doc     *ast.CommentGroup // ドキュメントコメント（無ければnil）
+	grouped bool              // 括弧でまとめた宣言のコメント（名前で始まらなくてよい）
+	pos     token.Pos
+}
+
+// funcDocTarget 公開された関数・メソッド（公開された型のメソッドのみ）
+func funcDocTarget(fn *ast.FuncDecl) (docTarget, bool) {
+	if !fn.Name.IsExported() {
+		return docTarget{}, false
+	}
+	if fn.Recv == nil {
+		return docTarget{kind: "関数", names: []string{fn.Name.Name}, doc: fn.Doc, pos: fn.Pos()}, true
+	}
+	recv := receiverTypeName(fn)
+	if !ast.IsExported(recv) {
+		return docTarget{}, false
+	}
+	return docTarget{kind: "メソッド", names: []string{recv + "." + fn.Name.Name}, doc: fn.Doc, pos: fn.Pos()}, true
+}
+
+// genDeclDocTargets 宣言中の公開された型・定数・変数
+// 括弧でまとめた宣言は、宣言全体のコメントがあれば個々のコメントを省略できる
+func genDeclDocTargets(gd *ast.GenDecl) []docTarget {
+	var kind string
+	switch gd.Tok {
+	case token.TYPE:
+		kind = "型"
+	case token.CONST:
+		kind = "定数"
+	case token.VAR:
+		kind = "変数"
+	default:
+		return nil
+	}
+	grouped := gd.Lparen.IsValid()
+
+	var targets []docTarget
+	for _, spec := range gd.Specs {
+		var names []string
+		var doc *ast.CommentGroup
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			names, doc = []string{s.Name.Name}, s.Doc
+		case *ast.ValueSpec:
+			for _, name := range s.Names {
+				names = append(names, name.Name)
+			}
+			doc = s.Doc
+		}
+		if !anyExported(names) {
+			continue
+		}
+		target := docTarget{kind: kind, names: names, doc: doc, pos: spec.Pos()}
+		if doc == nil {
+			target.doc, target.grouped = gd.Doc, grouped
+		}
+		if !grouped {
+			target.pos = gd.Pos()
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// anyExported 公開された名前があるか
+func anyExported(names []string) bool {
+	for _, name := range names {
+		if ast.IsExported(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// docSymbol 違反メッセージに使う名前（最初の公開された名前）
+func (t docTarget) docSymbol() string {
+	for _, name := range t.names {
+		if ast.IsExported(name[strings.LastIndex(name, ".")+1:]) {
+			return name
+		}
+	}
+	return t.names[0]
+}
+
+// checkExportedFuncDoc 公開された関数・メソッドにドキュメントコメントがあるか
+func (c *Checker) checkExportedFuncDoc(fn *ast.FuncDecl, filePath string) {
+	if target, ok := funcDocTarget(fn); ok {
+		c.checkExportedDoc(target, filePath)
+	}
+}
+
+// checkExportedGenDeclDoc 公開された型・定数・変数にドキュメントコメントがあるか
+func (c *Checker) checkExportedGenDeclDoc(gd *ast.GenDecl, filePath string) {
+	for _, target := range genDeclDocTargets(gd) {
+		c.checkExportedDoc(target, filePath)
+	}
+}
+
+// checkExportedDoc 公開シンボルにドキュメントコメントがあるか
+func (c *Checker) checkExportedDoc(target docTarget, filePath string) {
+	rule := c.config.Documentation.Rules.ExportedDoc
+	if target.doc != nil || strings.HasSuffix(filePath, "_test.go") || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	name := target.docSymbol()
+	c.reportDoc("exported_doc", rule.Severity, target.pos, filePath,
+		fmt.Sprintf("公開された%s '%s' にドキュメントコメントがありません", target.kind, name),
+		"// "+name[strings.LastIndex(name, ".")+1:]+" ... の形式でドキュメントコメントを追加してください")
+}
+
+// checkFuncDocPrefix 関数・メソッドのドキュメントコメントが名前で始まっているか
+func (c *Checker) checkFuncDocPrefix(fn *ast.FuncDecl, filePath string) {
+	if target, ok := funcDocTarget(fn); ok {
+		c.checkDocPrefix(target, filePath)
+	}
+}
+
+// checkGenDeclDocPrefix 型・定数・変数のドキュメントコメントが名前で始まっているか
+func (c *Checker) checkGenDeclDocPrefix(gd *ast.GenDecl, filePath string) {
+	for _, target := range genDeclDocTargets(gd) {
+		c.checkDocPrefix(target, filePath)
+	}
+}
+
+// checkDocPrefix ドキュメントコメントが宣言した名前で始まっているか（godocの規約）
+// 型は冠詞（A・An・The）に続けて名前を書いてもよく、Deprecated: で始まるコメントは対象外
+func (c *Checker) checkDocPrefix(target docTarget, filePath string) {
+	rule := c.config.Documentation.Rules.DocPrefix
+	if target.doc == nil || target.grouped || strings.HasSuffix(filePath, "_test.go") || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	text := strings.TrimSpace(target.doc.Text())
+	if strings.HasPrefix(text, "Deprecated:") {
+		return
+	}
+	if target.kind == "型" {
+		for _, article := range []string{"A ", "An ", "The "} {
+			text = strings.TrimPrefix(text, article)
+		}
+	}
+	for _, name := range target.names {
+		name = name[strings.LastIndex(name, ".")+1:]
+		if text == name || strings.HasPrefix(text, name+" ") || strings.HasPrefix(text, name+"\n") {
+			return
+		}
+	}
+
+	name := target.docSymbol()
+	c.reportDoc("doc_prefix", rule.Severity, target.doc.Pos(), filePath,
+		fmt.Sprintf("%s '%s' のドキュメントコメントが名前で始まっていません", target.kind, name),
+		"コメントを '"+name[strings.LastIndex(name, ".")+1:]+" ...' で始めてください")
+}
+
+// reportDoc ドキュメントコメントの違反を報告する
+func (c *Checker) reportDoc(ruleName, severity string, at token.Pos, filePath, message, suggestion string) {
+	pos := c.fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Rule:       ruleName,
+		Category:   "documentation",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// packageDoc パッケージのパッケージコメントの状況
+type packageDoc struct {
+	name     string
+	files    []string       // パッケージのファイル（テストファイルを除く）
+	docFiles []string       // パッケージコメントがあるファイル
+	badFile  string         // 'Package <名前>' で始まらないパッケージコメントのファイル
+	lines    map[string]int // ファイル→package句の行
+}
+
+// checkPackageComment main以外のパッケージにパッケージコメント（// Package foo ...）があるか
+// require_doc_goの場合はdoc.goに書かれているかも確認する
+func (c *Checker) checkPackageComment(ctx *ProjectContext) {
+	rule := c.config.Documentation.Rules.PackageComment
+
+	var dirs []string
+	pkgs := make(map[string]*packageDoc)
+	for _, path := range ctx.Files {
+		if strings.HasSuffix(path, "_test.go") || c.isAllowedIn(rule.AllowedIn, path) {
+			continue
+		}
+		if !c.config.Settings.SkipGenerated && c.isGenerated(path, c.generatedMarker()) {
+			continue
+		}
+		src, err := c.readSource(path)
+		if err != nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, src, parser.PackageClauseOnly|parser.ParseComments)
+		if err != nil || file.Name.Name == "main" {
+			continue
+		}
+		dir := filepath.Dir(path)
+		pkg := pkgs[dir]
+		if pkg == nil {
+			pkg = &packageDoc{name: file.Name.Name, lines: make(map[string]int)}
+			pkgs[dir] = pkg
+			dirs = append(dirs, dir)
+		}
+		pkg.files = append(pkg.files, path)
+		pkg.lines[path] = fset.Position(file.Package).Line
+		if file.Doc == nil {
+			continue
+		}
+		pkg.docFiles = append(pkg.docFiles, path)
+		if words := strings.Fields(file.Doc.Text()); len(words) < 2 || words[0] != "Package" || words[1] != pkg.name {
+			pkg.badFile = path
+		}
+	}
+
+	for _, dir := range dirs {
+		c.checkPackageDoc(pkgs[dir], dir, rule)
+	}
+}
+
+// checkPackageDoc 1つのパッケージのパッケージコメントを検査する
+func (c *Checker) checkPackageDoc(pkg *packageDoc, dir string, rule rules.PackageCommentRule) {
+	docGo := filepath.Join(dir, "doc.go")
+	var path, message, suggestion string
+	switch {
+	case len(pkg.docFiles) == 0:
+		path = pkg.files[0]
+		if containsString(pkg.files, docGo) {
+			path = docGo
+		}
+		message = "パッケージ '" + pkg.name + "' にパッケージコメントがありません"
+		suggestion = "doc.go を作成し、// Package " + pkg.name + " ... の形式でパッケージの概要を記述してください"
+	case pkg.badFile != "":
+		path = pkg.badFile
+		message = "パッケージ '" + pkg.name + "' のパッケージコメントが 'Package " + pkg.name + "' で始まっていません"
+		suggestion = "// Package " + pkg.name + " ... の形式で記述してください"
+	case rule.RequireDocGo && !containsString(pkg.docFiles, docGo):
+		path = pkg.docFiles[0]
+		message = "パッケージ '" + pkg.name + "' のパッケージコメントが doc.go にありません"
+		suggestion = "パッケージコメントを doc.go に移動してください"
+	default:
+		return
+	}
+
+	line := pkg.lines[path]
+	c.report.AddViolation(report.Violation{
+		File:       path,
+		Line:       line,
+		Rule:       "package_comment",
+		Category:   "documentation",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(path, line),
+		Suggestion: suggestion,
+	})
+}
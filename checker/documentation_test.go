@@ -0,0 +1,118 @@
+package checker
+
+import "testing"
+
+func TestExportedDoc(t *testing.T) {
+	const config = `
+documentation:
+  enabled: true
+  rules:
+    exported_doc:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "exported_doc", []ruleTest{
+		{
+			name: "exported function, type and method without doc",
+			files: map[string]string{"a.go": `package p
+
+type User struct{}
+
+func (u *User) Name() string { return "" }
+
+func Find(id string) *User { return nil }
+`},
+			want: 3,
+		},
+		{
+			name: "documented, grouped and unexported",
+			files: map[string]string{"a.go": `package p
+
+// User ユーザー
+type User struct{}
+
+// Find IDでユーザーを検索する
+func Find(id string) *User { return nil }
+
+// 状態
+const (
+	Active   = "active"
+	Disabled = "disabled"
+)
+
+func find(id string) *User { return nil }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestDocPrefix(t *testing.T) {
+	const config = `
+documentation:
+  enabled: true
+  rules:
+    doc_prefix:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "doc_prefix", []ruleTest{
+		{
+			name: "doc not starting with name",
+			files: map[string]string{"a.go": `package p
+
+// Returns the user
+func Find(id string) {}
+
+// ユーザー
+type User struct{}
+`},
+			want: 2,
+		},
+		{
+			name: "name, article and deprecated",
+			files: map[string]string{"a.go": `package p
+
+// Find returns the user
+func Find(id string) {}
+
+// A User is a member
+type User struct{}
+
+// Deprecated: use Find
+func Lookup(id string) {}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestPackageComment(t *testing.T) {
+	const config = `
+documentation:
+  enabled: true
+  rules:
+    package_comment:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "package_comment", []ruleTest{
+		{
+			name: "missing and malformed package comments",
+			files: map[string]string{
+				"user/user.go":   "package user\n\nfunc Find() {}\n",
+				"order/order.go": "// 注文を扱う\npackage order\n",
+			},
+			want: 2,
+		},
+		{
+			name: "package comment in one file and main package",
+			files: map[string]string{
+				"user/doc.go":  "// Package user ユーザーを扱う\npackage user\n",
+				"user/user.go": "package user\n\nfunc Find() {}\n",
+				"main.go":      "package main\n\nfunc main() {}\n",
+			},
+			want: 0,
+		},
+	})
+}
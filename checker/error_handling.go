@@ -0,0 +1,578 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// 操作の説明にならない汎用的なラップメッセージ
+var genericWrapPrefixes = map[string]bool{
+	"error": true, "err": true, "failed": true, "failure": true, "fail": true,
+	"エラー": true, "失敗": true,
+}
+
+// ========================================
+// 戻り値のエラーの無視チェック（式文）
+// ========================================
+
+// isAllowedIgnoredError 呼び出しがallowed_patternsに一致し、エラーの無視を許可されているか
+func (c *Checker) isAllowedIgnoredError(callStr string) bool {
+	for _, pattern := range c.patterns.AllowedErrors {
+		if pattern.MatchString(callStr) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIgnoredErrorStmt エラーを返す関数を f() のように呼び、戻り値ごと捨てている式文のチェック
+// 戻り値の型が分かる場合（-typed）のみ判定する
+func (c *Checker) checkIgnoredErrorStmt(stmt *ast.ExprStmt, filePath string) {
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || !returnsError(c.typeOf(call)) || neverFails(c.calleeFunc(call)) {
+		return
+	}
+	callStr := c.getCallExprString(call)
+	if c.isAllowedIgnoredError(callStr) {
+		return
+	}
+
+	rule := c.config.ErrorHandling.Rules.NoIgnoredErrors
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "no_ignored_errors",
+		Category:   "error_handling",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    rule.Message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: fmt.Sprintf("%s の戻り値のエラーを確認してください（意図的に無視する場合は //standards:ignore no_ignored_errors で理由を明記）", callStr),
+	})
+}
+
+// returnsError 呼び出し結果の型（多値の場合はいずれか）がerrorか
+func returnsError(t types.Type) bool {
+	if tuple, ok := t.(*types.Tuple); ok {
+		for i := 0; i < tuple.Len(); i++ {
+			if isErrorType(tuple.At(i).Type()) {
+				return true
+			}
+		}
+		return false
+	}
+	return isErrorType(t)
+}
+
+// neverFails エラーを返すがドキュメント上常にnilを返すメソッドか（strings.Builder・bytes.Bufferの書き込み）
+func neverFails(fn *types.Func) bool {
+	if fn == nil {
+		return false
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	return isNamedType(recv.Type(), "strings", "Builder") || isNamedType(recv.Type(), "bytes", "Buffer")
+}
+
+// ========================================
+// エラーのラップ（%w）チェック
+// ========================================
+
+// formatVerbRe 書式指定子（%%、引数インデックス・*を含むものは別に扱う）
+var formatVerbRe = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]*)?([a-zA-Z%\[*])`)
+
+// checkErrorWrapping エラーを%v・%sで埋め込んだfmt.Errorfと、errors.New(err.Error())による作り直しを検出
+// どちらも元のエラーがラップされず、errors.Is・errors.Asで判定できなくなる
+func (c *Checker) checkErrorWrapping(call *ast.CallExpr, callStr, filePath string) {
+	switch callStr {
+	case "fmt.Errorf":
+		c.checkErrorfWrapping(call, filePath)
+	case "errors.New":
+		if len(call.Args) != 1 {
+			return
+		}
+		inner, ok := call.Args[0].(*ast.CallExpr)
+		if !ok || len(inner.Args) != 0 {
+			return
+		}
+		sel, ok := inner.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Error" || !c.isErrorValue(sel.X) {
+			return
+		}
+		errText := c.nodeText(filePath, sel.X)
+		c.reportErrorWrapping(call, filePath,
+			"errors.New("+errText+".Error())はエラーを作り直しているため、元のエラーをラップしていません",
+			`fmt.Errorf("...: %w", `+errText+")", nil)
+	}
+}
+
+// checkErrorfWrapping fmt.Errorfの%v・%sに対応する引数がエラー（またはerr.Error()）か
+func (c *Checker) checkErrorfWrapping(call *ast.CallExpr, filePath string) {
+	if len(call.Args) < 2 {
+		return
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	verbs, ok := formatVerbs(lit.Value, len(call.Args))
+	if !ok {
+		return
+	}
+
+	var edits []report.TextEdit
+	var corrected strings.Builder
+	last := 0
+	args := c.argTexts(call, filePath)
+	litStart := c.fset.Position(lit.Pos()).Offset
+	for _, v := range verbs {
+		arg := call.Args[v.arg]
+		errExpr := c.wrappableError(arg)
+		if (v.verb != "v" && v.verb != "s") || errExpr == nil {
+			continue
+		}
+		edits = append(edits, report.TextEdit{Start: litStart + v.start, End: litStart + v.end, NewText: "%w"})
+		if errExpr != arg {
+			args[v.arg] = c.nodeText(filePath, errExpr)
+			edits = append(edits, c.replaceEdit(arg, args[v.arg]))
+		}
+		corrected.WriteString(lit.Value[last:v.start] + "%w")
+		last = v.end
+	}
+	if len(edits) == 0 {
+		return
+	}
+	corrected.WriteString(lit.Value[last:])
+	args[0] = corrected.String()
+
+	c.reportErrorWrapping(call, filePath,
+		"fmt.Errorfでエラーを%v・%sで埋め込んでいるため、元のエラーをラップしていません",
+		"fmt.Errorf("+strings.Join(args, ", ")+")",
+		&report.Fix{Description: "%wでラップ", Edits: edits})
+}
+
+// formatVerb 書式文字列内の書式指定子と、対応する引数
+type formatVerb struct {
+	start, end int // リテラル内の範囲
+	verb       string
+	arg        int // 引数のインデックス
+}
+
+// formatVerbs 書式指定子（%%を除く）と引数の対応
+// 引数インデックス・*を含む場合や引数が足りない場合は対応を追えないためfalse
+func formatVerbs(format string, nargs int) ([]formatVerb, bool) {
+	var verbs []formatVerb
+	arg := 1
+	for _, m := range formatVerbRe.FindAllStringSubmatchIndex(format, -1) {
+		verb := format[m[2]:m[3]]
+		switch {
+		case verb == "%":
+			continue
+		case verb == "[" || verb == "*" || arg >= nargs:
+			return nil, false
+		}
+		verbs = append(verbs, formatVerb{start: m[0], end: m[1], verb: verb, arg: arg})
+		arg++
+	}
+	return verbs, true
+}
+
+// argTexts 呼び出しの各引数のソーステキスト
+func (c *Checker) argTexts(call *ast.CallExpr, filePath string) []string {
+	args := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = c.nodeText(filePath, arg)
+	}
+	return args
+}
+
+// wrappableError %wでラップできるエラーの式（引数がerr.Error()の場合はerr、エラーでない場合はnil）
+func (c *Checker) wrappableError(arg ast.Expr) ast.Expr {
+	if call, ok := arg.(*ast.CallExpr); ok && len(call.Args) == 0 {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" && c.isErrorValue(sel.X) {
+			return sel.X
+		}
+		return nil
+	}
+	if c.isErrorValue(arg) {
+		return arg
+	}
+	return nil
+}
+
+// errNameRe エラーを保持すると推定する変数名（err、readErr、ErrNotFound等）
+var errNameRe = regexp.MustCompile(`^(?:err|[a-zA-Z0-9_]*Err|Err[A-Z][a-zA-Z0-9_]*)$`)
+
+// isErrorValue 式がエラーの値か（型情報が無い場合は変数名から推定する）
+func (c *Checker) isErrorValue(expr ast.Expr) bool {
+	if t := c.typeOf(expr); t != nil {
+		return isErrorType(t)
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return errNameRe.MatchString(e.Name)
+	case *ast.SelectorExpr:
+		return errNameRe.MatchString(e.Sel.Name)
+	}
+	return false
+}
+
+// reportErrorWrapping error_wrappingの違反を報告
+func (c *Checker) reportErrorWrapping(call *ast.CallExpr, filePath, message, suggestion string, fix *report.Fix) {
+	rule := c.config.ErrorHandling.Rules.ErrorWrapping
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "error_wrapping",
+		Category:   "error_handling",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+		Fix:        fix,
+	})
+}
+
+// ========================================
+// errors.New / fmt.Errorf 使い分けチェック
+// ========================================
+
+func (c *Checker) checkErrorConstructor(call *ast.CallExpr, callStr, filePath string) {
+	rule := c.config.ErrorHandling.Rules.ErrorConstructor
+	pos := c.fset.Position(call.Pos())
+
+	switch callStr {
+	case "fmt.Errorf":
+		// 書式指定子を含まない定数メッセージ
+		if len(call.Args) != 1 {
+			return
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING || strings.Contains(lit.Value, "%") {
+			return
+		}
+
+		edits := []report.TextEdit{c.replaceEdit(call.Fun, "errors.New")}
+		edits = append(edits, c.addImportEdits(c.file, "errors")...)
+		edits = append(edits, c.removeImportEdits(c.file, "fmt", "fmt", 1)...)
+
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "error_constructor",
+			Category:   "error_handling",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    "書式指定子のないfmt.Errorfはerrors.Newを使用してください",
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "errors.New(" + lit.Value + ")",
+			Fix:        &report.Fix{Description: "errors.Newに置換", Edits: edits},
+		})
+
+	case "errors.New":
+		// errors.New(fmt.Sprintf(...))
+		if len(call.Args) != 1 {
+			return
+		}
+		inner, ok := call.Args[0].(*ast.CallExpr)
+		if !ok || c.getCallExprString(inner) != "fmt.Sprintf" {
+			return
+		}
+
+		args := make([]string, 0, len(inner.Args))
+		for _, arg := range inner.Args {
+			args = append(args, c.nodeText(filePath, arg))
+		}
+		replacement := "fmt.Errorf(" + strings.Join(args, ", ") + ")"
+
+		edits := []report.TextEdit{c.replaceEdit(call, replacement)}
+		edits = append(edits, c.removeImportEdits(c.file, "errors", "errors", 1)...)
+
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "error_constructor",
+			Category:   "error_handling",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    "errors.New(fmt.Sprintf(...))はfmt.Errorfを使用してください",
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: replacement,
+			Fix:        &report.Fix{Description: "fmt.Errorfに置換", Edits: edits},
+		})
+	}
+}
+
+// ========================================
+// エラーラップメッセージのコンテキストチェック
+// ========================================
+
+func (c *Checker) checkWrapContext(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil {
+		return
+	}
+	rule := c.config.ErrorHandling.Rules.WrapContext
+
+	// 関数内で呼び出している関数名（ラップメッセージとの比較用）
+	callees := make(map[string]bool)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			switch f := call.Fun.(type) {
+			case *ast.Ident:
+				callees[normalizeName(f.Name)] = true
+			case *ast.SelectorExpr:
+				callees[normalizeName(f.Sel.Name)] = true
+				if callStr := c.getCallExprString(call); callStr != "" {
+					callees[normalizeName(callStr)] = true
+				}
+			}
+		}
+		return true
+	})
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || c.getCallExprString(call) != "fmt.Errorf" {
+			return true
+		}
+		lit := stringLit(call.Args, 0)
+		if lit == nil {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+		idx := strings.Index(format, "%w")
+		if idx < 0 {
+			return true
+		}
+
+		prefix := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(format[:idx]), ":"))
+		var message string
+		switch {
+		case prefix == "":
+			message = "エラーをラップする際は操作の説明を付与してください（例: \"ユーザー取得: %w\"）"
+		case genericWrapPrefixes[strings.ToLower(prefix)]:
+			message = fmt.Sprintf("ラップメッセージ '%s' は汎用的すぎます。失敗した操作を説明してください", prefix)
+		case callees[normalizeName(prefix)]:
+			message = fmt.Sprintf("ラップメッセージ '%s' が呼び出し先の関数名の繰り返しになっています。操作の説明を付与してください", prefix)
+		default:
+			return true
+		}
+
+		pos := c.fset.Position(call.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "wrap_context",
+			Category:   "error_handling",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    message,
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "fmt.Errorf(\"failed to load user %s: %w\", id, err) のように操作と対象を記述してください",
+		})
+		return true
+	})
+}
+
+// ========================================
+// HTTPハンドラのエラーレスポンスチェック
+// ========================================
+
+func (c *Checker) checkHTTPErrorResponse(fn *ast.FuncDecl, filePath string) {
+	writer := httpHandlerWriter(fn.Type)
+	if fn.Body == nil || writer == "" {
+		return
+	}
+	rule := c.config.ErrorHandling.Rules.HTTPErrorResp
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		ifStmt, ok := n.(*ast.IfStmt)
+		if !ok || !isErrNotNilCond(ifStmt.Cond) || !containsReturn(ifStmt.Body) {
+			return true
+		}
+		if c.respondsWithError(ifStmt.Body, writer, rule.Helpers) {
+			return true
+		}
+
+		pos := c.fset.Position(ifStmt.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "http_error_response",
+			Category:   "error_handling",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("ハンドラ '%s' のエラー分岐がエラーレスポンスを返さずにreturnしています", fn.Name.Name),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: fmt.Sprintf("http.Error(%s, ...) または %s.WriteHeader(<4xx/5xx>) でエラーを返却してください", writer, writer),
+		})
+		return true
+	})
+}
+
+// httpHandlerWriter (http.ResponseWriter, *http.Request) シグネチャであればWriterの引数名を返す
+func httpHandlerWriter(ft *ast.FuncType) string {
+	if ft.Params == nil {
+		return ""
+	}
+	var writer string
+	hasRequest := false
+	for _, field := range ft.Params.List {
+		if isSelector(field.Type, "http", "ResponseWriter") && len(field.Names) > 0 {
+			writer = field.Names[0].Name
+		}
+		if star, ok := field.Type.(*ast.StarExpr); ok && isSelector(star.X, "http", "Request") {
+			hasRequest = true
+		}
+	}
+	if !hasRequest || writer == "_" {
+		return ""
+	}
+	return writer
+}
+
+// respondsWithError ブロック内でエラーレスポンスを書き込んでいるか
+func (c *Checker) respondsWithError(block *ast.BlockStmt, writer string, helpers []string) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		callStr := c.getCallExprString(call)
+		if callStr == "http.Error" || containsString(helpers, callStr) {
+			found = true
+			return false
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			if containsString(helpers, sel.Sel.Name) {
+				found = true
+				return false
+			}
+			// w.WriteHeader(http.StatusOK) 以外
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == writer && sel.Sel.Name == "WriteHeader" && len(call.Args) == 1 {
+				if !isSelector(call.Args[0], "http", "StatusOK") {
+					if lit, ok := call.Args[0].(*ast.BasicLit); !ok || lit.Value != "200" {
+						found = true
+						return false
+					}
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// ========================================
+// rows.Err() / scanner.Err() チェック
+// ========================================
+
+func (c *Checker) checkIteratorErr(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil {
+		return
+	}
+	rule := c.config.ErrorHandling.Rules.IteratorErr
+
+	// for rows.Next() / for scanner.Scan() ループと .Err() 呼び出し位置を収集
+	type iterLoop struct {
+		loop   *ast.ForStmt
+		name   string
+		method string
+	}
+	var loops []iterLoop
+	errCalls := make(map[string][]token.Pos)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ForStmt:
+			call, ok := node.Cond.(*ast.CallExpr)
+			if !ok || len(call.Args) != 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (sel.Sel.Name != "Next" && sel.Sel.Name != "Scan") {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok {
+				loops = append(loops, iterLoop{loop: node, name: x.Name, method: sel.Sel.Name})
+			}
+		case *ast.CallExpr:
+			if sel, ok := node.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Err" && len(node.Args) == 0 {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					errCalls[x.Name] = append(errCalls[x.Name], node.Pos())
+				}
+			}
+		}
+		return true
+	})
+
+	for _, l := range loops {
+		checked := false
+		for _, p := range errCalls[l.name] {
+			if p > l.loop.End() {
+				checked = true
+				break
+			}
+		}
+		if checked {
+			continue
+		}
+
+		pos := c.fset.Position(l.loop.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "iterator_err",
+			Category:   "error_handling",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("%s.%s() ループの後で %s.Err() を確認していません", l.name, l.method, l.name),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: fmt.Sprintf("if err := %s.Err(); err != nil { ... } をループの後に追加してください", l.name),
+		})
+	}
+}
+
+// isErrNotNilCond 条件式が err != nil 形式か
+func isErrNotNilCond(expr ast.Expr) bool {
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok || bin.Op != token.NEQ {
+		return false
+	}
+	ident, ok := bin.X.(*ast.Ident)
+	if !ok || !strings.HasPrefix(strings.ToLower(ident.Name), "err") {
+		return false
+	}
+	nilIdent, ok := bin.Y.(*ast.Ident)
+	return ok && nilIdent.Name == "nil"
+}
+
+// containsReturn ブロック直下にreturn文があるか
+func containsReturn(block *ast.BlockStmt) bool {
+	for _, stmt := range block.List {
+		if _, ok := stmt.(*ast.ReturnStmt); ok {
+			return true
+		}
+	}
+	return false
+}
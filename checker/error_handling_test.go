@@ -0,0 +1,409 @@
+package checker
+
+import "testing"
+
+func TestErrorConstructor(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    error_constructor:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "error_constructor", []ruleTest{
+		{
+			name: "fmt.Errorf without verbs",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func f() error { return fmt.Errorf("not found") }
+`},
+			want: 1,
+		},
+		{
+			name: "errors.New with fmt.Sprintf",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"errors"
+	"fmt"
+)
+
+func f(id int) error { return errors.New(fmt.Sprintf("user %d not found", id)) }
+`},
+			want: 1,
+		},
+		{
+			name: "constructors used as intended",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"errors"
+	"fmt"
+)
+
+func f(id int) error {
+	if id < 0 {
+		return errors.New("negative id")
+	}
+	return fmt.Errorf("user %d not found", id)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestWrapContext(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    wrap_context:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "wrap_context", []ruleTest{
+		{
+			name: "empty and generic prefixes",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func f(err error) (error, error) {
+	return fmt.Errorf(": %w", err), fmt.Errorf("failed: %w", err)
+}
+`},
+			want: 2,
+		},
+		{
+			name: "callee name repeated",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func loadUser() error { return nil }
+
+func f() error {
+	if err := loadUser(); err != nil {
+		return fmt.Errorf("loadUser: %w", err)
+	}
+	return nil
+}
+`},
+			want: 1,
+		},
+		{
+			name: "operation described",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func loadUser() error { return nil }
+
+func f(id string) error {
+	if err := loadUser(); err != nil {
+		return fmt.Errorf("load user %s: %w", id, err)
+	}
+	return nil
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestHTTPErrorResponse(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    http_error_response:
+      enabled: true
+      severity: "warning"
+      helpers: ["respondError"]
+`
+	runRuleTests(t, config, "http_error_response", []ruleTest{
+		{
+			name: "bare return in error branch",
+			files: map[string]string{"a.go": `package p
+
+import "net/http"
+
+func load(r *http.Request) error { return nil }
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	if err := load(r); err != nil {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+`},
+			want: 1,
+		},
+		{
+			name: "http.Error, WriteHeader and helper",
+			files: map[string]string{"a.go": `package p
+
+import "net/http"
+
+func load(r *http.Request) error { return nil }
+
+func respondError(w http.ResponseWriter, err error) {}
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	if err := load(r); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := load(r); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := load(r); err != nil {
+		respondError(w, err)
+		return
+	}
+}
+`},
+			want: 0,
+		},
+		{
+			name: "not a handler",
+			files: map[string]string{"a.go": `package p
+
+func load() error { return nil }
+
+func f() {
+	if err := load(); err != nil {
+		return
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestIteratorErr(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    iterator_err:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "iterator_err", []ruleTest{
+		{
+			name: "rows.Err not checked",
+			files: map[string]string{"a.go": `package p
+
+import "database/sql"
+
+func f(rows *sql.Rows) {
+	for rows.Next() {
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "scanner.Err checked after loop",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"bufio"
+	"os"
+)
+
+func f() error {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+	}
+	return scanner.Err()
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestNoPanicAllowed(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    no_panic:
+      enabled: true
+      severity: "warning"
+      allowed_in: ["cmd/**"]
+      allowed_functions: ["Must*"]
+`
+	runRuleTests(t, config, "no_panic", []ruleTest{
+		{
+			name: "panic in library code",
+			files: map[string]string{"pkg/a.go": `package pkg
+
+func f() { panic("unreachable") }
+`},
+			want: 1,
+		},
+		{
+			name: "allowed path",
+			files: map[string]string{"cmd/tool/main.go": `package main
+
+func main() { panic("boom") }
+`},
+			want: 0,
+		},
+		{
+			name: "allowed function",
+			files: map[string]string{"pkg/a.go": `package pkg
+
+func MustParse(s string) int {
+	if s == "" {
+		panic("empty")
+	}
+	return len(s)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestNoIgnoredErrorsTyped(t *testing.T) {
+	const config = `
+settings:
+  typed: true
+error_handling:
+  enabled: true
+  rules:
+    no_ignored_errors:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "no_ignored_errors", []ruleTest{
+		{
+			name: "error discarded from multiple results",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "strconv"
+
+func f() int {
+	n, _ := strconv.Atoi("1")
+	return n
+}
+`,
+			},
+			want: 1,
+		},
+		{
+			name: "discarded value is not an error",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "strconv"
+
+func f() {
+	_ = strconv.Itoa(1)
+}
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestNoIgnoredErrorsStatement(t *testing.T) {
+	const config = `
+settings:
+  typed: true
+error_handling:
+  enabled: true
+  rules:
+    no_ignored_errors:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "no_ignored_errors", []ruleTest{
+		{
+			name: "call result discarded",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "strconv"
+
+func f() {
+	strconv.ParseBool("true")
+}
+`,
+			},
+			want: 1,
+		},
+		{
+			name: "writes that never fail",
+			files: map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go": `package p
+
+import "strings"
+
+func f() string {
+	var b strings.Builder
+	b.WriteString("x")
+	return b.String()
+}
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestErrorWrapping(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    error_wrapping:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "error_wrapping", []ruleTest{
+		{
+			name: "error formatted with %v and recreated",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"errors"
+	"fmt"
+)
+
+func f(err error) (error, error) {
+	return fmt.Errorf("load config: %v", err), errors.New(err.Error())
+}
+`},
+			want: 2,
+		},
+		{
+			name: "wrapped with %w",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func f(id int, err error) error {
+	return fmt.Errorf("load user %v: %w", id, err)
+}
+`},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,256 @@
+package checker
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// 自動修正ヘルパー
+// ========================================
+
+// nodeText ノードのソーステキストを取得
+func (c *Checker) nodeText(filePath string, node ast.Node) string {
+	src := c.fileSource(filePath)
+	start := c.fset.Position(node.Pos()).Offset
+	end := c.fset.Position(node.End()).Offset
+	if start < 0 || end > len(src) || start > end {
+		return ""
+	}
+	return string(src[start:end])
+}
+
+// replaceEdit ノード全体をnewTextで置換する編集を作成
+func (c *Checker) replaceEdit(node ast.Node, newText string) report.TextEdit {
+	return report.TextEdit{
+		Start:   c.fset.Position(node.Pos()).Offset,
+		End:     c.fset.Position(node.End()).Offset,
+		NewText: newText,
+	}
+}
+
+// hasImport ファイルが指定パッケージをimportしているか
+func hasImport(file *ast.File, path string) bool {
+	for _, imp := range file.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err == nil && p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// addImportEdits 指定パッケージのimportが無ければ追加する編集を返す
+func (c *Checker) addImportEdits(file *ast.File, path string) []report.TextEdit {
+	if hasImport(file, path) {
+		return nil
+	}
+	// 既存のimport宣言に追加する（括弧の無い宣言は括弧でまとめる。並び順はgofmtで整える）
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		if gd.Lparen.IsValid() {
+			offset := c.fset.Position(gd.Lparen).Offset + 1
+			return []report.TextEdit{{Start: offset, End: offset, NewText: "\n\t" + strconv.Quote(path)}}
+		}
+		start := c.fset.Position(gd.Specs[0].Pos()).Offset
+		end := c.fset.Position(gd.End()).Offset
+		return []report.TextEdit{
+			{Start: start, End: start, NewText: "(\n\t" + strconv.Quote(path) + "\n\t"},
+			{Start: end, End: end, NewText: "\n)"},
+		}
+	}
+	offset := c.fset.Position(file.Name.End()).Offset
+	return []report.TextEdit{{
+		Start:   offset,
+		End:     offset,
+		NewText: "\n\nimport " + strconv.Quote(path),
+	}}
+}
+
+// removeImportEdits パッケージの参照がusesの数以下であればimportを削除する編集を返す
+func (c *Checker) removeImportEdits(file *ast.File, path, name string, uses int) []report.TextEdit {
+	count := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == name {
+				count++
+			}
+		}
+		return true
+	})
+	if count > uses {
+		return nil
+	}
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			imp, ok := spec.(*ast.ImportSpec)
+			if !ok {
+				continue
+			}
+			if p, err := strconv.Unquote(imp.Path.Value); err != nil || p != path {
+				continue
+			}
+
+			// 単独のimportは宣言ごと、グループ内のimportは行ごと削除
+			var node ast.Node = spec
+			if len(gd.Specs) == 1 {
+				node = gd
+			}
+			tf := c.fset.File(node.Pos())
+			start := tf.Offset(tf.LineStart(tf.Line(node.Pos())))
+			end := c.fset.Position(node.End()).Offset
+			if src := c.fileSource(tf.Name()); end < len(src) && src[end] == '\n' {
+				end++
+			}
+			return []report.TextEdit{{Start: start, End: end}}
+		}
+	}
+	return nil
+}
+
+// renameEdits パッケージレベルの識別子の名前を変更する編集
+// 同じパッケージ内の参照と、モジュール内の他のパッケージからの参照（pkg.Name）も変更する
+// 同じパッケージに新しい名前の宣言が既にある場合はnil
+func (c *Checker) renameEdits(filePath string, name *ast.Ident, newName string) []report.TextEdit {
+	if c.ctx == nil {
+		return nil
+	}
+	target := renameTarget{
+		dir:     filepath.Dir(filePath),
+		pkgName: c.ctx.File.Name.Name,
+		pkgPath: c.importPath(filePath),
+		name:    name.Name,
+		newName: newName,
+	}
+
+	var edits []report.TextEdit
+	err := c.walkDir(c.rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != c.rootDir && (strings.HasPrefix(d.Name(), ".") || d.Name() == "vendor" || d.Name() == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		fileEdits, err := c.renameInFile(path, target)
+		if err != nil {
+			return err
+		}
+		if filepath.Clean(path) != filepath.Clean(filePath) {
+			for i := range fileEdits {
+				fileEdits[i].File = path
+			}
+		}
+		edits = append(edits, fileEdits...)
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+	return edits
+}
+
+// renameTarget 名前を変更する識別子
+type renameTarget struct {
+	dir, pkgName, pkgPath string // 宣言しているパッケージのディレクトリ・名前・importパス
+	name, newName         string
+}
+
+// errRenameConflict 変更後の名前が既に宣言されている
+var errRenameConflict = errors.New("rename conflict")
+
+// renameInFile ファイル内の識別子の参照を変更する編集（構文エラーのファイルは変更しない）
+func (c *Checker) renameInFile(path string, t renameTarget) ([]report.TextEdit, error) {
+	src, err := c.readSource(path)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, 0)
+	if err != nil {
+		return nil, nil
+	}
+
+	var refs []*ast.Ident
+	if filepath.Dir(path) == t.dir && file.Name.Name == t.pkgName {
+		if file.Scope.Lookup(t.newName) != nil {
+			return nil, errRenameConflict
+		}
+		refs = packageRefs(file, t.name)
+	} else if t.pkgPath != "" {
+		refs = selectorRefs(file, t.pkgPath, t.pkgName, t.name)
+	}
+
+	edits := make([]report.TextEdit, 0, len(refs))
+	for _, id := range refs {
+		offset := fset.Position(id.Pos()).Offset
+		edits = append(edits, report.TextEdit{Start: offset, End: offset + len(id.Name), NewText: t.newName})
+	}
+	return edits, nil
+}
+
+// packageRefs パッケージレベルの識別子の宣言と、ファイル内の参照
+// ファイル内で解決できない識別子は同じパッケージの他のファイルの宣言への参照とみなす
+func packageRefs(file *ast.File, name string) []*ast.Ident {
+	obj := file.Scope.Lookup(name)
+	var refs []*ast.Ident
+	ast.Inspect(file, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name && obj != nil && id.Obj == obj {
+			refs = append(refs, id)
+		}
+		return true
+	})
+	for _, id := range file.Unresolved {
+		if id.Name == name {
+			refs = append(refs, id)
+		}
+	}
+	return refs
+}
+
+// selectorRefs パッケージをimportしたファイルの pkg.name の参照
+func selectorRefs(file *ast.File, pkgPath, pkgName, name string) []*ast.Ident {
+	local := ""
+	for _, imp := range file.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err != nil || p != pkgPath {
+			continue
+		}
+		local = pkgName
+		if imp.Name != nil {
+			local = imp.Name.Name
+		}
+	}
+	if local == "" || local == "_" || local == "." {
+		return nil
+	}
+	var refs []*ast.Ident
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == name {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == local && x.Obj == nil {
+				refs = append(refs, sel.Sel)
+			}
+		}
+		return true
+	})
+	return refs
+}
@@ -0,0 +1,210 @@
+package checker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// plannedFiles 修正を適用した後の内容（ルートからの相対パス→内容）
+func plannedFiles(t *testing.T, root string, rep *report.Report) map[string]string {
+	t.Helper()
+	changes, _, err := report.PlanFixes(rep.Violations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for _, fc := range changes {
+		rel, err := filepath.Rel(root, fc.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[filepath.ToSlash(rel)] = string(fc.After)
+	}
+	return got
+}
+
+func TestErrorVarRenameFix(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		fix   bool
+		want  map[string]string // 変更されるファイルの修正後の内容
+	}{
+		{
+			name: "typed var with reference in same package",
+			files: map[string]string{
+				"a.go": "package rp\n\nimport \"errors\"\n\nvar NotFoundError error = errors.New(\"not found\")\n",
+				"b.go": "package rp\n\nfunc f() error { return NotFoundError }\n",
+			},
+			fix: true,
+			want: map[string]string{
+				"a.go": "package rp\n\nimport \"errors\"\n\nvar ErrNotFound error = errors.New(\"not found\")\n",
+				"b.go": "package rp\n\nfunc f() error { return ErrNotFound }\n",
+			},
+		},
+		{
+			name: "untyped errors.New with reference from other package",
+			files: map[string]string{
+				"a.go":     "package rp\n\nimport \"errors\"\n\nvar NotFoundError = errors.New(\"not found\")\n",
+				"sub/c.go": "package sub\n\nimport \"example.com/rp\"\n\nvar err = rp.NotFoundError\n",
+			},
+			fix: true,
+			want: map[string]string{
+				"a.go":     "package rp\n\nimport \"errors\"\n\nvar ErrNotFound = errors.New(\"not found\")\n",
+				"sub/c.go": "package sub\n\nimport \"example.com/rp\"\n\nvar err = rp.ErrNotFound\n",
+			},
+		},
+		{
+			name: "untyped fmt.Errorf",
+			files: map[string]string{
+				"a.go": "package rp\n\nimport \"fmt\"\n\nvar errCode = 1\n\nvar BadCodeError = fmt.Errorf(\"bad code %d\", errCode)\n",
+			},
+			fix: true,
+			want: map[string]string{
+				"a.go": "package rp\n\nimport \"fmt\"\n\nvar errCode = 1\n\nvar ErrBadCode = fmt.Errorf(\"bad code %d\", errCode)\n",
+			},
+		},
+		{
+			name: "new name already declared",
+			files: map[string]string{
+				"a.go": "package rp\n\nimport \"errors\"\n\nvar NotFoundError = errors.New(\"not found\")\n\nvar ErrNotFound = NotFoundError\n",
+			},
+			fix:  true,
+			want: map[string]string{},
+		},
+		{
+			name: "not fixing",
+			files: map[string]string{
+				"a.go": "package rp\n\nimport \"errors\"\n\nvar NotFoundError = errors.New(\"not found\")\n",
+			},
+			fix:  false,
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, map[string]string{"go.mod": "module example.com/rp\n\ngo 1.23\n"})
+			writeTree(t, root, tt.files)
+
+			c := NewChecker(errorVarConfig())
+			c.SetFix(tt.fix)
+			rep, err := c.Check(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := countRule(rep, "error_var"); n != 1 {
+				t.Fatalf("error_var violations = %d, want 1", n)
+			}
+
+			got := plannedFiles(t, root, rep)
+			if len(got) != len(tt.want) {
+				t.Fatalf("changed files = %v, want %v", keys(got), keys(tt.want))
+			}
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("%s after fix:\n%s\nwant:\n%s", name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+// TestErrorVarRenameFixIgnoresCache 参照しているファイルだけが変わった場合も、古い編集を適用しない
+func TestErrorVarRenameFixIgnoresCache(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"go.mod": "module example.com/rp\n\ngo 1.23\n",
+		"a.go":   "package rp\n\nimport \"errors\"\n\nvar NotFoundError = errors.New(\"not found\")\n",
+		"b.go":   "package rp\n\nfunc f() error { return NotFoundError }\n",
+	})
+	cacheDir := t.TempDir()
+
+	for _, fix := range []bool{false, true} {
+		c := NewChecker(errorVarConfig())
+		c.SetCacheDir(cacheDir)
+		c.SetFix(fix)
+		if _, err := c.Check(root); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeTree(t, root, map[string]string{
+		"b.go": "package rp\n\n// some comment added that\nfunc f() error { return NotFoundError }\n",
+	})
+	c := NewChecker(errorVarConfig())
+	c.SetCacheDir(cacheDir)
+	c.SetFix(true)
+	rep, err := c.Check(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "package rp\n\n// some comment added that\nfunc f() error { return ErrNotFound }\n"
+	if got := plannedFiles(t, root, rep)["b.go"]; got != want {
+		t.Errorf("b.go after fix:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// keys マップのキー（失敗時の表示用）
+func keys(m map[string]string) []string {
+	list := make([]string, 0, len(m))
+	for k := range m {
+		list = append(list, k)
+	}
+	return list
+}
+
+func TestFmtPrintlnFixImports(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "single import replaced",
+			src:  "package rp\n\nimport \"fmt\"\n\nfunc f() {\n\tfmt.Println(\"hi\")\n}\n",
+			want: "package rp\n\nimport (\n\t\"log/slog\"\n)\n\nfunc f() {\n\tslog.Info(\"hi\")\n}\n",
+		},
+		{
+			name: "grouped import still used",
+			src:  "package rp\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\nfunc f() string {\n\tfmt.Println(os.Args[0])\n\treturn fmt.Sprint(1)\n}\n",
+			want: "package rp\n\nimport (\n\t\"fmt\"\n\t\"log/slog\"\n\t\"os\"\n)\n\nfunc f() string {\n\tslog.Info(fmt.Sprint(os.Args[0]))\n\treturn fmt.Sprint(1)\n}\n",
+		},
+		{
+			name: "printf keeps fmt",
+			src:  "package rp\n\nimport \"fmt\"\n\nfunc f(n int) {\n\tfmt.Printf(\"n=%d\", n)\n}\n",
+			want: "package rp\n\nimport (\n\t\"fmt\"\n\t\"log/slog\"\n)\n\nfunc f(n int) {\n\tslog.Info(fmt.Sprintf(\"n=%d\", n))\n}\n",
+		},
+		{
+			name: "replacement already imported",
+			src:  "package rp\n\nimport (\n\t\"fmt\"\n\t\"log/slog\"\n)\n\nfunc f() {\n\tfmt.Println(\"a\")\n\tfmt.Println(\"b\")\n\tslog.Debug(\"c\")\n}\n",
+			want: "package rp\n\nimport (\n\t\"log/slog\"\n)\n\nfunc f() {\n\tslog.Info(\"a\")\n\tslog.Info(\"b\")\n\tslog.Debug(\"c\")\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, map[string]string{"a.go": tt.src})
+
+			cfg := rules.DefaultConfig()
+			cfg.Logging = rules.LoggingConfig{Enabled: true}
+			cfg.Logging.Rules.NoFmtPrintln = rules.NoFmtPrintlnRule{
+				BaseRule:          rules.BaseRule{Enabled: true, Severity: "warning"},
+				Replacement:       "slog.Info",
+				ReplacementImport: "log/slog",
+			}
+			rep, err := NewChecker(cfg).Check(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := plannedFiles(t, root, rep)["a.go"]; got != tt.want {
+				t.Errorf("a.go after fix:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package checker
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// ファイルシステム・ログ出力の抽象化
+// ========================================
+
+// SetFS チェック対象を読み込むファイルシステムを設定（nilの場合はOSのファイルシステム）
+//
+// fs.FSを設定した場合、Checkに渡すディレクトリはfs.FS内のパス（"." など）とし、
+// 型情報付き解析は行わない（go/typesのimporterがOSのファイルシステムを前提とするため）
+func (c *Checker) SetFS(fsys fs.FS) {
+	c.fsys = fsys
+}
+
+// SetFiles チェック対象を指定したファイルに限定（変更ファイルのみのチェック用、nilの場合はすべて）
+//
+// 除外パターンは引き続き適用される。プロジェクト単位のルールは限定したファイルのみを対象に実行する
+func (c *Checker) SetFiles(files []string) {
+	if files == nil {
+		c.files = nil
+		return
+	}
+	c.files = make(map[string]bool, len(files))
+	for _, f := range files {
+		c.files[filepath.Clean(f)] = true
+	}
+}
+
+// SetOverlay ディスク上の内容の代わりに使うファイルの内容を設定（エディタの未保存のバッファ等）
+//
+// キーはターゲットと同じ基準のパスとする。ディスク上に存在しないファイルもチェック対象になる
+func (c *Checker) SetOverlay(files map[string][]byte) {
+	if files == nil {
+		c.overlay = nil
+		return
+	}
+	c.overlay = make(map[string][]byte, len(files))
+	for path, src := range files {
+		c.overlay[filepath.Clean(path)] = src
+	}
+}
+
+// SetSink 違反をレポートに保持せず、見つけた順（ファイルの収集順）にsinkへ渡す
+//
+// Checkの戻り値のレポートは件数（Summary）のみを持つ
+func (c *Checker) SetSink(sink report.Sink) {
+	c.sink = sink
+}
+
+// SetLogger 警告の出力先を設定（nilの場合は標準出力）
+func (c *Checker) SetLogger(logger *log.Logger) {
+	c.logger = logger
+}
+
+// readSource ファイルの内容を読み込む
+func (c *Checker) readSource(path string) ([]byte, error) {
+	if src, ok := c.overlay[filepath.Clean(path)]; ok {
+		return src, nil
+	}
+	if c.fsys != nil {
+		return fs.ReadFile(c.fsys, filepath.ToSlash(path))
+	}
+	return os.ReadFile(path)
+}
+
+// openFile ファイルを開く
+func (c *Checker) openFile(path string) (fs.File, error) {
+	if src, ok := c.overlay[filepath.Clean(path)]; ok {
+		return newOverlayFile(path, src), nil
+	}
+	if c.fsys != nil {
+		return c.fsys.Open(filepath.ToSlash(path))
+	}
+	return os.Open(path)
+}
+
+// statPath ファイル情報を取得
+func (c *Checker) statPath(path string) (fs.FileInfo, error) {
+	if src, ok := c.overlay[filepath.Clean(path)]; ok {
+		return overlayInfo{name: filepath.Base(path), size: int64(len(src))}, nil
+	}
+	if c.fsys != nil {
+		return fs.Stat(c.fsys, filepath.ToSlash(path))
+	}
+	return os.Stat(path)
+}
+
+// readDir ディレクトリのエントリを取得
+func (c *Checker) readDir(dir string) ([]fs.DirEntry, error) {
+	if c.fsys != nil {
+		return fs.ReadDir(c.fsys, filepath.ToSlash(dir))
+	}
+	return os.ReadDir(dir)
+}
+
+// walkDir ディレクトリを再帰的に走査
+func (c *Checker) walkDir(root string, fn fs.WalkDirFunc) error {
+	if c.fsys != nil {
+		return fs.WalkDir(c.fsys, filepath.ToSlash(root), fn)
+	}
+	return filepath.WalkDir(root, fn)
+}
+
+// warnf 警告を出力（settings.languageの言語に翻訳する）
+func (c *Checker) warnf(format string, args ...any) {
+	msg := report.Localize(c.config.Settings.Language, fmt.Sprintf(format, args...))
+	if c.logger != nil {
+		c.logger.Print("Warning: " + msg)
+		return
+	}
+	fmt.Println("Warning: " + msg)
+}
@@ -0,0 +1,319 @@
+package checker
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// gRPCチェック
+// ========================================
+
+const grpcStatusPath = "google.golang.org/grpc/status"
+
+// isGRPCServerMethod gRPCサーバーの実装メソッドか
+// レシーバーがservers（デフォルト: *Server）に一致するか同じファイルでUnimplemented*Serverを埋め込む型で、
+// Unary（ctx, *Req) (*Resp, error)・Streaming（*Req, Svc_MethodServer) error の形のメソッドを対象にする
+func (c *Checker) isGRPCServerMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || fn.Body == nil || !fn.Name.IsExported() || len(fn.Recv.List) != 1 {
+		return false
+	}
+	recv := exprTypeName(fn.Recv.List[0].Type)
+	servers := c.config.GRPC.Servers
+	if len(servers) == 0 {
+		servers = []string{"*Server"}
+	}
+	if !matchesAnyPattern(servers, recv) && !embedsUnimplementedServer(c.file, recv) {
+		return false
+	}
+	return isUnaryRPC(fn.Type) || isStreamingRPC(fn.Type)
+}
+
+// isUnaryRPC (context.Context, *pkg.Req) (*pkg.Resp, error) の形か
+func isUnaryRPC(ft *ast.FuncType) bool {
+	params, results := fieldTypes(ft.Params), fieldTypes(ft.Results)
+	if len(params) != 2 || len(results) != 2 || !isContextType(params[0]) || !isErrorIdent(results[1]) {
+		return false
+	}
+	_, reqPtr := params[1].(*ast.StarExpr)
+	_, respPtr := results[0].(*ast.StarExpr)
+	return reqPtr && respPtr
+}
+
+// isStreamingRPC 最後の引数がストリーム（Svc_MethodServer）で、errorのみを返す形か
+func isStreamingRPC(ft *ast.FuncType) bool {
+	params, results := fieldTypes(ft.Params), fieldTypes(ft.Results)
+	if len(params) == 0 || len(results) != 1 || !isErrorIdent(results[0]) {
+		return false
+	}
+	name := exprTypeName(params[len(params)-1])
+	return strings.Contains(name, "_") && strings.HasSuffix(name, "Server")
+}
+
+// fieldTypes 引数・戻り値の型を名前の数だけ並べる
+func fieldTypes(fields *ast.FieldList) []ast.Expr {
+	if fields == nil {
+		return nil
+	}
+	var types []ast.Expr
+	for _, field := range fields.List {
+		for range max(len(field.Names), 1) {
+			types = append(types, field.Type)
+		}
+	}
+	return types
+}
+
+// isErrorIdent 型式がerrorか
+func isErrorIdent(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "error"
+}
+
+// embedsUnimplementedServer ファイル内の型nameがUnimplemented*Serverを埋め込んでいるか
+func embedsUnimplementedServer(file *ast.File, name string) bool {
+	found := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != name {
+			return !found
+		}
+		if st, ok := ts.Type.(*ast.StructType); ok {
+			for _, field := range st.Fields.List {
+				embedded := exprTypeName(field.Type)
+				if len(field.Names) == 0 && strings.HasPrefix(embedded, "Unimplemented") && strings.HasSuffix(embedded, "Server") {
+					found = true
+				}
+			}
+		}
+		return false
+	})
+	return found
+}
+
+// contextParamName 最初のcontext.Context型の引数名（無名・_ の場合は空）
+func contextParamName(ft *ast.FuncType) string {
+	for _, field := range ft.Params.List {
+		if !isContextType(field.Type) {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name != "_" {
+				return name.Name
+			}
+		}
+		return ""
+	}
+	return ""
+}
+
+// checkGRPCServerContext サーバーメソッドが受け取ったctxを下流の呼び出しに伝播しているか
+// context.Background()/TODO() の使用と、ctxを一度も参照せずに他の処理を呼び出すメソッドを報告する
+func (c *Checker) checkGRPCServerContext(fn *ast.FuncDecl, filePath string) {
+	if !c.isGRPCServerMethod(fn) || !isUnaryRPC(fn.Type) {
+		return
+	}
+	ctxName := contextParamName(fn.Type)
+	usesCtx, hasCalls, reported := false, false, false
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			usesCtx = usesCtx || (ctxName != "" && n.Name == ctxName)
+		case *ast.CallExpr:
+			callStr := c.getCallExprString(n)
+			if callStr == "context.Background" || callStr == "context.TODO" {
+				c.reportGRPC(filePath, n, "server_context", c.config.GRPC.Rules.ServerContext.Severity,
+					fmt.Sprintf("gRPCメソッド '%s' で%s()を使用しています", fn.Name.Name, callStr),
+					"受け取ったctxを渡し、デッドライン・キャンセル・メタデータを伝播させてください")
+				reported = true
+			}
+			if _, isMethod := n.Fun.(*ast.SelectorExpr); isMethod {
+				hasCalls = true
+			}
+		}
+		return true
+	})
+
+	if hasCalls && !usesCtx && !reported {
+		c.reportGRPC(filePath, fn.Name, "server_context", c.config.GRPC.Rules.ServerContext.Severity,
+			fmt.Sprintf("gRPCメソッド '%s' が受け取ったctxを下流の呼び出しに渡していません", fn.Name.Name),
+			"ctxを引数名で受け取り、リポジトリ・クライアント等の呼び出しに渡してください")
+	}
+}
+
+// checkGRPCStatusError Unaryのサーバーメソッドが返すエラーがstatus.Error等でコードを持つか
+// errors.New・fmt.Errorf・下流のエラーをそのまま返すとクライアントにはcodes.Unknownとして届く
+func (c *Checker) checkGRPCStatusError(fn *ast.FuncDecl, filePath string) {
+	if !c.isGRPCServerMethod(fn) || !isUnaryRPC(fn.Type) {
+		return
+	}
+	rule := c.config.GRPC.Rules.StatusError
+	status := importName(c.file, grpcStatusPath)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 2 {
+			return true
+		}
+		errExpr := ret.Results[1]
+		if c.isStatusError(errExpr, status, rule.Helpers) {
+			return true
+		}
+		c.reportGRPC(filePath, errExpr, "status_error", rule.Severity,
+			fmt.Sprintf("gRPCメソッド '%s' がコードを持たないエラー（%s）を返しています", fn.Name.Name, c.nodeText(filePath, errExpr)),
+			"status.Error(codes.XXX, ...) / status.Errorf でコードを付けて返してください")
+		return true
+	})
+}
+
+// isStatusError エラーの式がnil・status.Error/Errorf/FromContextError・*.Err()・許可ヘルパーの呼び出しか
+func (c *Checker) isStatusError(expr ast.Expr, status string, helpers []string) bool {
+	if ident, ok := expr.(*ast.Ident); ok && ident.Name == "nil" {
+		return true
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if status != "" && isSelector(sel, status, sel.Sel.Name) && sel.Sel.Name != "Convert" {
+			return true
+		}
+		if sel.Sel.Name == "Err" && len(call.Args) == 0 {
+			return true
+		}
+	}
+	return c.matchesHelper(call, helpers)
+}
+
+// checkGRPCServerPanic サーバーメソッドでpanicを呼び出していないか
+// recoveryインターセプターが無いとサーバープロセスごと停止する
+func (c *Checker) checkGRPCServerPanic(fn *ast.FuncDecl, filePath string) {
+	if !c.isGRPCServerMethod(fn) {
+		return
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "panic" {
+			c.reportGRPC(filePath, call, "server_panic", c.config.GRPC.Rules.ServerPanic.Severity,
+				fmt.Sprintf("gRPCメソッド '%s' でpanicを呼び出しています", fn.Name.Name),
+				"status.Error(codes.Internal, ...) を返してください")
+		}
+		return true
+	})
+}
+
+// reportGRPC gRPCのルールの違反を報告
+func (c *Checker) reportGRPC(filePath string, node ast.Node, rule, severity, message, suggestion string) {
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "grpc",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// checkPBEdited 生成された *.pb.go が生成後に編集されていないか
+// 生成マーカー（Code generated ... DO NOT EDIT.）の有無と、checksum_fileに記録したハッシュとの一致を確認する
+func (c *Checker) checkPBEdited(ctx *ProjectContext) {
+	rule := c.config.GRPC.Rules.PBEdited
+	sums := c.readChecksums(ctx.Root, rule.ChecksumFile)
+
+	w := &goFileWalker{c: c}
+	err := c.walkDir(ctx.Root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != ctx.Root && w.skipDir(path, d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".pb.go") {
+			return nil
+		}
+
+		var message string
+		if !c.isGenerated(path, c.generatedMarker()) {
+			message = fmt.Sprintf("%s に生成マーカー（// Code generated ... DO NOT EDIT.）がありません", filepath.Base(path))
+		} else if want, ok := sums[c.relPath(path)]; ok && want != c.fileChecksum(path) {
+			message = fmt.Sprintf("%s は生成後に編集されています（%s のハッシュと一致しません）", filepath.Base(path), rule.ChecksumFile)
+		}
+		if message != "" {
+			c.report.AddViolation(report.Violation{
+				File:       path,
+				Line:       1,
+				Rule:       "pb_edited",
+				Category:   "grpc",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    message,
+				Suggestion: ".protoを変更してprotocで再生成してください（生成コードは直接編集しない）",
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		c.warnf("pb_edited: %v", err)
+	}
+}
+
+// readChecksums sha256sumの出力形式（<ハッシュ>  <パス>）のファイルを読み込む（キーはルートからの相対パス）
+func (c *Checker) readChecksums(root, name string) map[string]string {
+	sums := make(map[string]string)
+	if name == "" {
+		return sums
+	}
+	f, err := c.openFile(filepath.Join(root, name))
+	if err != nil {
+		c.warnf("pb_edited: %s を読み込めません: %v", name, err)
+		return sums
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		hash, path, ok := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		if !ok {
+			continue
+		}
+		path = strings.TrimPrefix(strings.TrimSpace(path), "*") // バイナリモードの印
+		sums[filepath.ToSlash(filepath.Clean(path))] = strings.ToLower(hash)
+	}
+	if err := scanner.Err(); err != nil {
+		c.warnf("pb_edited: %s を読み込めません: %v", name, err)
+	}
+	return sums
+}
+
+// fileChecksum ファイルのSHA-256（読み込めない場合は空）
+func (c *Checker) fileChecksum(path string) string {
+	src, err := c.readSource(path)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(src)
+	return hex.EncodeToString(sum[:])
+}
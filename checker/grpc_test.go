@@ -0,0 +1,98 @@
+package checker
+
+import "testing"
+
+func TestGRPCServerRules(t *testing.T) {
+	const config = `
+grpc:
+  enabled: true
+  rules:
+    server_context:
+      enabled: true
+      severity: "warning"
+    status_error:
+      enabled: true
+      severity: "warning"
+    server_panic:
+      enabled: true
+      severity: "error"
+`
+	const bad = `package server
+
+import (
+	"context"
+	"errors"
+
+	pb "example.com/p/gen/user"
+)
+
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	repo interface{ Find(ctx context.Context, id string) error }
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if req.Id == "" {
+		panic("empty id")
+	}
+	if err := s.repo.Find(context.Background(), req.Id); err != nil {
+		return nil, errors.New("not found")
+	}
+	return &pb.GetUserResponse{}, nil
+}
+`
+	const good = `package server
+
+import (
+	"context"
+
+	pb "example.com/p/gen/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	repo interface{ Find(ctx context.Context, id string) error }
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+	if err := s.repo.Find(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "user %s: %v", req.Id, err)
+	}
+	return &pb.GetUserResponse{}, nil
+}
+`
+	for _, rule := range []string{"server_context", "status_error", "server_panic"} {
+		runRuleTests(t, config, rule, []ruleTest{
+			{name: rule + " violated", files: map[string]string{"server/user.go": bad}, want: 1},
+			{name: rule + " satisfied", files: map[string]string{"server/user.go": good}, want: 0},
+		})
+	}
+}
+
+func TestPBEdited(t *testing.T) {
+	const config = `
+grpc:
+  enabled: true
+  rules:
+    pb_edited:
+      enabled: true
+      severity: "error"
+`
+	runRuleTests(t, config, "pb_edited", []ruleTest{
+		{
+			name:  "generated marker removed",
+			files: map[string]string{"gen/user/user.pb.go": "package user\n\ntype GetUserRequest struct{ Id string }\n"},
+			want:  1,
+		},
+		{
+			name:  "generated file",
+			files: map[string]string{"gen/user/user.pb.go": "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage user\n\ntype GetUserRequest struct{ Id string }\n"},
+			want:  0,
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package checker
+
+import (
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strconv"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// インタフェースの設計（メソッド数・実装が1つ・引数名）
+// ========================================
+
+// defaultMaxInterfaceMethods インタフェースのメソッド数の上限の既定値
+const defaultMaxInterfaceMethods = 5
+
+// interfaceDecl 実装の数を調べるインタフェースの宣言
+type interfaceDecl struct {
+	path    string
+	spec    *ast.TypeSpec
+	methods []string // メソッド名（宣言の順）
+}
+
+// interfaceDecls 型宣言のうち、メソッドのみで構成されるインタフェース
+// 埋め込み・型の制約（型パラメータ・union）を含むインタフェースは実装を判定できないため除く
+func interfaceDecls(path string, decl *ast.GenDecl) []interfaceDecl {
+	if decl.Tok != token.TYPE {
+		return nil
+	}
+	var result []interfaceDecl
+	for _, spec := range decl.Specs {
+		ts, ok := spec.(*ast.TypeSpec)
+		if !ok || ts.TypeParams != nil {
+			continue
+		}
+		it, ok := ts.Type.(*ast.InterfaceType)
+		if !ok || len(it.Methods.List) == 0 {
+			continue
+		}
+		if names := interfaceMethodNames(it); names != nil {
+			result = append(result, interfaceDecl{path: path, spec: ts, methods: names})
+		}
+	}
+	return result
+}
+
+// interfaceMethodNames インタフェースのメソッド名（埋め込み・型の制約を含む場合はnil）
+func interfaceMethodNames(it *ast.InterfaceType) []string {
+	var names []string
+	for _, field := range it.Methods.List {
+		if len(field.Names) == 0 {
+			return nil
+		}
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
+// checkInterfaceMaxMethods 公開されたインタフェースのメソッド数が上限（limit）を超えていないか
+func (c *Checker) checkInterfaceMaxMethods(ts *ast.TypeSpec, filePath string) {
+	it, ok := ts.Type.(*ast.InterfaceType)
+	if !ok || !ts.Name.IsExported() {
+		return
+	}
+	rule := c.config.Interfaces.Rules.MaxMethods
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	limit := rule.Limit
+	if limit <= 0 {
+		limit = defaultMaxInterfaceMethods
+	}
+	count := 0
+	for _, field := range it.Methods.List {
+		if _, ok := field.Type.(*ast.FuncType); ok {
+			count += len(field.Names)
+		}
+	}
+	if count > limit {
+		c.reportInterface(ts.Name.Pos(), "max_methods", rule.Severity,
+			"インタフェース '"+ts.Name.Name+"' のメソッドが"+strconv.Itoa(count)+"個あります（上限: "+strconv.Itoa(limit)+"）",
+			"利用者ごとに必要なメソッドのみを持つ小さなインタフェースに分割してください", filePath)
+	}
+}
+
+// checkInterfaceParamNames インタフェースのメソッドの引数に名前が付いているか
+func (c *Checker) checkInterfaceParamNames(ts *ast.TypeSpec, filePath string) {
+	it, ok := ts.Type.(*ast.InterfaceType)
+	if !ok {
+		return
+	}
+	rule := c.config.Interfaces.Rules.ParamNames
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 || ft.Params == nil {
+			continue
+		}
+		for _, param := range ft.Params.List {
+			if len(param.Names) == 0 {
+				c.reportInterface(field.Pos(), "param_names", rule.Severity,
+					"インタフェース '"+ts.Name.Name+"' のメソッド '"+field.Names[0].Name+"' の引数に名前がありません",
+					"引数の役割が分かる名前を付けてください", filePath)
+				break
+			}
+		}
+	}
+}
+
+// checkSingleImplementation 実装が1つだけで、その実装と同じパッケージに宣言したインタフェース
+// 実装はメソッド名（値・ポインタレシーバ）ですべてのメソッドを持つ型とし、テストファイルの型（モック等）も数える
+func (c *Checker) checkSingleImplementation(ctx *ProjectContext) {
+	rule := c.config.Interfaces.Rules.SingleImplementation
+	ctx.loadTypeDecls()
+	sets := methodSets(ctx.methods)
+	for _, iface := range ctx.interfaces {
+		if c.isAllowedIn(rule.AllowedIn, iface.path) {
+			continue
+		}
+		var impls []methodSet
+		for _, t := range sets {
+			if t.implements(iface.methods) {
+				impls = append(impls, t)
+			}
+		}
+		if len(impls) != 1 || impls[0].dir != filepath.Dir(iface.path) {
+			continue
+		}
+		c.reportInterface(iface.spec.Name.Pos(), "single_implementation", rule.Severity,
+			"インタフェース '"+iface.spec.Name.Name+"' の実装は同じパッケージの '"+impls[0].typeName+"' のみです",
+			"インタフェースは利用する側のパッケージで宣言するか、型 '"+impls[0].typeName+"' を直接使用してください", iface.path)
+	}
+}
+
+// methodSet 型のメソッド名の集合
+type methodSet struct {
+	dir      string
+	typeName string
+	names    map[string]bool
+}
+
+// implements すべてのメソッドを持つか
+func (s methodSet) implements(methods []string) bool {
+	for _, name := range methods {
+		if !s.names[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// methodSets メソッドをパッケージ（ディレクトリ）・レシーバの型ごとのメソッド名の集合にまとめる
+func methodSets(methods []methodDecl) []methodSet {
+	var sets []methodSet
+	for _, group := range methodsByType(methods) {
+		s := methodSet{dir: filepath.Dir(group[0].path), typeName: group[0].typeName, names: make(map[string]bool, len(group))}
+		for _, m := range group {
+			s.names[m.fn.Name.Name] = true
+		}
+		sets = append(sets, s)
+	}
+	return sets
+}
+
+// reportInterface インタフェースのルールの違反を報告する
+func (c *Checker) reportInterface(at token.Pos, rule, severity, message, suggestion, filePath string) {
+	pos := c.fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "interfaces",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
@@ -0,0 +1,132 @@
+package checker
+
+import "testing"
+
+func TestInterfaceMaxMethods(t *testing.T) {
+	const config = `
+interfaces:
+  enabled: true
+  rules:
+    max_methods:
+      enabled: true
+      severity: "warning"
+      limit: 2
+`
+	runRuleTests(t, config, "max_methods", []ruleTest{
+		{
+			name: "too many methods",
+			files: map[string]string{"a.go": `package p
+
+type Store interface {
+	Get(id string) string
+	Put(id, v string)
+	Delete(id string)
+}
+`},
+			want: 1,
+		},
+		{
+			name: "embedded methods not counted",
+			files: map[string]string{"a.go": `package p
+
+type Getter interface {
+	Get(id string) string
+}
+
+type Store interface {
+	Getter
+	Put(id, v string)
+	Delete(id string)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSingleImplementation(t *testing.T) {
+	const config = `
+interfaces:
+  enabled: true
+  rules:
+    single_implementation:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "single_implementation", []ruleTest{
+		{
+			name: "interface next to its only implementation",
+			files: map[string]string{"a.go": `package p
+
+type Store interface {
+	Get(id string) string
+}
+
+type memStore struct{}
+
+func (m *memStore) Get(id string) string { return "" }
+`},
+			want: 1,
+		},
+		{
+			name: "implementation and mock",
+			files: map[string]string{
+				"a.go": `package p
+
+type Store interface {
+	Get(id string) string
+}
+
+type memStore struct{}
+
+func (m *memStore) Get(id string) string { return "" }
+`,
+				"a_test.go": `package p
+
+type fakeStore struct{}
+
+func (f *fakeStore) Get(id string) string { return id }
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestInterfaceParamNames(t *testing.T) {
+	const config = `
+interfaces:
+  enabled: true
+  rules:
+    param_names:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "param_names", []ruleTest{
+		{
+			name: "unnamed parameters",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+type Store interface {
+	Get(context.Context, string) (string, error)
+}
+`},
+			want: 1,
+		},
+		{
+			name: "named parameters",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+type Store interface {
+	Get(ctx context.Context, id string) (string, error)
+	Close() error
+}
+`},
+			want: 0,
+		},
+	})
+}
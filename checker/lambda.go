@@ -0,0 +1,557 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// AWS Lambdaチェック
+// ========================================
+
+const (
+	lambdaEventsPath = "github.com/aws/aws-lambda-go/events"
+	awsConfigPath    = "github.com/aws/aws-sdk-go-v2/config"
+	awsSessionPath   = "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// awsServicePrefixes AWS SDKのサービスクライアントパッケージ
+var awsServicePrefixes = []string{
+	"github.com/aws/aws-sdk-go-v2/service/",
+	"github.com/aws/aws-sdk-go/service/",
+}
+
+// lambdaStartFuncs ハンドラを登録するaws-lambda-goの関数
+var lambdaStartFuncs = []string{"Start", "StartWithOptions", "StartWithContext", "StartHandler", "StartHandlerFunc"}
+
+// collectLambdaHandlers Lambdaハンドラ（lambda.Start等またはaws_lambda.handler_wrappersの関数に渡された関数）と
+// そこから呼び出される関数をディレクトリ（パッケージ）単位で収集
+// eventsの型を引数に取るだけの関数（レコード単位の処理等）はハンドラとみなさない
+func (c *Checker) collectLambdaHandlers(goFiles []string) {
+	c.lambdaHandlers = make(map[string]map[string]bool)
+	c.lambdaCallTree = make(map[string]map[string]bool)
+	funcs := make(map[string]map[string][]*ast.FuncDecl)
+	for _, filePath := range goFiles {
+		file, err := c.parseFile(filePath)
+		if err != nil {
+			continue
+		}
+		dir := filepath.Dir(filePath)
+		if funcs[dir] == nil {
+			funcs[dir] = make(map[string][]*ast.FuncDecl)
+			c.lambdaHandlers[dir] = make(map[string]bool)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			funcs[dir][fn.Name.Name] = append(funcs[dir][fn.Name.Name], fn)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 || !c.registersLambdaHandler(call) {
+				return true
+			}
+			for _, name := range handlerNames(call.Args[0]) {
+				c.lambdaHandlers[dir][name] = true
+			}
+			return true
+		})
+	}
+
+	// ハンドラから同一パッケージ内で到達できる関数を辿る
+	for dir, handlers := range c.lambdaHandlers {
+		tree := make(map[string]bool)
+		var queue []string
+		for name := range handlers {
+			queue = append(queue, name)
+		}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			if tree[name] || len(funcs[dir][name]) == 0 {
+				continue
+			}
+			tree[name] = true
+			for _, fn := range funcs[dir][name] {
+				queue = append(queue, calledNames(fn.Body)...)
+			}
+		}
+		c.lambdaCallTree[dir] = tree
+	}
+}
+
+// registersLambdaHandler 呼び出しがハンドラを登録するか（lambda.Start等、またはaws_lambda.handler_wrappersの関数）
+func (c *Checker) registersLambdaHandler(call *ast.CallExpr) bool {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isSelector(sel, "lambda", sel.Sel.Name) && containsString(lambdaStartFuncs, sel.Sel.Name) {
+		return true
+	}
+	wrappers := c.config.AWSLambda.HandlerWrappers
+	return len(wrappers) > 0 && containsString(wrappers, c.getCallExprString(call))
+}
+
+// calledNames 本体から呼び出している関数名・メソッド名
+func calledNames(body *ast.BlockStmt) []string {
+	var names []string
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			names = append(names, fun.Name)
+		case *ast.SelectorExpr:
+			names = append(names, fun.Sel.Name)
+		}
+		return true
+	})
+	return names
+}
+
+// handlerNames ハンドラ引数から関数名・メソッド名を取り出す（ラッパー関数の引数も辿る）
+func handlerNames(expr ast.Expr) []string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return []string{e.Name}
+	case *ast.SelectorExpr:
+		return []string{e.Sel.Name}
+	case *ast.CallExpr:
+		var names []string
+		for _, arg := range e.Args {
+			names = append(names, handlerNames(arg)...)
+		}
+		return names
+	}
+	return nil
+}
+
+// isLambdaHandler 関数がLambdaハンドラか
+func (c *Checker) isLambdaHandler(fn *ast.FuncDecl, filePath string) bool {
+	return fn.Body != nil && c.lambdaHandlers[filepath.Dir(filePath)][fn.Name.Name]
+}
+
+// inLambdaCallTree 関数がLambdaハンドラ、またはハンドラから呼び出される関数か
+func (c *Checker) inLambdaCallTree(fn *ast.FuncDecl, filePath string) bool {
+	return fn.Body != nil && c.lambdaCallTree[filepath.Dir(filePath)][fn.Name.Name]
+}
+
+// importName ファイルでの指定パッケージの名前（importしていなければ空）
+func importName(file *ast.File, path string) string {
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || p != path {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return defaultPackageName(p)
+	}
+	return ""
+}
+
+// defaultPackageName importパスから既定のパッケージ名を推定（末尾の /v2 等は除く）
+func defaultPackageName(path string) string {
+	parts := strings.Split(path, "/")
+	name := parts[len(parts)-1]
+	if len(parts) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = parts[len(parts)-2]
+	}
+	return name
+}
+
+// awsServiceNames ファイルでimportしているAWS SDKサービスパッケージの名前
+func awsServiceNames(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		for _, prefix := range awsServicePrefixes {
+			if !strings.HasPrefix(p, prefix) {
+				continue
+			}
+			name := defaultPackageName(p)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// awsClientConstructor 呼び出しがAWSの設定読み込み・クライアント生成であればその名前を返す
+func (c *Checker) awsClientConstructor(call *ast.CallExpr, services map[string]bool) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	callStr := pkg.Name + "." + sel.Sel.Name
+	switch {
+	case pkg.Name == importName(c.file, awsConfigPath) && strings.HasPrefix(sel.Sel.Name, "LoadDefaultConfig"):
+		return callStr
+	case pkg.Name == importName(c.file, awsSessionPath) && strings.HasPrefix(sel.Sel.Name, "New"):
+		return callStr
+	case services[pkg.Name] && (sel.Sel.Name == "New" || sel.Sel.Name == "NewFromConfig"):
+		return callStr
+	}
+	return ""
+}
+
+// checkInitAWSClients ハンドラ内でのAWS設定読み込み・クライアント生成を検出
+func (c *Checker) checkInitAWSClients(fn *ast.FuncDecl, filePath string) {
+	if !c.isLambdaHandler(fn, filePath) {
+		return
+	}
+	rule := c.config.AWSLambda.Rules.InitAWSClients
+	services := awsServiceNames(c.file)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		name := c.awsClientConstructor(call, services)
+		if name == "" {
+			return true
+		}
+		pos := c.fset.Position(call.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "init_aws_clients",
+			Category:   "aws_lambda",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("Lambdaハンドラ '%s' 内で %s を呼び出しています（呼び出しごとに初期化されます）", fn.Name.Name, name),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "AWSクライアントはinit()・main()またはパッケージ変数で一度だけ初期化してください",
+		})
+		return true
+	})
+}
+
+// checkLambdaContextPropagation ハンドラの呼び出しツリー内でのcontext.Background/TODO・contextを受け取らないHTTP呼び出しを検出
+func (c *Checker) checkLambdaContextPropagation(fn *ast.FuncDecl, filePath string) {
+	if !c.inLambdaCallTree(fn, filePath) {
+		return
+	}
+	rule := c.config.AWSLambda.Rules.ContextPropagation
+	if c.isLambdaHandler(fn, filePath) {
+		c.checkHandlerContextParam(fn, filePath)
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var message, suggestion string
+		switch callStr := c.getCallExprString(call); callStr {
+		case "context.Background", "context.TODO":
+			message = fmt.Sprintf("Lambdaハンドラの呼び出しツリー内（%s）で%s()を使用しています", fn.Name.Name, callStr)
+			suggestion = "ハンドラが受け取ったctxを引き回し、タイムアウトとX-Rayトレースを伝播させてください"
+		case "http.Get", "http.Head", "http.Post", "http.PostForm", "http.NewRequest":
+			message = fmt.Sprintf("Lambdaハンドラの呼び出しツリー内（%s）でcontextを受け取らない%sを使用しています", fn.Name.Name, callStr)
+			suggestion = "http.NewRequestWithContext(ctx, ...) を使用してください"
+		default:
+			return true
+		}
+		pos := c.fset.Position(call.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "context_propagation",
+			Category:   "aws_lambda",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    message,
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: suggestion,
+		})
+		return true
+	})
+}
+
+// checkHandlerContextParam ハンドラが受け取るcontext.Contextを破棄していないか
+// ctxを受け取らない・_で受け取る・受け取っても使わない場合、呼び出し先にLambdaの残り時間とトレースが伝わらない
+func (c *Checker) checkHandlerContextParam(fn *ast.FuncDecl, filePath string) {
+	var message string
+	name := contextParamName(fn.Type)
+	switch {
+	case contextParam(fn.Type) == nil:
+		message = fmt.Sprintf("Lambdaハンドラ '%s' がcontext.Contextを受け取っていません", fn.Name.Name)
+	case name == "":
+		message = fmt.Sprintf("Lambdaハンドラ '%s' が受け取ったcontext.Contextを破棄しています", fn.Name.Name)
+	case !usesIdent(fn.Body, name):
+		message = fmt.Sprintf("Lambdaハンドラ '%s' が受け取ったcontext.Context '%s' を使用していません", fn.Name.Name, name)
+	default:
+		return
+	}
+
+	rule := c.config.AWSLambda.Rules.ContextPropagation
+	pos := c.fset.Position(fn.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "context_propagation",
+		Category:   "aws_lambda",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "ハンドラの第1引数で ctx context.Context を受け取り、AWS SDK・HTTP等の呼び出しに渡してください",
+	})
+}
+
+// usesIdent ブロック内で指定名の識別子を参照しているか
+func usesIdent(body *ast.BlockStmt, name string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkSQSBatchFailures events.SQSEventを受け取るハンドラが部分バッチ失敗（BatchItemFailures）を返しているか
+func (c *Checker) checkSQSBatchFailures(fn *ast.FuncDecl, filePath string) {
+	events := importName(c.file, lambdaEventsPath)
+	if events == "" || fn.Body == nil || !hasParamType(fn, events, "SQSEvent") {
+		return
+	}
+
+	var message, suggestion string
+	switch {
+	case !hasResultType(fn, events, "SQSEventResponse"):
+		message = fmt.Sprintf("SQSハンドラ '%s' が events.SQSEventResponse を返していません", fn.Name.Name)
+		suggestion = "戻り値を (events.SQSEventResponse, error) とし、失敗したレコードをBatchItemFailuresに追加してください（ReportBatchItemFailuresの有効化も必要です）"
+	case !appendsBatchItemFailures(fn.Body):
+		message = fmt.Sprintf("SQSハンドラ '%s' がBatchItemFailuresに失敗したレコードを追加していません", fn.Name.Name)
+		suggestion = "レコードごとのエラー時に events.SQSBatchItemFailure{ItemIdentifier: record.MessageId} を追加してください"
+	default:
+		return
+	}
+
+	rule := c.config.AWSLambda.Rules.SQSBatchFailures
+	pos := c.fset.Position(fn.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "sqs_batch_failures",
+		Category:   "aws_lambda",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// hasParamType 関数が指定の型（ポインタを含む）の引数を持つか
+func hasParamType(fn *ast.FuncDecl, pkg, name string) bool {
+	return fieldsHaveType(fn.Type.Params, pkg, name)
+}
+
+// hasResultType 関数が指定の型（ポインタを含む）の戻り値を持つか
+func hasResultType(fn *ast.FuncDecl, pkg, name string) bool {
+	return fieldsHaveType(fn.Type.Results, pkg, name)
+}
+
+// fieldsHaveType フィールドリストに指定の型（ポインタを含む）があるか
+func fieldsHaveType(fields *ast.FieldList, pkg, name string) bool {
+	if fields == nil {
+		return false
+	}
+	for _, field := range fields.List {
+		typ := field.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		if isSelector(typ, pkg, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendsBatchItemFailures 本体でBatchItemFailuresに要素を追加・設定しているか
+func appendsBatchItemFailures(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range node.Lhs {
+				if sel, ok := lhs.(*ast.SelectorExpr); ok && sel.Sel.Name == "BatchItemFailures" {
+					found = true
+				}
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := node.Key.(*ast.Ident); ok && key.Name == "BatchItemFailures" {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// envGetters 環境変数を読み出す関数
+var envGetters = []string{"os.Getenv", "os.LookupEnv"}
+
+// checkLambdaEnvAccess ハンドラの呼び出しツリー内での環境変数の読み出しを検出
+func (c *Checker) checkLambdaEnvAccess(fn *ast.FuncDecl, filePath string) {
+	if !c.inLambdaCallTree(fn, filePath) {
+		return
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		callStr := c.getCallExprString(call)
+		if !containsString(envGetters, callStr) {
+			return true
+		}
+		where := fmt.Sprintf("Lambdaハンドラの呼び出しツリー内（%s）", fn.Name.Name)
+		if inLoop(fn.Body, call.Pos()) {
+			where = fmt.Sprintf("Lambdaハンドラの呼び出しツリー内（%s）のループ", fn.Name.Name)
+		}
+		c.reportLambdaEnv(call, filePath,
+			fmt.Sprintf("%sで%sを呼び出しています（呼び出しごとに読み出されます）", where, callStr),
+			"環境変数はinit()・main()で一度だけ読み出して設定構造体に格納してください")
+		return true
+	})
+}
+
+// inLoop 指定位置がfor/rangeループの本体内にあるか
+func inLoop(root *ast.BlockStmt, pos token.Pos) bool {
+	found := false
+	ast.Inspect(root, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		switch l := n.(type) {
+		case *ast.ForStmt:
+			body = l.Body
+		case *ast.RangeStmt:
+			body = l.Body
+		}
+		if body != nil && body.Pos() <= pos && pos < body.End() {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkLambdaEnvValidation Lambdaパッケージの起動時（init/main・パッケージ変数）に読み出した環境変数が検証されているか
+func (c *Checker) checkLambdaEnvValidation(file *ast.File, filePath string) {
+	if !c.config.AWSLambda.Rules.EnvAccess.RequireValidation || len(c.lambdaHandlers[filepath.Dir(filePath)]) == 0 {
+		return
+	}
+
+	type envRead struct {
+		target string
+		call   *ast.CallExpr
+	}
+	var reads []envRead
+	collect := func(lhs []ast.Expr, rhs []ast.Expr) {
+		if len(lhs) != len(rhs) {
+			return
+		}
+		for i, r := range rhs {
+			call, ok := r.(*ast.CallExpr)
+			if ok && c.getCallExprString(call) == "os.Getenv" {
+				reads = append(reads, envRead{target: c.nodeText(filePath, lhs[i]), call: call})
+			}
+		}
+	}
+
+	var startup []*ast.BlockStmt
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil || d.Body == nil || (d.Name.Name != "init" && d.Name.Name != "main") {
+				continue
+			}
+			startup = append(startup, d.Body)
+			ast.Inspect(d.Body, func(n ast.Node) bool {
+				if as, ok := n.(*ast.AssignStmt); ok {
+					collect(as.Lhs, as.Rhs)
+				}
+				return true
+			})
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if vs, ok := spec.(*ast.ValueSpec); ok {
+					lhs := make([]ast.Expr, len(vs.Names))
+					for i, name := range vs.Names {
+						lhs[i] = name
+					}
+					collect(lhs, vs.Values)
+				}
+			}
+		}
+	}
+
+	for _, read := range reads {
+		if c.isEnvValidated(filePath, read.target, startup) {
+			continue
+		}
+		c.reportLambdaEnv(read.call, filePath,
+			fmt.Sprintf("起動時に読み出した環境変数（%s）が未設定かどうか検証されていません", read.target),
+			fmt.Sprintf("init()・main()で if %s == \"\" { ... } のように検証し、未設定であれば起動時に失敗させてください", read.target))
+	}
+}
+
+// isEnvValidated 起動処理内で対象が空文字列と比較されているか
+func (c *Checker) isEnvValidated(filePath, target string, startup []*ast.BlockStmt) bool {
+	found := false
+	for _, body := range startup {
+		ast.Inspect(body, func(n ast.Node) bool {
+			bin, ok := n.(*ast.BinaryExpr)
+			if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+				return !found
+			}
+			for _, pair := range [][2]ast.Expr{{bin.X, bin.Y}, {bin.Y, bin.X}} {
+				lit, ok := pair[1].(*ast.BasicLit)
+				if ok && lit.Value == `""` && c.nodeText(filePath, pair[0]) == target {
+					found = true
+				}
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// reportLambdaEnv 環境変数アクセスの違反を報告
+func (c *Checker) reportLambdaEnv(call *ast.CallExpr, filePath, message, suggestion string) {
+	rule := c.config.AWSLambda.Rules.EnvAccess
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "env_access",
+		Category:   "aws_lambda",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
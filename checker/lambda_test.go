@@ -0,0 +1,356 @@
+package checker
+
+import "testing"
+
+func TestInitAWSClients(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  rules:
+    init_aws_clients:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "init_aws_clients", []ruleTest{
+		{
+			name: "config and client created per invocation",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func handler(ctx context.Context) error {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return err
+	}
+	_ = s3.NewFromConfig(cfg)
+	return nil
+}
+
+func main() { lambda.Start(handler) }
+`},
+			want: 2,
+		},
+		{
+			name: "client created once in init",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+var client *s3.Client
+
+func init() {
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	client = s3.NewFromConfig(cfg)
+}
+
+func handler(ctx context.Context) error { return nil }
+
+func main() { lambda.Start(handler) }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLambdaContextPropagation(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  rules:
+    context_propagation:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_propagation", []ruleTest{
+		{
+			name: "new context and http.Get in call tree",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func notify(ctx context.Context) {}
+
+func process() {
+	notify(context.Background())
+	http.Get("https://example.com/hook")
+}
+
+func handler(ctx context.Context) error {
+	process()
+	return ctx.Err()
+}
+
+func main() { lambda.Start(handler) }
+`},
+			want: 2,
+		},
+		{
+			name: "ctx propagated",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func process(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://example.com/hook", nil)
+	if err != nil {
+		return err
+	}
+	_, err = http.DefaultClient.Do(req)
+	return err
+}
+
+func handler(ctx context.Context) error { return process(ctx) }
+
+func main() { lambda.Start(handler) }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSQSBatchFailures(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  rules:
+    sqs_batch_failures:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "sqs_batch_failures", []ruleTest{
+		{
+			name: "no batch response and failures not reported",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func handle(ctx context.Context, e events.SQSEvent) error { return nil }
+
+func handleBatch(ctx context.Context, e events.SQSEvent) (events.SQSEventResponse, error) {
+	return events.SQSEventResponse{}, nil
+}
+`},
+			want: 2,
+		},
+		{
+			name: "failed records reported",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func process(m events.SQSMessage) error { return nil }
+
+func handle(ctx context.Context, e events.SQSEvent) (events.SQSEventResponse, error) {
+	var resp events.SQSEventResponse
+	for _, record := range e.Records {
+		if err := process(record); err != nil {
+			resp.BatchItemFailures = append(resp.BatchItemFailures, events.SQSBatchItemFailure{ItemIdentifier: record.MessageId})
+		}
+	}
+	return resp, nil
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLambdaEnvAccess(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  rules:
+    env_access:
+      enabled: true
+      severity: "warning"
+      require_validation: true
+`
+	runRuleTests(t, config, "env_access", []ruleTest{
+		{
+			name: "read per invocation and unvalidated startup read",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+var table = os.Getenv("TABLE_NAME")
+
+func handler(ctx context.Context) error {
+	_ = os.Getenv("BUCKET")
+	return nil
+}
+
+func main() { lambda.Start(handler) }
+`},
+			want: 2,
+		},
+		{
+			name: "validated at startup",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+var table string
+
+func handler(ctx context.Context) error { return nil }
+
+func main() {
+	table = os.Getenv("TABLE_NAME")
+	if table == "" {
+		panic("TABLE_NAME is required")
+	}
+	lambda.Start(handler)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLambdaHandlerContextParam(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  rules:
+    context_propagation:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_propagation", []ruleTest{
+		{
+			name: "context missing, discarded or unused",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func noContext() error { return nil }
+
+func discarded(_ context.Context) error { return nil }
+
+func unused(ctx context.Context) error { return nil }
+
+func main() {
+	lambda.Start(noContext)
+	lambda.Start(discarded)
+	lambda.Start(unused)
+}
+`},
+			want: 3,
+		},
+		{
+			name: "context used",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func handler(ctx context.Context) error { return ctx.Err() }
+
+func main() { lambda.Start(handler) }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestLambdaHandlerDetection(t *testing.T) {
+	const config = `
+aws_lambda:
+  enabled: true
+  handler_wrappers: ["platform.Run"]
+  rules:
+    context_propagation:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "context_propagation", []ruleTest{
+		{
+			name: "function passed to registered wrapper",
+			files: map[string]string{"main.go": `package main
+
+import "example.com/platform"
+
+func handler() error { return nil }
+
+func main() { platform.Run(handler) }
+`},
+			want: 1,
+		},
+		{
+			name: "record helper taking events type is not a handler",
+			files: map[string]string{"main.go": `package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func process(msg events.SQSMessage) error { return nil }
+
+func handler(ctx context.Context, e events.SQSEvent) error {
+	for _, record := range e.Records {
+		if err := process(record); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+func main() { lambda.Start(handler) }
+`},
+			want: 0,
+		},
+	})
+}
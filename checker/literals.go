@@ -0,0 +1,116 @@
+package checker
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ========================================
+// ハードコードされたリテラル（マジックナンバー・URL）
+// ========================================
+
+// hardcodedURL 文字列リテラル中のhttp(s)のURL（ホストの無い "https://" のみの記述は対象外）
+var hardcodedURL = regexp.MustCompile(`https?://[A-Za-z0-9\-._~%\[\]]+[A-Za-z0-9\-._~:/?#\[\]@!$&()*+,;=%]*`)
+
+// walkLiterals 名前を付けていないリテラルをfnに渡す（negativeは単項の-が付いた数値）
+// 定数宣言・import・配列の長さ・構造体タグのリテラルは対象外
+func walkLiterals(file *ast.File, fn func(lit *ast.BasicLit, negative bool)) {
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			return n.Tok != token.CONST && n.Tok != token.IMPORT
+		case *ast.ArrayType:
+			return false
+		case *ast.Field:
+			if n.Tag != nil && n.Type != nil {
+				ast.Inspect(n.Type, visit)
+				return false
+			}
+		case *ast.UnaryExpr:
+			if lit, ok := n.X.(*ast.BasicLit); ok && n.Op == token.SUB {
+				fn(lit, true)
+				return false
+			}
+		case *ast.BasicLit:
+			fn(n, false)
+		}
+		return true
+	}
+	ast.Inspect(file, visit)
+}
+
+// checkMagicNumbers 定数宣言以外で直接使われている数値リテラル（allowed_numbersを除く）を検出
+func (c *Checker) checkMagicNumbers(file *ast.File, filePath string) {
+	rule := c.config.Structure.Rules.NoMagicNumbers
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	allowed := make([]constant.Value, 0, len(rule.AllowedNumbers))
+	for _, s := range rule.AllowedNumbers {
+		if v := numberValue(strings.TrimPrefix(s, "-"), strings.HasPrefix(s, "-")); v.Kind() != constant.Unknown {
+			allowed = append(allowed, v)
+		}
+	}
+	walkLiterals(file, func(lit *ast.BasicLit, negative bool) {
+		if lit.Kind != token.INT && lit.Kind != token.FLOAT {
+			return
+		}
+		v := numberValue(lit.Value, negative)
+		for _, a := range allowed {
+			if constant.Compare(v, token.EQL, a) {
+				return
+			}
+		}
+		text := lit.Value
+		if negative {
+			text = "-" + text
+		}
+		c.reportStructure(lit.Pos(), "no_magic_numbers", rule.Severity,
+			"マジックナンバー "+text+" を直接使用しています",
+			"意味の分かる名前の定数（const）にしてください", filePath)
+	})
+}
+
+// numberValue 数値リテラルの値（解釈できない場合はUnknown）
+func numberValue(literal string, negative bool) constant.Value {
+	v := constant.MakeFromLiteral(literal, token.INT, 0)
+	if v.Kind() == constant.Unknown {
+		v = constant.MakeFromLiteral(literal, token.FLOAT, 0)
+	}
+	if negative && v.Kind() != constant.Unknown {
+		v = constant.UnaryOp(token.SUB, v, 0)
+	}
+	return v
+}
+
+// checkHardcodedURLs 定数宣言以外の文字列リテラルに含まれるhttp(s)のURL（allowed_hostsのホストを除く）を検出
+func (c *Checker) checkHardcodedURLs(file *ast.File, filePath string) {
+	rule := c.config.Structure.Rules.NoHardcodedURLs
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	walkLiterals(file, func(lit *ast.BasicLit, _ bool) {
+		if lit.Kind != token.STRING {
+			return
+		}
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return
+		}
+		for _, raw := range hardcodedURL.FindAllString(s, -1) {
+			if u, err := url.Parse(raw); err == nil && matchesAnyPattern(rule.AllowedHosts, u.Hostname()) {
+				continue
+			}
+			c.reportStructure(lit.Pos(), "no_hardcoded_urls", rule.Severity,
+				"URL '"+raw+"' がハードコードされています",
+				"名前を付けた定数にするか、設定（環境変数・設定ファイル）から取得してください", filePath)
+			return
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package checker
+
+import "testing"
+
+func TestNoMagicNumbers(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    no_magic_numbers:
+      enabled: true
+      severity: "info"
+      allowed_numbers: ["0", "1"]
+`
+	runRuleTests(t, config, "no_magic_numbers", []ruleTest{
+		{
+			name: "numbers in expressions",
+			files: map[string]string{"a.go": `package p
+
+func f(n int) bool {
+	return n*60 > 3600
+}
+`},
+			want: 2,
+		},
+		{
+			name: "constants, array length and allowed numbers",
+			files: map[string]string{"a.go": `package p
+
+const secondsPerHour = 3600
+
+var buf [16]byte
+
+func f(n int) bool {
+	return n+1 > secondsPerHour && n != 0
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestNoHardcodedURLs(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    no_hardcoded_urls:
+      enabled: true
+      severity: "warning"
+      allowed_hosts: ["localhost", "*.example.com"]
+`
+	runRuleTests(t, config, "no_hardcoded_urls", []ruleTest{
+		{
+			name: "URL literal in function",
+			files: map[string]string{"a.go": `package p
+
+import "net/http"
+
+func f() {
+	http.Get("https://api.partner.net/v1/users")
+}
+`},
+			want: 1,
+		},
+		{
+			name: "constant and allowed hosts",
+			files: map[string]string{"a.go": `package p
+
+import "net/http"
+
+const usersURL = "https://api.partner.net/v1/users"
+
+func f() {
+	http.Get(usersURL)
+	http.Get("http://localhost:8080/health")
+	http.Get("https://api.example.com/v1")
+}
+`},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,638 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// zap/slogのフィールドコンストラクタ（第1引数がキー）
+var logFieldConstructors = map[string]map[string]bool{
+	"zap": {
+		"String": true, "Strings": true, "Int": true, "Int64": true, "Int32": true,
+		"Uint": true, "Uint64": true, "Float64": true, "Bool": true, "Any": true,
+		"Duration": true, "Time": true, "Stringer": true, "ByteString": true,
+		"Binary": true, "Reflect": true, "Namespace": true, "NamedError": true,
+	},
+	"slog": {
+		"String": true, "Int": true, "Int64": true, "Uint64": true, "Float64": true,
+		"Bool": true, "Any": true, "Duration": true, "Time": true, "Group": true,
+	},
+}
+
+// zerologイベントのフィールドメソッド（第1引数がキー）
+var zerologFieldMethods = map[string]bool{
+	"Str": true, "Strs": true, "Stringer": true, "Int": true, "Ints": true,
+	"Int64": true, "Int32": true, "Uint": true, "Uint64": true, "Float64": true,
+	"Float32": true, "Bool": true, "Bools": true, "Dur": true, "Time": true,
+	"TimeDiff": true, "Interface": true, "Any": true, "Bytes": true, "Hex": true,
+	"IPAddr": true, "RawJSON": true, "AnErr": true, "Dict": true, "Array": true,
+	"Object": true,
+}
+
+// slogのロギング関数（メッセージ以降がキー・値の交互引数）
+var slogLogFuncs = map[string]int{
+	"Debug": 1, "Info": 1, "Warn": 1, "Error": 1,
+	"DebugContext": 2, "InfoContext": 2, "WarnContext": 2, "ErrorContext": 2,
+}
+
+// ========================================
+// ログフィールドキーチェック
+// ========================================
+
+// logFieldKeys 単一の呼び出しで指定されているフィールドキーを抽出
+func (c *Checker) logFieldKeys(call *ast.CallExpr) []*ast.BasicLit {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	// パッケージ関数: zap.String("key", v), slog.Info("msg", "key", v)
+	if x, ok := sel.X.(*ast.Ident); ok {
+		if logFieldConstructors[x.Name][sel.Sel.Name] {
+			if key := stringLit(call.Args, 0); key != nil {
+				return []*ast.BasicLit{key}
+			}
+			return nil
+		}
+		if start, ok := slogLogFuncs[sel.Sel.Name]; ok && x.Name == "slog" {
+			return c.slogPairKeys(call.Args, start)
+		}
+		return nil
+	}
+
+	// zerologのメソッドチェーン: log.Info().Str("key", v)
+	if _, ok := sel.X.(*ast.CallExpr); ok && zerologFieldMethods[sel.Sel.Name] {
+		if key := stringLit(call.Args, 0); key != nil {
+			return []*ast.BasicLit{key}
+		}
+	}
+	return nil
+}
+
+// slogPairKeys slogのキー・値の交互引数からキーを抽出
+func (c *Checker) slogPairKeys(args []ast.Expr, start int) []*ast.BasicLit {
+	var keys []*ast.BasicLit
+	for i := start; i < len(args); {
+		// slog.Attrはそれ自体で1引数
+		if call, ok := args[i].(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == "slog" {
+					i++
+					continue
+				}
+			}
+		}
+		if key := stringLit(args, i); key != nil {
+			keys = append(keys, key)
+			i += 2
+			continue
+		}
+		i++
+	}
+	return keys
+}
+
+// checkLogFieldKeyStyle フィールドキーの命名スタイルをチェック
+func (c *Checker) checkLogFieldKeyStyle(call *ast.CallExpr, filePath string) {
+	rule := c.config.Logging.Rules.FieldKeys
+
+	for _, lit := range c.logFieldKeys(call) {
+		key, err := strconv.Unquote(lit.Value)
+		if err != nil || key == "" || matchesCaseStyle(key, rule.Style) {
+			continue
+		}
+
+		pos := c.fset.Position(lit.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "log_field_keys",
+			Category:   "logging",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("ログフィールドキー '%s' は%sで命名してください", key, rule.Style),
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: strconv.Quote(toCaseStyle(key, rule.Style)),
+		})
+	}
+}
+
+// checkLogFieldKeyDuplicates 1つのログ呼び出し内でのキー重複をチェック
+func (c *Checker) checkLogFieldKeyDuplicates(call *ast.CallExpr, filePath string) {
+	rule := c.config.Logging.Rules.FieldKeys
+	seen := make(map[string]bool)
+
+	// メソッドチェーンと引数をたどってキーを収集（関数リテラル内は別の呼び出し）
+	ast.Inspect(call, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			for _, lit := range c.logFieldKeys(node) {
+				key, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					continue
+				}
+				if !seen[key] {
+					seen[key] = true
+					continue
+				}
+
+				pos := c.fset.Position(lit.Pos())
+				c.report.AddViolation(report.Violation{
+					File:     filePath,
+					Line:     pos.Line,
+					Column:   pos.Column,
+					Rule:     "log_field_keys",
+					Category: "logging",
+					Severity: rules.ParseSeverity(rule.Severity),
+					Message:  fmt.Sprintf("ログフィールドキー '%s' が同じログ呼び出し内で重複しています", key),
+					Code:     c.getCodeLine(filePath, pos.Line),
+				})
+			}
+		}
+		return true
+	})
+}
+
+// ========================================
+// 機密情報のログ出力チェック
+// ========================================
+
+// isLogCall ログ出力の呼び出しか（レシーバチェーンの起点名で判定）
+func isLogCall(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	root := rootIdent(sel.X)
+	if root == nil {
+		return false
+	}
+	name := strings.ToLower(root.Name)
+	return strings.Contains(name, "log") || name == "zap"
+}
+
+// rootIdent セレクタ・メソッドチェーンの起点となる識別子を返す
+func rootIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.CallExpr:
+			expr = e.Fun
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+// isSensitiveName 識別子名が機密情報パターンに一致するか
+func isSensitiveName(name string, patterns []string) bool {
+	normalized := normalizeName(name)
+	for _, p := range patterns {
+		if p = normalizeName(p); p != "" && strings.Contains(normalized, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeName 大文字小文字と区切り文字を無視して比較するための正規化
+func normalizeName(s string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(s))
+}
+
+func (c *Checker) checkSensitiveLogData(call *ast.CallExpr, filePath string) {
+	if !isLogCall(call) {
+		return
+	}
+	rule := c.config.Logging.Rules.SensitiveData
+
+	for _, arg := range call.Args {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			var name string
+			switch node := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.CallExpr:
+				// ネストしたログ呼び出し（zap.String等）はそれ自体でチェックされる
+				return !isLogCall(node)
+			case *ast.SelectorExpr:
+				name = node.Sel.Name
+			case *ast.Ident:
+				name = node.Name
+			default:
+				return true
+			}
+
+			if !isSensitiveName(name, rule.Patterns) {
+				return true
+			}
+
+			pos := c.fset.Position(n.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "sensitive_data",
+				Category:   "logging",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("機密情報の可能性がある '%s' をログに出力しています", name),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: "値をマスクするか、ログ出力から除外してください",
+			})
+			// user.Password の Password を重複して報告しない
+			return false
+		})
+	}
+}
+
+// ========================================
+// コンテキスト付きロガーチェック
+// ========================================
+
+func (c *Checker) checkContextLogger(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil || contextParam(fn.Type) == nil {
+		return
+	}
+	rule := c.config.Logging.Rules.ContextLogger
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		callStr := c.getCallExprString(call)
+		if callStr == "" {
+			return true
+		}
+
+		for _, lib := range rule.Libraries {
+			if !containsString(lib.Calls, callStr) {
+				continue
+			}
+
+			pos := c.fset.Position(call.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "context_logger",
+				Category:   "logging",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("contextを受け取る関数 '%s' では%sのcontext対応ロガーを使用してください（%s）", fn.Name.Name, lib.Name, callStr),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: lib.Suggestion,
+			})
+			break
+		}
+		return true
+	})
+}
+
+// ========================================
+// 標準出力・標準エラーへの直接書き込みチェック
+// ========================================
+
+// 第1引数に出力先を取る書き込み関数
+var stdWriteFuncs = map[string]bool{
+	"fmt.Fprint": true, "fmt.Fprintf": true, "fmt.Fprintln": true,
+	"io.WriteString": true, "io.Copy": true,
+}
+
+func (c *Checker) checkStdWrite(call *ast.CallExpr, callStr, filePath string) {
+	// mainパッケージとcmd配下はCLI出力として許容
+	if c.file.Name.Name == "main" || hasPathSegment(filePath, "cmd") {
+		return
+	}
+	rule := c.config.Logging.Rules.NoStdWrite
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	var stream string
+	if stdWriteFuncs[callStr] && len(call.Args) > 0 {
+		stream = stdStreamName(call.Args[0])
+	} else if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		// os.Stdout.Write(...) / os.Stderr.WriteString(...)
+		stream = stdStreamName(sel.X)
+	}
+	if stream == "" {
+		return
+	}
+
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "no_std_write",
+		Category:   "logging",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("%sへの直接書き込みはmain/cmdパッケージ以外では使用しないでください", stream),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "ロガーを使用するか、io.Writerを引数で受け取ってください",
+	})
+}
+
+// checkBuiltinPrint 組み込み関数println/printの呼び出しを検出
+func (c *Checker) checkBuiltinPrint(call *ast.CallExpr, filePath string) {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok || (ident.Name != "println" && ident.Name != "print") {
+		return
+	}
+	// 同名の関数・変数が宣言されている場合は組み込み関数ではない
+	if ident.Obj != nil || c.file.Scope.Lookup(ident.Name) != nil {
+		return
+	}
+
+	rule := c.config.Logging.Rules.NoBuiltinPrint
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "no_builtin_print",
+		Category:   "logging",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("組み込み関数%sはfmtもロガーも経由しないため使用しないでください", ident.Name),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "デバッグ出力を削除するか、構造化ログライブラリを使用してください",
+	})
+}
+
+// stdStreamName 式がos.Stdout/os.Stderrであればその名前を返す
+func stdStreamName(expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || x.Name != "os" {
+		return ""
+	}
+	if sel.Sel.Name == "Stdout" || sel.Sel.Name == "Stderr" {
+		return "os." + sel.Sel.Name
+	}
+	return ""
+}
+
+// hasPathSegment パスに指定のディレクトリ名が含まれるか
+func hasPathSegment(path, segment string) bool {
+	for _, s := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if s == segment {
+			return true
+		}
+	}
+	return false
+}
+
+// ========================================
+// ロギングライブラリ混在チェック
+// ========================================
+
+// loggerImport ロギングライブラリのimport箇所
+type loggerImport struct {
+	library string
+	file    string
+	pos     token.Position
+	code    string // import行（ファイルの内容はチェック後に解放するため保持する）
+}
+
+// loggerLibraries importパス（サブパッケージを含む）→ロギングライブラリ名
+var loggerLibraries = map[string]string{
+	"log":                              "log",
+	"log/slog":                         "slog",
+	"github.com/sirupsen/logrus":       "logrus",
+	"go.uber.org/zap":                  "zap",
+	"github.com/rs/zerolog":            "zerolog",
+	"github.com/golang/glog":           "glog",
+	"k8s.io/klog":                      "klog",
+	"github.com/go-kit/log":            "go-kit",
+	"github.com/go-kit/kit/log":        "go-kit",
+	"github.com/apex/log":              "apex",
+	"github.com/hashicorp/go-hclog":    "hclog",
+	"github.com/inconshreveable/log15": "log15",
+	"github.com/charmbracelet/log":     "charmlog",
+}
+
+// loggerLibrary importパスからロギングライブラリ名を判定（ロギングライブラリでなければ空）
+// 標準ライブラリのlog・log/slogは完全一致、その他はサブパッケージ（/v2 等）も含む
+func loggerLibrary(importPath string) string {
+	if !strings.Contains(importPath, ".") {
+		return loggerLibraries[importPath]
+	}
+	for path := importPath; ; {
+		if lib, ok := loggerLibraries[path]; ok {
+			return lib
+		}
+		slash := strings.LastIndex(path, "/")
+		if slash < 0 {
+			return ""
+		}
+		path = path[:slash]
+	}
+}
+
+func (c *Checker) collectLoggerImports(file *ast.File, filePath string) {
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if lib := loggerLibrary(path); lib != "" {
+			pos := c.fset.Position(imp.Pos())
+			c.loggerImports = append(c.loggerImports, loggerImport{
+				library: lib,
+				file:    filePath,
+				pos:     pos,
+				code:    c.getCodeLine(filePath, pos.Line),
+			})
+		}
+	}
+}
+
+func (c *Checker) checkMixedLoggers() {
+	rule := c.config.Logging.Rules.MixedLoggers
+
+	// ライブラリごとの使用ファイル数
+	usage := make(map[string]map[string]bool)
+	for _, imp := range c.loggerImports {
+		if usage[imp.library] == nil {
+			usage[imp.library] = make(map[string]bool)
+		}
+		usage[imp.library][imp.file] = true
+	}
+	if len(usage) <= 1 {
+		return
+	}
+
+	canonical := rule.Canonical
+	if canonical == "" {
+		for lib, files := range usage {
+			if canonical == "" || len(files) > len(usage[canonical]) || (len(files) == len(usage[canonical]) && lib < canonical) {
+				canonical = lib
+			}
+		}
+	}
+
+	libs := make([]string, 0, len(usage))
+	for lib := range usage {
+		libs = append(libs, lib)
+	}
+	sort.Strings(libs)
+
+	for _, imp := range c.loggerImports {
+		if imp.library == canonical {
+			continue
+		}
+		c.report.AddViolation(report.Violation{
+			File:       imp.file,
+			Line:       imp.pos.Line,
+			Column:     imp.pos.Column,
+			Rule:       "mixed_loggers",
+			Category:   "logging",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("ロギングライブラリが混在しています（%s）。標準は%sです", strings.Join(libs, ", "), canonical),
+			Code:       imp.code,
+			Suggestion: fmt.Sprintf("%sに統一してください", canonical),
+		})
+	}
+}
+
+// ========================================
+// 標準log・承認されていないロギングライブラリのチェック
+// ========================================
+
+// checkStdLog 標準logパッケージの使用（log.Printf等）と、approved_loggersに無いロギングライブラリのimportを報告する
+func (c *Checker) checkStdLog(file *ast.File, filePath string) {
+	rule := c.config.Logging.Rules.NoStdLog
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	if len(rule.ApprovedLoggers) > 0 {
+		c.checkApprovedLoggers(file, filePath)
+	}
+	name := importName(file, "log")
+	if name == "" || name == "_" || name == "." {
+		return
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok || symbolRef(sel) != name+"."+sel.Sel.Name {
+			return true
+		}
+		c.reportStdLog(sel.Pos(), "標準logパッケージの log."+sel.Sel.Name+" は使用しないでください",
+			c.approvedLoggersSuggestion(), filePath)
+		return false
+	})
+}
+
+// checkApprovedLoggers approved_loggersに無いロギングライブラリのimportを報告する（標準logは使用箇所で報告する）
+func (c *Checker) checkApprovedLoggers(file *ast.File, filePath string) {
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		lib := loggerLibrary(path)
+		if lib == "" || lib == "log" || c.isApprovedLogger(lib, path) {
+			continue
+		}
+		c.reportStdLog(imp.Pos(), "ロギングライブラリ '"+lib+"'（"+path+"）は承認されていません",
+			c.approvedLoggersSuggestion(), filePath)
+	}
+}
+
+// isApprovedLogger ライブラリ名またはimportパス（サブパッケージを含む）がapproved_loggersにあるか
+func (c *Checker) isApprovedLogger(lib, importPath string) bool {
+	for _, approved := range c.config.Logging.Rules.NoStdLog.ApprovedLoggers {
+		if approved == lib || matchedPackage([]string{approved}, importPath) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// approvedLoggersSuggestion 承認されたロギングライブラリへの置き換えの提案
+func (c *Checker) approvedLoggersSuggestion() string {
+	approved := c.config.Logging.Rules.NoStdLog.ApprovedLoggers
+	if len(approved) == 0 {
+		return "構造化ログライブラリ（zerolog・zap・slog等）を使用してください"
+	}
+	return strings.Join(approved, "・") + " のいずれかを使用してください"
+}
+
+// reportStdLog no_std_logの違反を報告する
+func (c *Checker) reportStdLog(at token.Pos, message, suggestion, filePath string) {
+	pos := c.fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "no_std_log",
+		Category:   "logging",
+		Severity:   rules.ParseSeverity(c.config.Logging.Rules.NoStdLog.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// containsString スライスに文字列が含まれるか
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// stringLit i番目の引数が文字列リテラルであれば返す
+func stringLit(args []ast.Expr, i int) *ast.BasicLit {
+	if i >= len(args) {
+		return nil
+	}
+	if lit, ok := args[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		return lit
+	}
+	return nil
+}
+
+// matchesCaseStyle 指定スタイル（snake_case / camelCase）に合致するか
+func matchesCaseStyle(s, style string) bool {
+	switch style {
+	case "snake_case":
+		return isSnakeCase(s)
+	case "camelCase":
+		return isCamelCase(s) && !strings.ContainsAny(s, "_-")
+	default:
+		return true
+	}
+}
+
+// toCaseStyle 指定スタイルに変換
+func toCaseStyle(s, style string) string {
+	if style == "camelCase" {
+		return toCamelCase(s)
+	}
+	return toSnakeCase(s)
+}
@@ -0,0 +1,284 @@
+package checker
+
+import "testing"
+
+func TestLogFieldKeys(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    log_field_keys:
+      enabled: true
+      style: "snake_case"
+      severity: "warning"
+`
+	runRuleTests(t, config, "log_field_keys", []ruleTest{
+		{
+			name: "camelCase key and duplicate key",
+			files: map[string]string{"a.go": `package p
+
+import "log/slog"
+
+func f(id, name string) {
+	slog.Info("user loaded", "userId", id)
+	slog.Info("user loaded", "user_id", id, "user_id", name)
+}
+`},
+			want: 2,
+		},
+		{
+			name: "snake_case keys",
+			files: map[string]string{"a.go": `package p
+
+import "log/slog"
+
+func f(id, name string) {
+	slog.Info("user loaded", "user_id", id, slog.String("user_name", name))
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSensitiveData(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    sensitive_data:
+      enabled: true
+      severity: "error"
+      patterns: ["password", "apiKey"]
+`
+	runRuleTests(t, config, "sensitive_data", []ruleTest{
+		{
+			name: "password and api key logged",
+			files: map[string]string{"a.go": `package p
+
+import "log"
+
+type user struct{ Password string }
+
+func f(u user, api_key string) {
+	log.Printf("login %s %s", u.Password, api_key)
+}
+`},
+			want: 2,
+		},
+		{
+			name: "no sensitive names",
+			files: map[string]string{"a.go": `package p
+
+import "log"
+
+func f(password string) {
+	log.Printf("login %s", "user")
+	_ = password
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestContextLogger(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    context_logger:
+      enabled: true
+      severity: "info"
+      libraries:
+        - name: "slog"
+          calls: ["slog.Info"]
+          suggestion: "slog.InfoContext(ctx, ...) を使用してください"
+`
+	runRuleTests(t, config, "context_logger", []ruleTest{
+		{
+			name: "logger without context in request scope",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"context"
+	"log/slog"
+)
+
+func f(ctx context.Context) {
+	slog.Info("start")
+}
+`},
+			want: 1,
+		},
+		{
+			name: "context logger or no context",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"context"
+	"log/slog"
+)
+
+func f(ctx context.Context) {
+	slog.InfoContext(ctx, "start")
+}
+
+func g() {
+	slog.Info("start")
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestNoStdWrite(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    no_std_write:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "no_std_write", []ruleTest{
+		{
+			name: "direct writes in library package",
+			files: map[string]string{"lib/a.go": `package lib
+
+import (
+	"fmt"
+	"os"
+)
+
+func f() {
+	fmt.Fprintln(os.Stderr, "failed")
+	os.Stdout.WriteString("done")
+}
+`},
+			want: 2,
+		},
+		{
+			name: "main package and cmd directory",
+			files: map[string]string{
+				"main.go": `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() { fmt.Fprintln(os.Stderr, "usage") }
+`,
+				"cmd/tool/run.go": `package tool
+
+import (
+	"fmt"
+	"os"
+)
+
+func Run() { fmt.Fprintln(os.Stdout, "ok") }
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestMixedLoggers(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    mixed_loggers:
+      enabled: true
+      severity: "warning"
+      canonical: "slog"
+`
+	runRuleTests(t, config, "mixed_loggers", []ruleTest{
+		{
+			name: "logrus and zap next to slog",
+			files: map[string]string{
+				"a.go": "package p\n\nimport \"log/slog\"\n\nvar _ = slog.Info\n",
+				"b.go": "package p\n\nimport \"github.com/sirupsen/logrus\"\n\nvar _ = logrus.Info\n",
+				"c.go": "package p\n\nimport \"go.uber.org/zap\"\n\nvar _ = zap.L\n",
+			},
+			want: 2,
+		},
+		{
+			name: "single library",
+			files: map[string]string{
+				"a.go": "package p\n\nimport \"log/slog\"\n\nvar _ = slog.Info\n",
+				"b.go": "package p\n\nimport \"log/slog\"\n\nvar _ = slog.Warn\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestNoBuiltinPrint(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    no_builtin_print:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "no_builtin_print", []ruleTest{
+		{
+			name: "println and print",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	println("debug")
+	print("x")
+}
+`},
+			want: 2,
+		},
+		{
+			name: "shadowed by a declared function",
+			files: map[string]string{"a.go": `package p
+
+func println(s string) {}
+
+func f() { println("ok") }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestNoStdLogApprovedLoggers(t *testing.T) {
+	const config = `
+logging:
+  enabled: true
+  rules:
+    no_std_log:
+      enabled: true
+      severity: "info"
+      allowed_in: ["cmd/**"]
+      approved_loggers: ["slog", "zap"]
+`
+	runRuleTests(t, config, "no_std_log", []ruleTest{
+		{
+			name: "standard log and unapproved library",
+			files: map[string]string{
+				"a.go": "package p\n\nimport \"log\"\n\nfunc f() { log.Printf(\"x\") }\n",
+				"b.go": "package p\n\nimport \"github.com/sirupsen/logrus\"\n\nvar _ = logrus.Info\n",
+			},
+			want: 2,
+		},
+		{
+			name: "approved libraries and allowed path",
+			files: map[string]string{
+				"a.go":             "package p\n\nimport \"go.uber.org/zap\"\n\nvar _ = zap.L\n",
+				"b.go":             "package p\n\nimport \"log/slog\"\n\nfunc f() { slog.Info(\"x\") }\n",
+				"cmd/tool/main.go": "package main\n\nimport \"log\"\n\nfunc main() { log.Fatal(\"x\") }\n",
+			},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package checker
+
+import (
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ========================================
+// ディスク上の内容の代わりに使うファイル（SetOverlay）
+// ========================================
+
+// overlayFile SetOverlayで指定した内容を読み込むfs.File
+type overlayFile struct {
+	*bytes.Reader
+	info overlayInfo
+}
+
+// newOverlayFile 内容srcのファイルを開く
+func newOverlayFile(path string, src []byte) *overlayFile {
+	return &overlayFile{Reader: bytes.NewReader(src), info: overlayInfo{name: filepath.Base(path), size: int64(len(src))}}
+}
+
+func (f *overlayFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *overlayFile) Close() error               { return nil }
+
+// overlayInfo SetOverlayで指定したファイルの情報
+type overlayInfo struct {
+	name string
+	size int64
+}
+
+func (i overlayInfo) Name() string       { return i.name }
+func (i overlayInfo) Size() int64        { return i.size }
+func (i overlayInfo) Mode() fs.FileMode  { return 0o644 }
+func (i overlayInfo) ModTime() time.Time { return time.Time{} }
+func (i overlayInfo) IsDir() bool        { return false }
+func (i overlayInfo) Sys() any           { return nil }
+
+// unwalkedOverlay 走査で見つからなかった（ディスク上に存在しない）dir配下のSetOverlayのファイル（パス順）
+func (c *Checker) unwalkedOverlay(dir string, walked map[string]bool) []string {
+	var paths []string
+	for path := range c.overlay {
+		if walked[path] {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, path); err == nil && !strings.HasPrefix(rel, "..") {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,81 @@
+package checker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// パスごとの設定の上書き（overrides）
+// ========================================
+
+// overrideConfig overridesを適用した設定（一致したoverridesの組み合わせごとに1つ）
+type overrideConfig struct {
+	config   *rules.Config
+	patterns *rules.Patterns
+	ruleSet  ruleSet
+}
+
+// compileOverrides overridesのパスとルール名を検証する（チェック開始時に1回）
+// 上書きはファイル単位のルールに適用し、プロジェクト単位のルールは元の設定でチェックする
+func (c *Checker) compileOverrides() error {
+	c.overrideConfigs = make(map[string]*overrideConfig)
+	known := make(map[string]bool)
+	for _, rule := range append(append(RegisteredRules(), c.extraRules...), c.pluginRules...) {
+		known[rule.Name()] = true
+		known[rule.Category()] = true
+	}
+	for i, o := range c.config.Overrides {
+		if len(o.Paths) == 0 {
+			return fmt.Errorf("overrides[%d].paths: no paths", i)
+		}
+		for name := range o.Rules {
+			if !known[name] {
+				return fmt.Errorf("overrides[%d].rules: unknown rule %q", i, name)
+			}
+		}
+		if _, err := c.config.WithOverrides(c.config.Overrides[i : i+1]); err != nil {
+			return fmt.Errorf("overrides[%d].rules.%w", i, err)
+		}
+	}
+	return nil
+}
+
+// applyOverrides ファイルのパスに一致するoverridesを適用した設定・ルールに切り替える（ワーカーごとのコピーで呼び出す）
+func (c *Checker) applyOverrides(filePath string) error {
+	var matched []rules.Override
+	key := ""
+	for i, o := range c.config.Overrides {
+		if c.isAllowedIn(o.Paths, filePath) {
+			matched = append(matched, o)
+			key += strconv.Itoa(i) + ","
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	oc := c.overrideConfigs[key]
+	if oc == nil {
+		cfg, err := c.config.WithOverrides(matched)
+		if err != nil {
+			return err
+		}
+		patterns, err := cfg.Patterns()
+		if err != nil {
+			return err
+		}
+		oc = &overrideConfig{config: cfg, patterns: patterns}
+		base := c.config
+		c.config = cfg
+		oc.ruleSet = c.resolveRules()
+		c.config = base
+		c.overrideConfigs[key] = oc
+	}
+	c.config, c.patterns, c.ruleSet = oc.config, oc.patterns, oc.ruleSet
+	return nil
+}
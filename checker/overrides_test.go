@@ -0,0 +1,52 @@
+package checker
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-standards-checker/rules"
+)
+
+func TestOverrides(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    no_panic:
+      enabled: true
+      severity: "warning"
+overrides:
+  - paths: ["tools/**"]
+    rules:
+      no_panic: {enabled: false}
+`
+	const src = "package tools\n\nfunc f() { panic(\"unreachable\") }\n"
+	runRuleTests(t, config, "no_panic", []ruleTest{
+		{
+			name:  "path not overridden",
+			files: map[string]string{"internal/a.go": src, "tools/a.go": src},
+			want:  1,
+		},
+		{
+			name:  "rule disabled for path",
+			files: map[string]string{"tools/a.go": src, "tools/gen/b.go": src},
+			want:  0,
+		},
+	})
+}
+
+func TestOverridesUnknownRule(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"config.yaml": "overrides:\n  - paths: [\"tools/**\"]\n    rules:\n      no_such_rule: {enabled: false}\n",
+		"a.go":        "package p\n",
+	})
+	cfg, err := rules.LoadConfig(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewChecker(cfg).Check(dir); err == nil || !strings.Contains(err.Error(), "no_such_rule") {
+		t.Errorf("Check() error = %v, want unknown rule no_such_rule", err)
+	}
+}
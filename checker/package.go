@@ -0,0 +1,28 @@
+package checker
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// ========================================
+// 解析済みパッケージのチェック
+// ========================================
+
+// SetPackage 解析済みのパッケージをチェック対象にする（analysisのドライバー等、AST・型情報を既に持つ場合）
+//
+// ディレクトリの走査・ファイルの再解析・型チェックは行わず、filesのファイルのみをチェックする。
+// srcsはファイルの内容（パス→内容）、infoは型情報（nilの場合は型情報なし）。ASTの位置はfsetに対応している必要がある
+func (c *Checker) SetPackage(fset *token.FileSet, files []*ast.File, srcs map[string][]byte, info *types.Info) {
+	c.fset = fset
+	c.info = info
+	c.pkgFiles = make([]string, 0, len(files))
+	for _, file := range files {
+		path := fset.Position(file.Package).Filename
+		c.pkgFiles = append(c.pkgFiles, path)
+		c.astMap[path] = file
+	}
+	c.SetFiles(c.pkgFiles)
+	c.SetOverlay(srcs)
+}
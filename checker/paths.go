@@ -0,0 +1,170 @@
+package checker
+
+import (
+	"bufio"
+	"go/ast"
+	"go/token"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ========================================
+// パス・パターン照合ヘルパー
+// ========================================
+
+// readModulePath go.modからモジュールパスを読み取る
+func (c *Checker) readModulePath(dir string) string {
+	file, err := c.openFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module ") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module")), `"`)
+		}
+	}
+	return ""
+}
+
+// relPath ルートディレクトリからの相対パス（スラッシュ区切り）
+func (c *Checker) relPath(filePath string) string {
+	rel, err := filepath.Rel(c.rootDir, filePath)
+	if err != nil {
+		return filepath.ToSlash(filePath)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// importPath ファイルが属するパッケージのimportパス（go.modが無ければ空）
+func (c *Checker) importPath(filePath string) string {
+	if c.modulePath == "" {
+		return ""
+	}
+	dir := path.Dir(c.relPath(filePath))
+	if dir == "." {
+		return c.modulePath
+	}
+	return c.modulePath + "/" + dir
+}
+
+// isAllowedIn ファイルが許可パターンのいずれかにマッチするか
+// ファイル名・相対パス・パッケージのimportパスのいずれかと照合する
+func (c *Checker) isAllowedIn(patterns []string, filePath string) bool {
+	base := filepath.Base(filePath)
+	rel := c.relPath(filePath)
+	pkg := c.importPath(filePath)
+
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, base); matched {
+			return true
+		}
+		if matchGlob(pattern, rel) {
+			return true
+		}
+		if pkg != "" && matchGlob(pattern, pkg) {
+			return true
+		}
+	}
+	return false
+}
+
+// globCache globパターン→変換した正規表現（ノードごとに照合するため変換は1回だけ行う）
+var globCache sync.Map
+
+// matchGlob **（任意階層）に対応したglobマッチ
+func matchGlob(pattern, name string) bool {
+	if re, ok := globCache.Load(pattern); ok {
+		return re.(*regexp.Regexp).MatchString(name)
+	}
+	re := globRegexp(pattern)
+	globCache.Store(pattern, re)
+	return re.MatchString(name)
+}
+
+// globRegexp globパターンを正規表現に変換
+func globRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch ch := pattern[i]; ch {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// **/ は0個以上のディレクトリ
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	sb.WriteString("$")
+
+	// 各文字をエスケープしているため常にコンパイルできる
+	return regexp.MustCompile(sb.String())
+}
+
+// enclosingFunc 指定位置を含むトップレベル関数を返す
+func (c *Checker) enclosingFunc(pos token.Pos) *ast.FuncDecl {
+	for _, decl := range c.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= pos && pos < fn.End() {
+			return fn
+		}
+	}
+	return nil
+}
+
+// matchesFuncName 関数名（またはType.Method）がパターンのいずれかにマッチするか
+func matchesFuncName(patterns []string, fn *ast.FuncDecl) bool {
+	names := []string{fn.Name.Name}
+	if recv := receiverTypeName(fn); recv != "" {
+		names = append(names, recv+"."+fn.Name.Name)
+	}
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if matched, _ := filepath.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// receiverTypeName メソッドのレシーバ型名（関数であれば空）
+func receiverTypeName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr:
+		if ident, ok := t.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	case *ast.IndexListExpr:
+		if ident, ok := t.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	}
+	return ""
+}
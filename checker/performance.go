@@ -0,0 +1,152 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// パフォーマンスチェック
+// ========================================
+
+// checkSprintfConcat 文字列連結やstrconvで済むfmt.Sprintfを検出
+//
+//	fmt.Sprintf("%s%s", a, b) → a + b
+//	fmt.Sprintf("%d", n)      → strconv.Itoa(n)（型が判明する場合は自動修正情報付き）
+func (c *Checker) checkSprintfConcat(call *ast.CallExpr, callStr, filePath string) {
+	if callStr != "fmt.Sprintf" || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return
+	}
+	lit := stringLit(call.Args, 0)
+	if lit == nil {
+		return
+	}
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+	literals, verbs, ok := splitFormat(format)
+	if !ok || len(verbs) != len(call.Args)-1 {
+		return
+	}
+
+	rule := c.config.Performance.Rules.SprintfConcat
+	pos := c.fset.Position(call.Pos())
+	v := report.Violation{
+		File:     filePath,
+		Line:     pos.Line,
+		Column:   pos.Column,
+		Rule:     "sprintf_concat",
+		Category: "performance",
+		Severity: rules.ParseSeverity(rule.Severity),
+		Code:     c.getCodeLine(filePath, pos.Line),
+	}
+
+	switch {
+	case len(verbs) == 1 && verbs[0] == "d" && literals[0] == "" && literals[1] == "":
+		arg := c.nodeText(filePath, call.Args[1])
+		v.Message = "整数の文字列変換にfmt.Sprintfは不要です"
+		replacement, ok := c.intToString(call.Args[1], arg)
+		if !ok {
+			v.Suggestion = "strconv.Itoa / strconv.FormatInt / strconv.FormatUint を使用してください"
+			break
+		}
+		edits := []report.TextEdit{c.replaceEdit(call, replacement)}
+		edits = append(edits, c.addImportEdits(c.file, "strconv")...)
+		edits = append(edits, c.removeImportEdits(c.file, "fmt", "fmt", 1)...)
+
+		v.Suggestion = fmt.Sprintf("%s を使用してください", replacement)
+		v.Fix = &report.Fix{Description: "strconvに置換", Edits: edits}
+	case allVerbs(verbs, "s"):
+		parts := make([]string, 0, len(literals)+len(verbs))
+		for i, lit := range literals {
+			if lit != "" {
+				parts = append(parts, strconv.Quote(lit))
+			}
+			if i < len(verbs) {
+				parts = append(parts, c.nodeText(filePath, call.Args[i+1]))
+			}
+		}
+		v.Message = "文字列の連結にfmt.Sprintfは不要です"
+		v.Suggestion = fmt.Sprintf("%s のように連結してください", strings.Join(parts, " + "))
+	default:
+		return
+	}
+
+	c.report.AddViolation(v)
+}
+
+// intToString 整数式argを文字列化するstrconvの呼び出しを返す
+// 型が判明しない場合（型情報なしで整数リテラル以外）はokがfalse
+func (c *Checker) intToString(arg ast.Expr, text string) (string, bool) {
+	if lit, ok := ast.Unparen(arg).(*ast.BasicLit); ok && lit.Kind == token.INT {
+		return "strconv.Itoa(" + text + ")", true
+	}
+	t := c.typeOf(arg)
+	if t == nil {
+		return "", false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", false
+	}
+	_, named := t.(*types.Named)
+	switch kind := basic.Kind(); {
+	case kind == types.UntypedInt || kind == types.Int && !named:
+		return "strconv.Itoa(" + text + ")", true
+	case kind == types.Int64 && !named:
+		return "strconv.FormatInt(" + text + ", 10)", true
+	case kind == types.Uint64 && !named:
+		return "strconv.FormatUint(" + text + ", 10)", true
+	case basic.Info()&types.IsUnsigned != 0:
+		return "strconv.FormatUint(uint64(" + text + "), 10)", true
+	case basic.Info()&types.IsInteger != 0:
+		return "strconv.FormatInt(int64(" + text + "), 10)", true
+	}
+	return "", false
+}
+
+// splitFormat 書式文字列をリテラル部分と動詞に分割する
+// フラグ・幅・精度・引数インデックス付きの動詞を含む場合はokがfalse
+func splitFormat(format string) (literals, verbs []string, ok bool) {
+	var lit strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if i+1 >= len(format) {
+			return nil, nil, false
+		}
+		i++
+		switch verb := format[i]; {
+		case verb == '%':
+			lit.WriteByte('%')
+		case verb >= 'a' && verb <= 'z' || verb >= 'A' && verb <= 'Z':
+			literals = append(literals, lit.String())
+			lit.Reset()
+			verbs = append(verbs, string(verb))
+		default:
+			return nil, nil, false
+		}
+	}
+	literals = append(literals, lit.String())
+	return literals, verbs, true
+}
+
+// allVerbs すべての動詞がverbか
+func allVerbs(verbs []string, verb string) bool {
+	for _, v := range verbs {
+		if v != verb {
+			return false
+		}
+	}
+	return len(verbs) > 0
+}
@@ -0,0 +1,101 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSprintfConcat(t *testing.T) {
+	const config = `
+performance:
+  enabled: true
+  rules:
+    sprintf_concat:
+      enabled: true
+      severity: "info"
+`
+	runRuleTests(t, config, "sprintf_concat", []ruleTest{
+		{
+			name: "integer conversion and concatenation",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func f(n int, a, b string) (string, string) {
+	return fmt.Sprintf("%d", n), fmt.Sprintf("%s-%s", a, b)
+}
+`},
+			want: 2,
+		},
+		{
+			name: "formatting that needs fmt",
+			files: map[string]string{"a.go": `package p
+
+import "fmt"
+
+func f(n int, v any) (string, string) {
+	return fmt.Sprintf("%5d", n), fmt.Sprintf("value %v", v)
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestSprintfConcatFix(t *testing.T) {
+	const config = `
+performance:
+  enabled: true
+  rules:
+    sprintf_concat:
+      enabled: true
+      severity: "info"
+`
+	tests := []struct {
+		name  string
+		typed bool
+		src   string
+		want  string // 置換後の式（空の場合は自動修正なし）
+	}{
+		{name: "int literal", src: "fmt.Sprintf(\"%d\", 42)", want: "strconv.Itoa(42)"},
+		{name: "untyped variable", src: "fmt.Sprintf(\"%d\", n)", want: ""},
+		{name: "typed int", typed: true, src: "fmt.Sprintf(\"%d\", n)", want: "strconv.Itoa(n)"},
+		{name: "typed int64", typed: true, src: "fmt.Sprintf(\"%d\", n64)", want: "strconv.FormatInt(n64, 10)"},
+		{name: "typed int32", typed: true, src: "fmt.Sprintf(\"%d\", n32)", want: "strconv.FormatInt(int64(n32), 10)"},
+		{name: "typed uint", typed: true, src: "fmt.Sprintf(\"%d\", u)", want: "strconv.FormatUint(uint64(u), 10)"},
+		{name: "typed named int", typed: true, src: "fmt.Sprintf(\"%d\", code)", want: "strconv.FormatInt(int64(code), 10)"},
+		{name: "typed pointer", typed: true, src: "fmt.Sprintf(\"%d\", &n)", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config
+			if tt.typed {
+				cfg = "settings:\n  typed: true\n" + cfg
+			}
+			src := "package p\n\nimport \"fmt\"\n\ntype status int\n\n" +
+				"func f(n int, n64 int64, n32 int32, u uint, code status) string {\n\treturn " + tt.src + "\n}\n"
+			rep := checkConfig(t, cfg, map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.21\n",
+				"a.go":   src,
+			})
+			if n := countRule(rep, "sprintf_concat"); n != 1 {
+				t.Fatalf("sprintf_concat violations = %d, want 1", n)
+			}
+			var got string
+			for _, v := range rep.Violations {
+				if v.Rule != "sprintf_concat" || v.Fix == nil {
+					continue
+				}
+				for _, e := range v.Fix.Edits {
+					if strings.HasPrefix(e.NewText, "strconv.") {
+						got = e.NewText
+					}
+				}
+			}
+			if got != tt.want {
+				t.Errorf("replacement = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// ファイル単位のチェックパイプライン
+// ========================================
+//
+// walker（ファイル収集）→ workers（解析・チェック）→ collector（結果の集約）の順に流す
+// walkerはディレクトリ（パッケージ）単位にまとめてワーカーに渡し、ワーカーはディレクトリ内の
+// ファイルを1回ずつ読み込み・解析してチェックした後に解放する（保持するのは処理中のディレクトリのみ）
+// ワーカーはCheckerのコピーでチェックし、ファイル単位の状態（AST・行内容・違反）は
+// コピーとFileContextのみが持つ。共有するのは読み取り専用の設定・型情報と、
+// mu で保護した解析結果のキャッシュのみ
+
+// fileJob ワーカーに渡すファイル
+type fileJob struct {
+	index int
+	path  string
+}
+
+// fileResult ワーカーが1ファイルのチェックで得た結果
+type fileResult struct {
+	index         int
+	path          string
+	violations    []report.Violation
+	loggerImports []loggerImport
+	backlog       []report.TodoItem
+	suppressed    int            // 抑制コメントで取り除いた違反の件数
+	suppressions  []*suppression // ファイルの抑制コメント（プロジェクト単位のルールにも適用する）
+	canceled      bool           // キャンセルによりチェックしなかった（途中で打ち切った）
+}
+
+// pipelineResult パイプラインの実行結果
+type pipelineResult struct {
+	files     []string // チェック対象のファイル（収集順）
+	generated int      // 自動生成のためスキップしたファイル数
+	checked   int      // チェックを終えたファイル数（キャンセルした場合はfilesより少ない）
+}
+
+// runPipeline ファイルを収集しながら並行してチェックし、結果をレポートに集約する
+// ctxがキャンセルされた場合も、それまでにチェックを終えたファイルの結果は集約する
+func (c *Checker) runPipeline(ctx context.Context, targetDir string) (pipelineResult, error) {
+	batches := make(chan []fileJob)
+	results := make(chan fileResult)
+
+	// walker
+	var result pipelineResult
+	walkErr := make(chan error, 1)
+	if c.needsPrepass() {
+		// 型情報付き解析は全ファイルを必要とするため、収集を終えてから流す
+		files, skipped, err := c.collectGoFiles(ctx, targetDir)
+		result.generated = skipped
+		if err != nil {
+			return result, err
+		}
+		result.files = files
+		c.prepass(ctx, files)
+		go func() {
+			defer close(batches)
+			group := newDirGrouper(batches)
+			for _, path := range result.files {
+				group.add(path)
+			}
+			group.flush("")
+			walkErr <- nil
+		}()
+	} else {
+		go func() {
+			defer close(batches)
+			group := newDirGrouper(batches)
+			skipped, err := c.walkGoFiles(ctx, targetDir, func(path string) {
+				group.add(path)
+				result.files = append(result.files, path)
+			})
+			result.generated = skipped
+			group.flush("")
+			walkErr <- err
+		}()
+	}
+
+	// workers
+	var wg sync.WaitGroup
+	for i := 0; i < c.workerCount(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				c.checkDirJob(ctx, batch, results)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// collector（収集順に並べ直し、先頭から揃った分を逐次レポートに渡す）
+	// ワーカーがCheckerをコピーするため、Checkerのフィールドは全ワーカーの終了後に更新する
+	var loggerImports []loggerImport
+	suppressions := make(map[string][]*suppression)
+	pending := make(map[int]fileResult)
+	next := 0
+	for r := range results {
+		pending[r.index] = r
+		for {
+			done, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			if done.canceled {
+				continue
+			}
+			result.checked++
+			for _, v := range done.violations {
+				c.report.AddViolation(v)
+			}
+			loggerImports = append(loggerImports, done.loggerImports...)
+			for _, item := range done.backlog {
+				c.report.AddTodo(item)
+			}
+			c.report.AddSuppressed(done.suppressed)
+			if len(done.suppressions) > 0 {
+				suppressions[done.path] = done.suppressions
+			}
+		}
+	}
+	c.loggerImports = loggerImports
+	c.fileSuppressions = suppressions
+	if err := <-walkErr; err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// dirGrouper 深さ優先で見つかったファイルをディレクトリ単位にまとめる
+// ディレクトリ内のファイルはサブディレクトリを挟んで見つかる場合があるため、
+// 走査がディレクトリを抜けた時点でまとめて送る
+type dirGrouper struct {
+	out   chan<- []fileJob
+	stack []dirBatch // 走査中のディレクトリ（親→子）
+	count int
+}
+
+type dirBatch struct {
+	dir  string
+	jobs []fileJob
+}
+
+func newDirGrouper(out chan<- []fileJob) *dirGrouper {
+	return &dirGrouper{out: out}
+}
+
+// add ファイルを追加（抜けたディレクトリのファイルを送る）
+func (g *dirGrouper) add(path string) {
+	dir := filepath.Dir(path)
+	g.flush(dir)
+	if n := len(g.stack); n == 0 || g.stack[n-1].dir != dir {
+		g.stack = append(g.stack, dirBatch{dir: dir})
+	}
+	top := &g.stack[len(g.stack)-1]
+	top.jobs = append(top.jobs, fileJob{index: g.count, path: path})
+	g.count++
+}
+
+// flush dir（空の場合はすべて）とその親以外のディレクトリのファイルを送る
+func (g *dirGrouper) flush(dir string) {
+	for n := len(g.stack); n > 0; n = len(g.stack) {
+		top := g.stack[n-1]
+		if dir != "" && isDirOrParent(top.dir, dir) {
+			return
+		}
+		g.out <- top.jobs
+		g.stack = g.stack[:n-1]
+	}
+}
+
+// isDirOrParent parentがdir自身または祖先か
+func isDirOrParent(parent, dir string) bool {
+	return parent == dir || parent == "." || strings.HasPrefix(dir, parent+string(filepath.Separator))
+}
+
+// needsPrepass 全ファイルを対象にした事前解析が必要か
+func (c *Checker) needsPrepass() bool {
+	return c.config.Settings.Typed && c.fsys == nil
+}
+
+// prepass 全ファイルを対象にした事前解析
+func (c *Checker) prepass(ctx context.Context, goFiles []string) {
+	// 型情報付き解析（パッケージ単位で型チェック、SetPackageの場合は渡された型情報を使う）
+	if c.pkgFiles == nil {
+		c.loadTypes(ctx, goFiles)
+	}
+
+	// Lambdaハンドラを収集（型情報付き解析で全ファイルを解析済みのため全体で行う）
+	if c.config.AWSLambda.Enabled && ctx.Err() == nil {
+		c.collectLambdaHandlers(goFiles)
+	}
+}
+
+// workerCount ワーカー数（settings.workers、未指定の場合はCPU数）
+func (c *Checker) workerCount() int {
+	if n := c.config.Settings.Workers; n > 0 {
+		return n
+	}
+	return runtime.NumCPU()
+}
+
+// checkDirJob 同じディレクトリのファイルをチェックし、終わったファイルの内容を解放する
+// キャンセルされた場合も、集約側が収集順に並べ直せるようにファイルごとに結果を送る
+func (c *Checker) checkDirJob(ctx context.Context, jobs []fileJob, results chan<- fileResult) {
+	if ctx.Err() != nil {
+		for _, job := range jobs {
+			results <- fileResult{index: job.index, canceled: true}
+		}
+		return
+	}
+
+	paths := make([]string, len(jobs))
+	for i, job := range jobs {
+		paths[i] = job.path
+	}
+
+	// Lambdaハンドラを収集（ハンドラ定義とlambda.Startが別ファイルの場合があるためディレクトリ単位で行う）
+	d := *c
+	if c.config.AWSLambda.Enabled && c.lambdaHandlers == nil && (len(c.config.AWSLambda.HandlerWrappers) > 0 || d.importsLambda(paths)) {
+		d.collectLambdaHandlers(paths)
+	}
+
+	for _, job := range jobs {
+		results <- d.checkFileJob(ctx, job)
+	}
+	c.releaseFiles(paths)
+}
+
+// importsLambda いずれかのファイルがaws-lambda-goを参照しているか
+func (c *Checker) importsLambda(paths []string) bool {
+	for _, path := range paths {
+		if src, err := c.loadFile(path); err == nil && bytes.Contains(src, []byte(`"github.com/aws/aws-lambda-go/`)) {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseFiles チェックを終えたファイルの内容とASTを解放する
+// 型情報はASTを参照するため、型情報付き解析ではASTを保持する
+func (c *Checker) releaseFiles(paths []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, path := range paths {
+		delete(c.fileMap, path)
+		delete(c.srcMap, path)
+		if c.info == nil {
+			delete(c.astMap, path)
+		}
+	}
+}
+
+// checkFileJob ワーカー用のコピーで1ファイルをチェックする（内容が変わっていなければキャッシュを使う）
+func (c *Checker) checkFileJob(ctx context.Context, job fileJob) fileResult {
+	if ctx.Err() != nil {
+		return fileResult{index: job.index, canceled: true}
+	}
+
+	var hash string
+	if c.cache != nil {
+		if src, err := c.loadFile(job.path); err == nil {
+			hash = c.fileHash(job.path, src)
+			if result, ok := c.cache.lookup(job, hash); ok {
+				return result
+			}
+		}
+	}
+
+	w := *c
+	w.report = report.NewReport(job.path)
+	w.loggerImports = nil
+	start := c.timings.now()
+	err := w.applyOverrides(job.path)
+	if err == nil {
+		err = w.checkFile(ctx, job.path)
+	}
+	if c.timings != nil {
+		c.timings.addFile(job.path, time.Since(start), w.clock)
+	}
+	if ctx.Err() != nil {
+		// 途中で打ち切ったファイルの結果は使わない
+		return fileResult{index: job.index, canceled: true}
+	}
+	if err != nil {
+		c.warnf("failed to check %s: %v", job.path, err)
+	}
+	result := fileResult{
+		index:         job.index,
+		path:          job.path,
+		violations:    w.report.Violations,
+		loggerImports: w.loggerImports,
+		backlog:       w.report.Backlog,
+		suppressed:    w.report.Summary.Suppressed,
+		suppressions:  w.suppressions,
+	}
+	if c.cache != nil && hash != "" && err == nil {
+		if err := c.cache.store(job, hash, result); err != nil {
+			c.warnf("failed to write cache for %s: %v", job.path, err)
+		}
+	}
+	return result
+}
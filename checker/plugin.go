@@ -0,0 +1,225 @@
+package checker
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"plugin"
+	"sync"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 外部ルールプラグイン
+// ========================================
+//
+// 2種類のプラグインをサポートする
+//   - go:      Go pluginの.soファイル。`Rules` シンボル（func() []checker.Rule）でルールを公開する
+//   - process: 外部プロセス。標準入出力で改行区切りのJSON-RPC 2.0をやり取りする
+//       initialize → {"rules": [{"name": "...", "category": "..."}]}
+//       check_file {"path": "...", "source": "..."} → {"violations": [Violation...]}
+//       shutdown（応答不要、終了後に標準入力を閉じる）
+
+// loadPlugins 設定のプラグインを読み込み、ルールとして追加する
+func (c *Checker) loadPlugins() error {
+	c.pluginRules = nil
+	for _, cfg := range c.config.Plugins {
+		if !cfg.Enabled {
+			continue
+		}
+		switch cfg.Type {
+		case "go":
+			loaded, err := loadGoPlugin(cfg.Path)
+			if err != nil {
+				return fmt.Errorf("plugin %s: %w", cfg.Name, err)
+			}
+			c.pluginRules = append(c.pluginRules, loaded...)
+		case "process", "":
+			p, err := startProcessPlugin(cfg)
+			if err != nil {
+				return fmt.Errorf("plugin %s: %w", cfg.Name, err)
+			}
+			c.plugins = append(c.plugins, p)
+			c.pluginRules = append(c.pluginRules, p)
+		default:
+			return fmt.Errorf("plugin %s: unknown type %q", cfg.Name, cfg.Type)
+		}
+	}
+	return nil
+}
+
+// closePlugins 外部プロセスのプラグインを終了する
+func (c *Checker) closePlugins() {
+	for _, p := range c.plugins {
+		if err := p.close(); err != nil {
+			c.warnf("plugin %s: %v", p.name, err)
+		}
+	}
+	c.plugins = nil
+}
+
+// loadGoPlugin Go pluginの.soファイルからルールを読み込む
+func loadGoPlugin(path string) ([]Rule, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	sym, err := p.Lookup("Rules")
+	if err != nil {
+		return nil, err
+	}
+	rulesFunc, ok := sym.(func() []Rule)
+	if !ok {
+		return nil, fmt.Errorf("symbol Rules must be func() []checker.Rule, got %T", sym)
+	}
+	return rulesFunc(), nil
+}
+
+// pluginRuleInfo 外部プロセスが提供するルール
+type pluginRuleInfo struct {
+	RuleName     string `json:"name"`
+	RuleCategory string `json:"category"`
+}
+
+func (r pluginRuleInfo) Name() string     { return r.RuleName }
+func (r pluginRuleInfo) Category() string { return r.RuleCategory }
+
+// processPlugin 外部プロセスのプラグイン（ファイル単位で問い合わせる）
+type processPlugin struct {
+	name  string
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	out   *bufio.Reader
+	rules map[string]pluginRuleInfo
+
+	mu     sync.Mutex
+	nextID int
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id,omitempty"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// startProcessPlugin 外部プロセスを起動し、提供するルールを問い合わせる
+func startProcessPlugin(cfg rules.PluginConfig) (*processPlugin, error) {
+	cmd := exec.Command(cfg.Path, cfg.Args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	p := &processPlugin{
+		name:  cfg.Name,
+		cmd:   cmd,
+		stdin: stdin,
+		out:   bufio.NewReader(stdout),
+		rules: make(map[string]pluginRuleInfo),
+	}
+	var result struct {
+		Rules []pluginRuleInfo `json:"rules"`
+	}
+	if err := p.call("initialize", nil, &result); err != nil {
+		p.close()
+		return nil, err
+	}
+	for _, r := range result.Rules {
+		p.rules[r.RuleName] = r
+	}
+	return p, nil
+}
+
+func (p *processPlugin) Name() string     { return p.name }
+func (p *processPlugin) Category() string { return "plugin" }
+
+// CheckFile ファイルの内容を外部プロセスに渡し、返された違反を報告する
+func (p *processPlugin) CheckFile(ctx *FileContext) {
+	var result struct {
+		Violations []report.Violation `json:"violations"`
+	}
+	params := map[string]string{"path": ctx.Path, "source": string(ctx.Src)}
+	if err := p.call("check_file", params, &result); err != nil {
+		ctx.c.warnf("plugin %s: %s: %v", p.name, ctx.Path, err)
+		return
+	}
+	for _, v := range result.Violations {
+		rule, ok := p.rules[v.Rule]
+		if !ok {
+			rule = pluginRuleInfo{RuleName: v.Rule, RuleCategory: v.Category}
+		}
+		if rule.RuleCategory == "" {
+			rule.RuleCategory = p.Category()
+		}
+		if !ctx.c.ruleEnabled(rule) {
+			continue
+		}
+		ctx.Report(rule, v)
+	}
+}
+
+// call リクエストを送信し、応答をresultに読み込む
+func (p *processPlugin) call(method string, params, result any) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.nextID++
+	req := rpcRequest{JSONRPC: "2.0", ID: p.nextID, Method: method, Params: params}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if _, err := p.stdin.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	line, err := p.out.ReadBytes('\n')
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	var resp rpcResponse
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.ID != req.ID {
+		return fmt.Errorf("response id %d does not match request id %d", resp.ID, req.ID)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s (code %d)", resp.Error.Message, resp.Error.Code)
+	}
+	if result == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// close shutdownを通知してプロセスの終了を待つ
+func (p *processPlugin) close() error {
+	p.mu.Lock()
+	data, _ := json.Marshal(rpcRequest{JSONRPC: "2.0", Method: "shutdown"})
+	p.stdin.Write(append(data, '\n'))
+	p.stdin.Close()
+	p.mu.Unlock()
+	return p.cmd.Wait()
+}
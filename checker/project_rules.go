@@ -0,0 +1,278 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// プロジェクト固有ルール（project_rules）
+// ========================================
+
+// プロジェクト固有ルールの種類
+const (
+	projectRuleForbiddenImport     = "forbidden_import"     // packagesのimportを禁止する
+	projectRuleRestrictedImport    = "restricted_import"    // packagesのimportをallowed_inのファイル・パッケージに限る
+	projectRuleImportBan           = "import_ban"           // forbidden_importの旧名
+	projectRuleForbiddenCall       = "forbidden_call"       // functionsの呼び出しを禁止する
+	projectRuleForbiddenIdentifier = "forbidden_identifier" // identifiersの参照を禁止する
+	projectRuleRequiredCallIn      = "required_call_in"     // pathsの関数でfunctionsのいずれかの呼び出しを必須とする
+)
+
+// projectRule コンパイル済みのプロジェクト固有ルール
+type projectRule struct {
+	rules.ProjectRule
+	funcPattern *regexp.Regexp // required_call_inの対象の関数名（nilの場合は公開された関数・メソッド）
+}
+
+// compileProjectRules 有効なプロジェクト固有ルールを集める（チェック開始時に1回）
+// 種類が不明なルール・種類に必要な項目が無いルールがあればエラーを返す
+func (c *Checker) compileProjectRules() error {
+	c.projectRules = nil
+	for i, rule := range c.config.ProjectRules {
+		if !rule.Enabled {
+			continue
+		}
+		var required, items string
+		switch rule.Type {
+		case projectRuleForbiddenImport, projectRuleRestrictedImport, projectRuleImportBan:
+			required, items = "packages", strings.Join(rule.Packages, "")
+		case projectRuleForbiddenCall, projectRuleRequiredCallIn:
+			required, items = "functions", strings.Join(rule.Functions, "")
+		case projectRuleForbiddenIdentifier:
+			required, items = "identifiers", strings.Join(rule.Identifiers, "")
+		default:
+			return fmt.Errorf("project_rules[%d] (%s).type: unknown type %q", i, rule.Name, rule.Type)
+		}
+		if items == "" {
+			return fmt.Errorf("project_rules[%d] (%s).%s: required for type %q", i, rule.Name, required, rule.Type)
+		}
+		c.projectRules = append(c.projectRules, projectRule{ProjectRule: rule, funcPattern: c.patterns.ProjectFuncs[i]})
+	}
+	return nil
+}
+
+// checkProjectRules ファイルがプロジェクト固有ルールに違反していないか
+func (c *Checker) checkProjectRules(file *ast.File, filePath string) {
+	c.checkImportRules(file, filePath)
+	for _, rule := range c.projectRules {
+		switch rule.Type {
+		case projectRuleForbiddenCall:
+			c.checkForbiddenCalls(rule, file, filePath)
+		case projectRuleForbiddenIdentifier:
+			c.checkForbiddenIdentifiers(rule, file, filePath)
+		case projectRuleRequiredCallIn:
+			c.checkRequiredCalls(rule, file, filePath)
+		}
+	}
+}
+
+// checkImportRules ファイルのimportがプロジェクト固有ルールで禁止・制限したパッケージでないか
+func (c *Checker) checkImportRules(file *ast.File, filePath string) {
+	for _, imp := range file.Imports {
+		pkg, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		for _, rule := range c.projectRules {
+			if suggestion, ok := c.projectRuleViolation(rule, pkg, filePath); ok {
+				c.reportProjectRule(rule, imp.Pos(), fmt.Sprintf("'%s' はimportできません", pkg), suggestion, filePath)
+			}
+		}
+	}
+}
+
+// projectRuleViolation importがルールに違反するか（違反する場合は修正の提案も返す）
+func (c *Checker) projectRuleViolation(rule projectRule, pkg, filePath string) (suggestion string, ok bool) {
+	matched := matchedPackage(rule.Packages, pkg)
+	if matched == "" {
+		return "", false
+	}
+	if rule.Type == projectRuleRestrictedImport {
+		if c.isAllowedIn(rule.AllowedIn, filePath) {
+			return "", false
+		}
+		return fmt.Sprintf("'%s' は %s でのみimportできます", matched, strings.Join(rule.AllowedIn, ", ")), true
+	}
+	return fmt.Sprintf("'%s' のimportを削除し、代替のパッケージを使用してください", pkg), true
+}
+
+// checkForbiddenCalls functionsの呼び出しを報告する（allowed_inのファイルは対象外）
+func (c *Checker) checkForbiddenCalls(rule projectRule, file *ast.File, filePath string) {
+	symbols := localSymbols(file, rule.Functions)
+	if len(symbols) == 0 || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if spec, ok := symbols[calleeRef(call)]; ok {
+			c.reportProjectRule(rule, call.Pos(), "'"+spec+"' の呼び出しは禁止されています",
+				"'"+spec+"' を呼び出さない実装に変更してください", filePath)
+		}
+		return true
+	})
+}
+
+// checkForbiddenIdentifiers identifiersの参照を報告する（allowed_inのファイルは対象外）
+func (c *Checker) checkForbiddenIdentifiers(rule projectRule, file *ast.File, filePath string) {
+	symbols := localSymbols(file, rule.Identifiers)
+	if len(symbols) == 0 || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		var ref string
+		switch n := n.(type) {
+		case *ast.ImportSpec:
+			return false
+		case *ast.SelectorExpr:
+			if ref = symbolRef(n); ref == "" {
+				// フィールド・メソッドの名前は照合せず、レシーバ側のみを辿る
+				ast.Inspect(n.X, visit)
+				return false
+			}
+		case *ast.Ident:
+			ref = n.Name
+		default:
+			return true
+		}
+		if spec, ok := symbols[ref]; ok {
+			c.reportProjectRule(rule, n.Pos(), "'"+spec+"' の使用は禁止されています",
+				"'"+spec+"' を使用しない実装に変更してください", filePath)
+			return false
+		}
+		_, isSelector := n.(*ast.SelectorExpr)
+		return !isSelector
+	}
+	ast.Inspect(file, visit)
+}
+
+// checkRequiredCalls pathsのファイルの対象の関数が、functionsのいずれも呼び出していなければ報告する
+func (c *Checker) checkRequiredCalls(rule projectRule, file *ast.File, filePath string) {
+	if len(rule.Paths) > 0 && !c.isAllowedIn(rule.Paths, filePath) || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	symbols := localSymbols(file, rule.Functions)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !rule.requiresCall(fn) || callsAny(fn.Body, symbols) {
+			continue
+		}
+		name := fn.Name.Name
+		if fn.Recv != nil {
+			name = receiverTypeName(fn) + "." + name
+		}
+		c.reportProjectRule(rule, fn.Name.Pos(),
+			"関数 '"+name+"' で "+strings.Join(rule.Functions, ", ")+" のいずれも呼び出していません",
+			"'"+name+"' の中で "+strings.Join(rule.Functions, ", ")+" のいずれかを呼び出してください", filePath)
+	}
+}
+
+// requiresCall required_call_inで呼び出しを必須とする関数か（func_patternが無ければ公開された関数・メソッド）
+func (r projectRule) requiresCall(fn *ast.FuncDecl) bool {
+	if r.funcPattern != nil {
+		return r.funcPattern.MatchString(fn.Name.Name)
+	}
+	return fn.Name.IsExported()
+}
+
+// callsAny 本体（関数リテラルを含む）でsymbolsのいずれかを呼び出しているか
+func callsAny(body *ast.BlockStmt, symbols map[string]string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && !found {
+			_, found = symbols[calleeRef(call)]
+		}
+		return !found
+	})
+	return found
+}
+
+// localSymbols 関数・識別子の指定（importパス.名前 または 名前）をファイル内での参照の形式→指定のマップにする
+// importしていないパッケージ・ドットimport・ブランクimportの指定は含まない
+func localSymbols(file *ast.File, specs []string) map[string]string {
+	symbols := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		pkg, name := splitSymbol(spec)
+		if pkg == "" {
+			symbols[name] = spec
+			continue
+		}
+		if local := importName(file, pkg); local != "" && local != "_" && local != "." {
+			symbols[local+"."+name] = spec
+		}
+	}
+	return symbols
+}
+
+// splitSymbol importパス.名前 をimportパスと名前に分ける（importパスが無い場合は空）
+func splitSymbol(spec string) (pkg, name string) {
+	dot := strings.LastIndex(spec, ".")
+	if dot <= strings.LastIndex(spec, "/") {
+		return "", spec
+	}
+	return spec[:dot], spec[dot+1:]
+}
+
+// calleeRef 呼び出す関数の参照（パッケージ名.名前 または 名前、ローカル変数・メソッドの呼び出しは空）
+func calleeRef(call *ast.CallExpr) string {
+	if ident, ok := call.Fun.(*ast.Ident); ok {
+		if ident.Obj != nil && ident.Obj.Kind != ast.Fun {
+			return ""
+		}
+		return ident.Name
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		return symbolRef(sel)
+	}
+	return ""
+}
+
+// symbolRef パッケージの識別子の参照（パッケージ名.名前、パッケージの参照でなければ空）
+// パッケージ名と同じ名前のローカル変数のフィールド・メソッドは、宣言が解決されるため含まない
+func symbolRef(sel *ast.SelectorExpr) string {
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || x.Obj != nil {
+		return ""
+	}
+	return x.Name + "." + sel.Sel.Name
+}
+
+// reportProjectRule プロジェクト固有ルールの違反を報告する（ルールにmessageがあればそちらを使う）
+func (c *Checker) reportProjectRule(rule projectRule, at token.Pos, message, suggestion, filePath string) {
+	if rule.Message != "" {
+		message = rule.Message
+	}
+	pos := c.fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule.Name,
+		Category:   "custom",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// matchedPackage importパスに一致するpackagesの要素（パッケージ自体またはそのサブパッケージ、無ければ空）
+func matchedPackage(packages []string, importPath string) string {
+	for _, pkg := range packages {
+		if importPath == pkg || strings.HasPrefix(importPath, pkg+"/") {
+			return pkg
+		}
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+package checker
+
+import "testing"
+
+func TestForbiddenImport(t *testing.T) {
+	const config = `
+project_rules:
+  - name: "no_deprecated_package"
+    enabled: true
+    severity: "error"
+    type: "forbidden_import"
+    packages: ["io/ioutil", "github.com/pkg/errors"]
+`
+	runRuleTests(t, config, "no_deprecated_package", []ruleTest{
+		{
+			name: "forbidden package and subpackage",
+			files: map[string]string{"a.go": `package p
+
+import (
+	_ "github.com/pkg/errors/internal"
+	_ "io/ioutil"
+)
+`},
+			want: 2,
+		},
+		{
+			name: "replacement packages",
+			files: map[string]string{"a.go": `package p
+
+import (
+	_ "errors"
+	_ "io"
+	_ "os"
+)
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestRestrictedImport(t *testing.T) {
+	const config = `
+project_rules:
+  - name: "db_driver_in_repository"
+    enabled: true
+    severity: "warning"
+    type: "restricted_import"
+    packages: ["database/sql"]
+    allowed_in: ["internal/repository/**"]
+`
+	runRuleTests(t, config, "db_driver_in_repository", []ruleTest{
+		{
+			name:  "imported outside repository",
+			files: map[string]string{"internal/handler/user.go": "package handler\n\nimport _ \"database/sql\"\n"},
+			want:  1,
+		},
+		{
+			name:  "imported in repository",
+			files: map[string]string{"internal/repository/user.go": "package repository\n\nimport _ \"database/sql\"\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestForbiddenCall(t *testing.T) {
+	const config = `
+project_rules:
+  - name: "no_sleep_or_exit"
+    enabled: true
+    severity: "warning"
+    type: "forbidden_call"
+    functions: ["time.Sleep", "os.Exit"]
+    allowed_in: ["cmd/**"]
+`
+	runRuleTests(t, config, "no_sleep_or_exit", []ruleTest{
+		{
+			name: "calls with renamed import and across lines",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"os"
+	t "time"
+)
+
+func f() {
+	t.Sleep(
+		t.Second,
+	)
+	os.Exit(1)
+}
+`},
+			want: 2,
+		},
+		{
+			name: "strings, comments, other functions and allowed path",
+			files: map[string]string{
+				"a.go": `package p
+
+import "time"
+
+// time.Sleep is not used here
+func f() string {
+	_ = time.After(time.Second)
+	return "os.Exit(1)"
+}
+`,
+				"cmd/tool/main.go": "package main\n\nimport \"os\"\n\nfunc main() { os.Exit(1) }\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestForbiddenIdentifier(t *testing.T) {
+	const config = `
+project_rules:
+  - name: "no_context_todo"
+    enabled: true
+    severity: "warning"
+    type: "forbidden_identifier"
+    identifiers: ["context.TODO"]
+`
+	runRuleTests(t, config, "no_context_todo", []ruleTest{
+		{
+			name: "called and referenced",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+var newContext = context.TODO
+
+func f() context.Context { return context.TODO() }
+`},
+			want: 2,
+		},
+		{
+			name: "other identifiers",
+			files: map[string]string{"a.go": `package p
+
+import "context"
+
+func f() context.Context { return context.Background() }
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestRequiredCallIn(t *testing.T) {
+	const config = `
+project_rules:
+  - name: "handler_validates_input"
+    enabled: true
+    severity: "warning"
+    type: "required_call_in"
+    functions: ["validateRequest"]
+    paths: ["internal/handler/**"]
+    func_pattern: "^(Create|Update)"
+`
+	runRuleTests(t, config, "handler_validates_input", []ruleTest{
+		{
+			name: "create without validation",
+			files: map[string]string{"internal/handler/user.go": `package handler
+
+func validateRequest(v any) error { return nil }
+
+func CreateUser(name string) error { return nil }
+`},
+			want: 1,
+		},
+		{
+			name: "validated and functions outside pattern",
+			files: map[string]string{"internal/handler/user.go": `package handler
+
+func validateRequest(v any) error { return nil }
+
+func CreateUser(name string) error { return validateRequest(name) }
+
+func GetUser(id string) error { return nil }
+`},
+			want: 0,
+		},
+	})
+}
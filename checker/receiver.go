@@ -0,0 +1,218 @@
+package checker
+
+import (
+	"go/ast"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// メソッドのレシーバ（名前・ポインタレシーバの統一）
+// ========================================
+
+// defaultReceiverNameLength レシーバ名の最大文字数の既定値
+const defaultReceiverNameLength = 2
+
+// methodDecl レシーバのルールで検査するメソッドの宣言
+type methodDecl struct {
+	path     string
+	fn       *ast.FuncDecl
+	typeName string // レシーバの型名（ポインタ・型パラメータを除く）
+	recv     string // レシーバ名（省略した場合は空）
+	pointer  bool   // ポインタレシーバか
+	test     bool   // *_test.goのメソッドか
+}
+
+// loadTypeDecls チェック対象の本番コードのメソッド・インタフェースと、同じディレクトリの *_test.go のメソッドを読み込む（初回のみ）
+func (ctx *ProjectContext) loadTypeDecls() {
+	if ctx.methods != nil {
+		return
+	}
+	ctx.methods = []methodDecl{}
+	for _, path := range ctx.Files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		if fctx := ctx.File(path); fctx != nil {
+			ctx.addTypeDecls(fctx, false)
+		}
+	}
+	for _, pkg := range ctx.testPackages() {
+		for _, fctx := range pkg.files {
+			ctx.addTypeDecls(fctx, true)
+		}
+	}
+}
+
+// addTypeDecls ファイルのメソッド・インタフェース（本番コードのみ）の宣言を加える
+func (ctx *ProjectContext) addTypeDecls(fctx *FileContext, test bool) {
+	for _, decl := range fctx.File.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if receiverTypeName(decl) != "" {
+				m := newMethodDecl(fctx.Path, decl)
+				m.test = test
+				ctx.methods = append(ctx.methods, m)
+			}
+		case *ast.GenDecl:
+			if !test {
+				ctx.interfaces = append(ctx.interfaces, interfaceDecls(fctx.Path, decl)...)
+			}
+		}
+	}
+}
+
+// methodDecls チェック対象の本番コード（*_test.go以外）のメソッド（ファイル・宣言の順）
+func (ctx *ProjectContext) methodDecls() []methodDecl {
+	ctx.loadTypeDecls()
+	var methods []methodDecl
+	for _, m := range ctx.methods {
+		if !m.test {
+			methods = append(methods, m)
+		}
+	}
+	return methods
+}
+
+// newMethodDecl メソッドの宣言からレシーバの情報を取り出す
+func newMethodDecl(path string, fn *ast.FuncDecl) methodDecl {
+	field := fn.Recv.List[0]
+	m := methodDecl{path: path, fn: fn, typeName: receiverTypeName(fn)}
+	_, m.pointer = field.Type.(*ast.StarExpr)
+	if len(field.Names) > 0 {
+		m.recv = field.Names[0].Name
+	}
+	return m
+}
+
+// methodsByType メソッドをパッケージ（ディレクトリ）・レシーバの型ごとにまとめる（最初のメソッドの順）
+func methodsByType(methods []methodDecl) [][]methodDecl {
+	var keys []string
+	groups := make(map[string][]methodDecl)
+	for _, m := range methods {
+		key := filepath.Dir(m.path) + "\x00" + m.typeName
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], m)
+	}
+	result := make([][]methodDecl, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, groups[key])
+	}
+	return result
+}
+
+// checkReceiverNames レシーバ名が短く（max_length以内）、this・self等でなく、型のメソッドで統一されているか
+func (c *Checker) checkReceiverNames(ctx *ProjectContext) {
+	rule := c.config.Naming.Rules.ReceiverName
+	maxLength := rule.MaxLength
+	if maxLength <= 0 {
+		maxLength = defaultReceiverNameLength
+	}
+	for _, methods := range methodsByType(ctx.methodDecls()) {
+		expected := expectedReceiverName(methods, rule.Forbidden, maxLength)
+		for _, m := range methods {
+			if problem := receiverNameProblem(m, expected, rule.Forbidden, maxLength); problem != "" {
+				c.reportReceiver(m, "receiver_name", rule.Severity,
+					"メソッド '"+m.fn.Name.Name+"' の"+problem, "レシーバ名を '"+expected+"' にしてください")
+			}
+		}
+	}
+}
+
+// expectedReceiverName 型のメソッドで使うべきレシーバ名
+// 規則を満たす名前のうち最も多く使われている名前（同数の場合は先に現れた名前）、無ければ型名の先頭の文字を小文字にした名前
+func expectedReceiverName(methods []methodDecl, forbidden []string, maxLength int) string {
+	counts := make(map[string]int)
+	expected := ""
+	for _, m := range methods {
+		if !validReceiverName(m.recv, forbidden, maxLength) {
+			continue
+		}
+		counts[m.recv]++
+		if counts[m.recv] > counts[expected] {
+			expected = m.recv
+		}
+	}
+	if expected != "" {
+		return expected
+	}
+	r, _ := utf8.DecodeRuneInString(methods[0].typeName)
+	return strings.ToLower(string(r))
+}
+
+// validReceiverName レシーバ名が規則（max_length以内・forbidden以外）を満たすか
+func validReceiverName(name string, forbidden []string, maxLength int) bool {
+	return name != "" && name != "_" && utf8.RuneCountInString(name) <= maxLength && !containsString(forbidden, name)
+}
+
+// receiverNameProblem レシーバ名の問題（無ければ空。省略したレシーバ・ブランク識別子は対象外）
+func receiverNameProblem(m methodDecl, expected string, forbidden []string, maxLength int) string {
+	switch {
+	case m.recv == "" || m.recv == "_":
+		return ""
+	case containsString(forbidden, m.recv):
+		return "レシーバ名に '" + m.recv + "' は使用しないでください"
+	case utf8.RuneCountInString(m.recv) > maxLength:
+		return "レシーバ名 '" + m.recv + "' が長すぎます（上限: " + strconv.Itoa(maxLength) + "文字）"
+	case m.recv != expected:
+		return "レシーバ名 '" + m.recv + "' が型 '" + m.typeName + "' の他のメソッド（'" + expected + "'）と異なります"
+	}
+	return ""
+}
+
+// checkReceiverTypes 型のメソッドでポインタレシーバ・値レシーバが混在していないか
+// 多い方（同数の場合はポインタレシーバ）に合わせ、少ない方のメソッドを報告する
+func (c *Checker) checkReceiverTypes(ctx *ProjectContext) {
+	rule := c.config.Naming.Rules.ReceiverType
+	for _, methods := range methodsByType(ctx.methodDecls()) {
+		pointers := 0
+		for _, m := range methods {
+			if m.pointer {
+				pointers++
+			}
+		}
+		if pointers == 0 || pointers == len(methods) {
+			continue
+		}
+		usePointer := pointers*2 >= len(methods)
+		for _, m := range methods {
+			if m.pointer != usePointer {
+				c.reportReceiverType(m, usePointer, rule.Severity)
+			}
+		}
+	}
+}
+
+// reportReceiverType ポインタレシーバ・値レシーバの混在を報告する
+func (c *Checker) reportReceiverType(m methodDecl, usePointer bool, severity string) {
+	kind, expected := "値レシーバ", "*"+m.typeName
+	if !usePointer {
+		kind, expected = "ポインタレシーバ", m.typeName
+	}
+	c.reportReceiver(m, "receiver_type", severity,
+		"メソッド '"+m.fn.Name.Name+"' は"+kind+"です（型 '"+m.typeName+"' の他のメソッドと統一してください）",
+		"レシーバの型を '"+expected+"' にしてください")
+}
+
+// reportReceiver レシーバのルールの違反をメソッドのレシーバの位置に報告する
+func (c *Checker) reportReceiver(m methodDecl, rule, severity, message, suggestion string) {
+	pos := c.fset.Position(m.fn.Recv.List[0].Pos())
+	c.report.AddViolation(report.Violation{
+		File:       m.path,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "naming",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(m.path, pos.Line),
+		Suggestion: suggestion,
+	})
+}
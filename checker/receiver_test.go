@@ -0,0 +1,87 @@
+package checker
+
+import "testing"
+
+func TestReceiverName(t *testing.T) {
+	const config = `
+naming:
+  enabled: true
+  rules:
+    receiver_name:
+      enabled: true
+      severity: "warning"
+      max_length: 2
+      forbidden: ["this", "self"]
+`
+	runRuleTests(t, config, "receiver_name", []ruleTest{
+		{
+			name: "forbidden, long and inconsistent names",
+			files: map[string]string{"a.go": `package p
+
+type Server struct{}
+
+func (this *Server) Start() {}
+
+func (s *Server) Stop() {}
+
+type Client struct{}
+
+func (client *Client) Get() {}
+`},
+			want: 2,
+		},
+		{
+			name: "short consistent names",
+			files: map[string]string{"a.go": `package p
+
+type Server struct{}
+
+func (s *Server) Start() {}
+
+func (s *Server) Stop() {}
+
+type Client struct{}
+
+func (c *Client) Get() {}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestReceiverType(t *testing.T) {
+	const config = `
+naming:
+  enabled: true
+  rules:
+    receiver_type:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "receiver_type", []ruleTest{
+		{
+			name: "pointer and value receivers mixed",
+			files: map[string]string{"a.go": `package p
+
+type Server struct{ addr string }
+
+func (s *Server) Start() {}
+
+func (s Server) Addr() string { return s.addr }
+`},
+			want: 1,
+		},
+		{
+			name: "pointer receivers only",
+			files: map[string]string{"a.go": `package p
+
+type Server struct{ addr string }
+
+func (s *Server) Start() {}
+
+func (s *Server) Addr() string { return s.addr }
+`},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package checker
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// ルールインタフェースとレジストリ
+// ========================================
+
+// Rule チェックルールの共通インタフェース
+//
+// ルールは以下のいずれか（複数可）を実装する
+//   - FileRule:    ファイル単位で検査する
+//   - NodeRule:    ASTノード単位で検査する（全ルールで1回の走査を共有する）
+//   - ProjectRule: 全ファイルの検査後にプロジェクト単位で検査する
+//
+// 有効/無効・重要度・メッセージは Category() と Name() をキーに設定ファイルから解決する
+// （組み込みルールは各カテゴリの rules、それ以外は rule_settings）
+type Rule interface {
+	Name() string
+	Category() string
+}
+
+// FileRule ファイル単位のルール
+type FileRule interface {
+	Rule
+	CheckFile(ctx *FileContext)
+}
+
+// NodeRule ASTノード単位のルール
+type NodeRule interface {
+	Rule
+	CheckNode(ctx *FileContext, node ast.Node)
+}
+
+// ProjectRule プロジェクト単位のルール
+type ProjectRule interface {
+	Rule
+	CheckProject(ctx *ProjectContext)
+}
+
+// FileContext ファイル単位の検査に渡す情報
+type FileContext struct {
+	Path   string         // ファイルパス
+	File   *ast.File      // AST
+	Fset   *token.FileSet // 位置情報
+	Src    []byte         // ソース
+	Lines  []string       // 行単位の内容
+	Info   *types.Info    // 型情報（-typed モード時のみ、それ以外はnil）
+	Config *rules.Config  // 設定
+
+	c *Checker
+}
+
+// Report 違反を報告する（File・Rule・Category・Code・Severity・Messageは未設定であれば補完する）
+func (ctx *FileContext) Report(rule Rule, v report.Violation) {
+	if v.File == "" {
+		v.File = ctx.Path
+	}
+	if v.Code == "" && v.Line > 0 {
+		v.Code = ctx.c.getCodeLine(ctx.Path, v.Line)
+	}
+	ctx.c.addRuleViolation(rule, v)
+}
+
+// ProjectContext プロジェクト単位の検査に渡す情報
+type ProjectContext struct {
+	Root       string        // チェック対象のルートディレクトリ
+	ModulePath string        // go.modのモジュールパス（無ければ空）
+	Files      []string      // チェック対象のGoファイル
+	Config     *rules.Config // 設定
+
+	c          *Checker
+	tests      []testPackage   // 解析済みのテストファイル（testingカテゴリのルールで共有、初回の参照時に読み込む）
+	methods    []methodDecl    // メソッド（レシーバ・インタフェースのルールで共有、初回の参照時に読み込む）
+	interfaces []interfaceDecl // 本番コードのインタフェース（methodsと同時に読み込む）
+}
+
+// File ファイルのコンテキストを返す（解析できなかったファイルはnil）
+// チェック済みのファイルの内容は解放しているため、呼び出すたびに読み込み・解析する
+func (ctx *ProjectContext) File(path string) *FileContext {
+	c := ctx.c
+	c.mu.Lock()
+	file := c.astMap[path]
+	c.mu.Unlock()
+
+	src, lines, err := c.readFile(path)
+	if err != nil {
+		return nil
+	}
+	if file == nil {
+		if file, err = parser.ParseFile(c.fset, path, src, parser.ParseComments); err != nil {
+			return nil
+		}
+	}
+	return &FileContext{
+		Path:   path,
+		File:   file,
+		Fset:   c.fset,
+		Src:    src,
+		Lines:  lines,
+		Info:   c.info,
+		Config: c.config,
+		c:      c,
+	}
+}
+
+// Report 違反を報告する（Rule・Category・Severity・Messageは未設定であれば補完する）
+func (ctx *ProjectContext) Report(rule Rule, v report.Violation) {
+	ctx.c.addRuleViolation(rule, v)
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []Rule
+)
+
+// Register すべてのCheckerで使用するルールを登録（組み込み先のinit()から呼び出す）
+func Register(rule Rule) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry = append(registry, rule)
+}
+
+// RegisteredRules 組み込みルールと登録済みルールの一覧
+func RegisteredRules() []Rule {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	all := builtinRules()
+	return append(all, registry...)
+}
+
+// AddRule このCheckerのみで使用するルールを追加
+func (c *Checker) AddRule(rule Rule) {
+	c.extraRules = append(c.extraRules, rule)
+}
+
+// ruleSet 有効なルールを種類別に保持
+type ruleSet struct {
+	file    []FileRule
+	node    []NodeRule
+	project []ProjectRule
+}
+
+// resolveRules 設定で有効なルールを種類別に振り分ける
+func (c *Checker) resolveRules() ruleSet {
+	var set ruleSet
+	for _, rule := range append(append(RegisteredRules(), c.extraRules...), c.pluginRules...) {
+		if !c.ruleEnabled(rule) {
+			continue
+		}
+		if r, ok := rule.(*builtinRule); ok {
+			// 組み込みルールは該当するフックがある種類のみに登録する
+			if r.file != nil {
+				set.file = append(set.file, r)
+			}
+			if r.hasNodeHook() {
+				set.node = append(set.node, r)
+			}
+			if r.project != nil {
+				set.project = append(set.project, r)
+			}
+			continue
+		}
+		if r, ok := rule.(FileRule); ok {
+			set.file = append(set.file, r)
+		}
+		if r, ok := rule.(NodeRule); ok {
+			set.node = append(set.node, r)
+		}
+		if r, ok := rule.(ProjectRule); ok {
+			set.project = append(set.project, r)
+		}
+	}
+	return set
+}
+
+// ruleEnabled ルールが有効か（設定に無いルールは有効とみなす）
+func (c *Checker) ruleEnabled(rule Rule) bool {
+	if r, ok := rule.(*builtinRule); ok && r.enabled != nil {
+		return r.enabled(c.config)
+	}
+	setting, found := c.config.RuleSetting(rule.Category(), rule.Name())
+	return !found || setting.Enabled
+}
+
+// addRuleViolation ルールの設定で補完して違反を追加
+func (c *Checker) addRuleViolation(rule Rule, v report.Violation) {
+	setting, _ := c.config.RuleSetting(rule.Category(), rule.Name())
+	if v.Rule == "" {
+		v.Rule = rule.Name()
+	}
+	if v.Category == "" {
+		v.Category = rule.Category()
+	}
+	if v.Severity == "" {
+		v.Severity = rules.SeverityWarning
+		if setting.Severity != "" {
+			v.Severity = rules.ParseSeverity(setting.Severity)
+		}
+	}
+	if v.Message == "" {
+		v.Message = setting.Message
+	}
+	c.report.AddViolation(v)
+}
+
+// fileContext ファイル単位の検査に渡すコンテキストを作成
+func (c *Checker) fileContext(filePath string, file *ast.File) *FileContext {
+	c.mu.Lock()
+	src, lines := c.srcMap[filePath], c.fileMap[filePath]
+	c.mu.Unlock()
+	return &FileContext{
+		Path:   filePath,
+		File:   file,
+		Fset:   c.fset,
+		Src:    src,
+		Lines:  lines,
+		Info:   c.info,
+		Config: c.config,
+		c:      c,
+	}
+}
@@ -0,0 +1,348 @@
+package checker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// ハードコードされた秘密情報（既知の形式・名前・エントロピー）
+// ========================================
+
+// defaultSecretVarNames ハードコードを疑う識別子名
+var defaultSecretVarNames = []string{
+	"password", "passwd", "secret", "apikey", "token", "privatekey",
+	"accesskey", "credential", "clientsecret", "dsn",
+}
+
+// secretFormat 既知の鍵・トークン形式
+type secretFormat struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// secretFormats 名前に関わらず検出する既知の鍵・トークン形式
+var secretFormats = []secretFormat{
+	{"AWSアクセスキー", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"JWT", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{"PEM秘密鍵", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"GitHubトークン", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{"GitHubトークン", regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`)},
+	{"Slackトークン", regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{"Google APIキー", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"Stripeシークレットキー", regexp.MustCompile(`\b[sr]k_live_[0-9A-Za-z]{24,}\b`)},
+}
+
+// placeholderWords 設定例・ダミー値とみなす語（アルファベット・数字の並びを含む）
+var placeholderWords = []string{"example", "changeme", "dummy", "xxxx", "placeholder", "your", "${", "{{", "<", "abcdefgh", "01234567"}
+
+// tokenValue 鍵・トークンで使う文字（英数字と+/=_-）のみで構成された値
+var tokenValue = regexp.MustCompile(`^[A-Za-z0-9+/=_-]+$`)
+
+// checkHardcodedSecrets 秘密情報らしい名前への文字列リテラル代入、既知の鍵形式、高エントロピーな文字列を検出
+func (c *Checker) checkHardcodedSecrets(file *ast.File, filePath string) {
+	rule := c.config.Security.Rules.HardcodedSecrets
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	names := rule.Patterns
+	if len(names) == 0 {
+		names = defaultSecretVarNames
+	}
+
+	reported := make(map[token.Pos]bool)
+	checkNamed := func(name string, value ast.Expr) {
+		lit, ok := value.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING || !isSensitiveName(name, names) {
+			return
+		}
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil || !c.looksLikeSecret(s) {
+			return
+		}
+		reported[lit.Pos()] = true
+		c.reportHardcodedSecret(lit, s, filePath, fmt.Sprintf("'%s' に認証情報らしき文字列がハードコードされています", name))
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ImportSpec:
+			return false
+		case *ast.ValueSpec:
+			for i, ident := range node.Names {
+				if i < len(node.Values) {
+					checkNamed(ident.Name, node.Values[i])
+				}
+			}
+		case *ast.AssignStmt:
+			if len(node.Lhs) == len(node.Rhs) {
+				for i, lhs := range node.Lhs {
+					checkNamed(assignedName(lhs), node.Rhs[i])
+				}
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := node.Key.(*ast.Ident); ok {
+				checkNamed(key.Name, node.Value)
+			} else if key := stringLit([]ast.Expr{node.Key}, 0); key != nil {
+				name, _ := strconv.Unquote(key.Value)
+				checkNamed(name, node.Value)
+			}
+		case *ast.BasicLit:
+			if node.Kind == token.STRING && !reported[node.Pos()] {
+				c.checkSecretLiteral(node, filePath)
+			}
+		}
+		return true
+	})
+}
+
+// checkSecretLiteral 文字列リテラルが既知の鍵・トークン形式か、高エントロピーな値か
+func (c *Checker) checkSecretLiteral(lit *ast.BasicLit, filePath string) {
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+	for _, f := range secretFormats {
+		if f.pattern.MatchString(s) {
+			c.reportHardcodedSecret(lit, s, filePath, f.name+"がハードコードされています")
+			return
+		}
+	}
+	if entropy, ok := c.highEntropy(s); ok {
+		c.reportHardcodedSecret(lit, s, filePath, fmt.Sprintf("高エントロピーな文字列（%.2fビット/文字）がハードコードされています", entropy))
+	}
+}
+
+// looksLikeSecret 値が十分な長さとエントロピーを持ち、ダミー値でないか
+func (c *Checker) looksLikeSecret(s string) bool {
+	rule := c.config.Security.Rules.HardcodedSecrets
+	minLength := rule.MinLength
+	if minLength == 0 {
+		minLength = 8
+	}
+	minEntropy := rule.MinEntropy
+	if minEntropy == 0 {
+		minEntropy = 3.0
+	}
+	if len(s) < minLength || strings.ContainsAny(s, " \t\n") || isPlaceholder(s) {
+		return false
+	}
+	// ヘッダー名・キー名のような英字と区切り文字のみの値は除外
+	if wordLikeValue.MatchString(s) {
+		return false
+	}
+	classes := charClasses(s)
+	if classes < 3 && !(len(s) >= 20 && classes >= 2) {
+		return false
+	}
+	return shannonEntropy(s) >= minEntropy
+}
+
+// highEntropy 名前に関わらず報告する高エントロピーな値か（entropy_thresholdが0の場合は調べない）
+// 鍵・トークンで使う文字のみで構成され、英字と数字の両方を含む値を対象にする
+func (c *Checker) highEntropy(s string) (float64, bool) {
+	rule := c.config.Security.Rules.HardcodedSecrets
+	minLength := rule.EntropyMinLength
+	if minLength == 0 {
+		minLength = 20
+	}
+	if rule.EntropyThreshold <= 0 || len(s) < minLength || !tokenValue.MatchString(s) || isPlaceholder(s) {
+		return 0, false
+	}
+	if !strings.ContainsAny(s, "0123456789") || strings.IndexFunc(s, unicode.IsLetter) < 0 {
+		return 0, false
+	}
+	entropy := shannonEntropy(s)
+	return entropy, entropy >= rule.EntropyThreshold
+}
+
+// isPlaceholder 設定例・ダミー値か
+func isPlaceholder(s string) bool {
+	lower := strings.ToLower(s)
+	for _, w := range placeholderWords {
+		if strings.Contains(lower, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordLikeValue 英字と区切り文字のみで構成された値
+var wordLikeValue = regexp.MustCompile(`^[A-Za-z][A-Za-z_.:/-]*$`)
+
+// charClasses 値に含まれる文字種（小文字・大文字・数字・記号）の数
+func charClasses(s string) int {
+	var lower, upper, digit, other bool
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	n := 0
+	for _, b := range []bool{lower, upper, digit, other} {
+		if b {
+			n++
+		}
+	}
+	return n
+}
+
+// shannonEntropy 文字列の1文字あたりのシャノンエントロピー（ビット）
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	entropy := 0.0
+	for _, n := range counts {
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// reportHardcodedSecret ハードコードされた秘密情報を報告
+// メッセージ・コードの行には値を伏せた表記のみを含め、許可リストに一致する値は報告しない
+func (c *Checker) reportHardcodedSecret(lit *ast.BasicLit, value, filePath, message string) {
+	fingerprint := secretFingerprint(value)
+	if c.secretAllow.allows(value, fingerprint) {
+		return
+	}
+	rule := c.config.Security.Rules.HardcodedSecrets
+	suggestion := "環境変数やシークレットマネージャーから取得してください"
+	if rule.AllowlistFile != "" {
+		suggestion += "（誤検出の場合は " + rule.AllowlistFile + " に sha256:" + fingerprint + " を追加してください）"
+	}
+	pos := c.fset.Position(lit.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "hardcoded_secrets",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message + "（値: " + redactSecret(value) + "）",
+		Code:       redactCode(c.getCodeLine(filePath, pos.Line), pos.Column, lit.Value, maskSecret(value)),
+		Suggestion: suggestion,
+	})
+}
+
+// maskSecret 値の先頭（最大4文字）のみを残して伏せた値
+func maskSecret(s string) string {
+	runes := []rune(s)
+	return string(runes[:min(4, len(runes)/4)]) + "****"
+}
+
+// redactSecret 値を伏せた表記（例: AKIA****（20文字））
+func redactSecret(s string) string {
+	return maskSecret(s) + "（" + strconv.Itoa(len([]rune(s))) + "文字）"
+}
+
+// redactCode コードの行のcolumn（1始まりのバイト位置）から始まるリテラルlitを伏せた値に置き換える
+// 複数行のリテラルは行末までを置き換える
+func redactCode(line string, column int, lit, masked string) string {
+	start := column - 1
+	if start < 0 || start > len(line) {
+		return line
+	}
+	end := start + len(lit)
+	if i := strings.IndexByte(lit, '\n'); i >= 0 {
+		end = len(line)
+	}
+	return line[:start] + strconv.Quote(masked) + line[min(end, len(line)):]
+}
+
+// secretFingerprint 許可リストで値を指定する指紋（SHA-256の先頭16桁）
+func secretFingerprint(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// secretAllowlist 誤検出として許可する値（hardcoded_secretsのallowlist_file）
+type secretAllowlist struct {
+	fingerprints map[string]bool  // 値の指紋（secretFingerprint）
+	patterns     []*regexp.Regexp // 値の正規表現
+	data         []byte           // ファイルの内容（キャッシュのキーに含める）
+}
+
+// allows 値が許可リストに一致するか（nilの場合は許可しない）
+func (l *secretAllowlist) allows(value, fingerprint string) bool {
+	if l == nil {
+		return false
+	}
+	if l.fingerprints[fingerprint] {
+		return true
+	}
+	for _, re := range l.patterns {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSecretAllowlist allowlist_file（相対パスはチェック対象のルートから）を読み込む（ファイルが無い場合は許可しない）
+// 1行に1件、sha256:<指紋>（違反の提案に表示される指紋、完全なSHA-256も可）または regex:<値の正規表現> を記述し、#で始まる行はコメント
+func (c *Checker) loadSecretAllowlist(root string) error {
+	c.secretAllow = nil
+	name := c.config.Security.Rules.HardcodedSecrets.AllowlistFile
+	if name == "" {
+		return nil
+	}
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(root, name)
+	}
+	data, err := c.readSource(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	list := &secretAllowlist{fingerprints: make(map[string]bool), data: data}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kind, value, _ := strings.Cut(line, ":")
+		switch kind {
+		case "sha256":
+			fingerprint, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(value)), " ")
+			list.fingerprints[fingerprint[:min(16, len(fingerprint))]] = true
+		case "regex":
+			re, err := regexp.Compile(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%s:%d: %w", name, i+1, err)
+			}
+			list.patterns = append(list.patterns, re)
+		default:
+			return fmt.Errorf("%s:%d: sha256: または regex: で始めてください", name, i+1)
+		}
+	}
+	c.secretAllow = list
+	return nil
+}
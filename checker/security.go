@@ -0,0 +1,770 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// セキュリティチェック
+// ========================================
+
+// defaultSQLMethods クエリ文字列を受け取るメソッド（database/sql, sqlx, gorm）
+var defaultSQLMethods = []string{
+	"Query", "QueryContext", "QueryRow", "QueryRowContext",
+	"Exec", "ExecContext", "Prepare", "PrepareContext",
+	"Get", "GetContext", "Select", "SelectContext", "Raw",
+}
+
+// checkSQLInjection 文字列連結・fmt.Sprintfで組み立てたクエリの実行を検出
+func (c *Checker) checkSQLInjection(call *ast.CallExpr, filePath string) {
+	rule := c.config.Security.Rules.SQLInjection
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	methods := rule.Methods
+	if len(methods) == 0 {
+		methods = defaultSQLMethods
+	}
+	if !containsString(methods, sel.Sel.Name) {
+		return
+	}
+
+	query := c.queryArg(call)
+	if query == nil {
+		return
+	}
+	how := c.dynamicStringKind(query)
+	if how == "" {
+		return
+	}
+
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "sql_injection",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("%sに渡すクエリが%sで組み立てられています", sel.Sel.Name, how),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "プレースホルダ（? / $1）とパラメータ、またはプリペアドステートメントを使用してください",
+	})
+}
+
+// queryArg クエリ文字列の引数（contextを除く最初の引数）
+func (c *Checker) queryArg(call *ast.CallExpr) ast.Expr {
+	for _, arg := range call.Args {
+		if c.isContextValue(arg) {
+			continue
+		}
+		return arg
+	}
+	return nil
+}
+
+// dynamicStringKind 式が変数を含む文字列連結・fmt.Sprintfで組み立てられていれば、その方法を返す
+// 同じ関数内で連結により組み立てられたローカル変数も対象とする
+func (c *Checker) dynamicStringKind(expr ast.Expr) string {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD && !isConstString(e) {
+			return "文字列連結"
+		}
+	case *ast.CallExpr:
+		callStr := c.getCallExprString(e)
+		if (callStr == "fmt.Sprintf" || callStr == "strings.Join") && len(e.Args) > 1 {
+			return callStr
+		}
+	case *ast.Ident:
+		return c.dynamicIdentKind(e)
+	}
+	return ""
+}
+
+// dynamicIdentKind ローカル変数の代入（:= / = / +=）に動的な文字列組み立てがあるか
+func (c *Checker) dynamicIdentKind(ident *ast.Ident) string {
+	fn := c.enclosingFunc(ident.Pos())
+	if fn == nil || fn.Body == nil || ident.Obj == nil {
+		return ""
+	}
+	kind := ""
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || kind != "" || len(as.Lhs) != len(as.Rhs) {
+			return kind == ""
+		}
+		for i, lhs := range as.Lhs {
+			l, ok := lhs.(*ast.Ident)
+			if !ok || l.Obj != ident.Obj {
+				continue
+			}
+			if as.Tok == token.ADD_ASSIGN && !isConstString(as.Rhs[i]) {
+				kind = "文字列連結"
+			} else if _, isIdent := as.Rhs[i].(*ast.Ident); !isIdent {
+				kind = c.dynamicStringKind(as.Rhs[i])
+			}
+		}
+		return kind == ""
+	})
+	return kind
+}
+
+// isConstString 式が文字列リテラルのみで構成されているか
+func isConstString(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.STRING
+	case *ast.BinaryExpr:
+		return e.Op == token.ADD && isConstString(e.X) && isConstString(e.Y)
+	case *ast.Ident:
+		// 定数（const宣言）は連結しても安全
+		return e.Obj != nil && e.Obj.Kind == ast.Con
+	}
+	return false
+}
+
+// shellNames シェル経由でコマンドを実行するプログラム名
+var shellNames = []string{"sh", "bash", "zsh", "ksh", "dash", "cmd", "cmd.exe", "powershell", "pwsh"}
+
+// defaultTaintSources ユーザー入力由来とみなす識別子名のパターン
+var defaultTaintSources = []*regexp.Regexp{
+	regexp.MustCompile(`^(r|req|request)$`), regexp.MustCompile(`(?i)input`), regexp.MustCompile(`(?i)param`),
+	regexp.MustCompile(`(?i)query`), regexp.MustCompile(`(?i)form`), regexp.MustCompile(`(?i)body`),
+	regexp.MustCompile(`(?i)header`), regexp.MustCompile(`(?i)user`), regexp.MustCompile(`^Args$`),
+}
+
+// checkCommandInjection os/execでのシェル経由の実行・ユーザー入力を連結した引数を検出
+func (c *Checker) checkCommandInjection(call *ast.CallExpr, callStr string, filePath string) {
+	if callStr != "exec.Command" && callStr != "exec.CommandContext" {
+		return
+	}
+	argv := call.Args
+	if callStr == "exec.CommandContext" && len(argv) > 0 {
+		argv = argv[1:]
+	}
+	if len(argv) == 0 {
+		return
+	}
+
+	if script, ok := shellScriptArg(argv); ok && !isConstString(script) {
+		c.reportCommandInjection(call, filePath,
+			fmt.Sprintf("%sでシェル経由（-c）に動的なコマンド文字列を渡しています", callStr),
+			"シェルを介さずにプログラムと引数を個別に渡してください（exec.Command(\"prog\", arg1, arg2)）")
+		return
+	}
+
+	sources := c.patterns.TaintSources
+	if len(sources) == 0 {
+		sources = defaultTaintSources
+	}
+	for _, arg := range argv {
+		if c.dynamicStringKind(arg) == "" {
+			continue
+		}
+		if name := taintedIdent(arg, sources); name != "" {
+			c.reportCommandInjection(call, filePath,
+				fmt.Sprintf("%sの引数にユーザー入力（%s）を連結した文字列を渡しています", callStr, name),
+				"入力値は独立した引数として渡し、許可リストで検証してください")
+			return
+		}
+	}
+}
+
+// shellScriptArg argvが「シェル -c スクリプト」の形ならスクリプト引数を返す
+func shellScriptArg(argv []ast.Expr) (ast.Expr, bool) {
+	if len(argv) < 3 {
+		return nil, false
+	}
+	prog := stringLit(argv, 0)
+	flag := stringLit(argv, 1)
+	if prog == nil || flag == nil {
+		return nil, false
+	}
+	name, _ := strconv.Unquote(prog.Value)
+	opt, _ := strconv.Unquote(flag.Value)
+	if !containsString(shellNames, path.Base(name)) {
+		return nil, false
+	}
+	if opt != "-c" && opt != "/c" && opt != "/C" && opt != "-Command" {
+		return nil, false
+	}
+	return argv[2], true
+}
+
+// taintedIdent 式に含まれる識別子のうちテイントソースのパターンに一致する名前を返す
+func taintedIdent(expr ast.Expr, sources []*regexp.Regexp) string {
+	found := ""
+	ast.Inspect(expr, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok || found != "" {
+			return found == ""
+		}
+		for _, pattern := range sources {
+			if pattern.MatchString(ident.Name) {
+				found = ident.Name
+				break
+			}
+		}
+		return found == ""
+	})
+	return found
+}
+
+// reportCommandInjection コマンドインジェクション違反を報告
+func (c *Checker) reportCommandInjection(call *ast.CallExpr, filePath, message, suggestion string) {
+	rule := c.config.Security.Rules.CommandInjection
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "command_injection",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// tlsVersions crypto/tlsのバージョン定数と値
+var tlsVersions = map[string]uint16{
+	"VersionSSL30": 0x0300,
+	"VersionTLS10": 0x0301,
+	"VersionTLS11": 0x0302,
+	"VersionTLS12": 0x0303,
+	"VersionTLS13": 0x0304,
+}
+
+// outboundURLFuncs 外部へのリクエスト先URLを受け取る関数と引数位置
+var outboundURLFuncs = map[string]int{
+	"http.Get":                   0,
+	"http.Head":                  0,
+	"http.Post":                  0,
+	"http.PostForm":              0,
+	"http.NewRequest":            1,
+	"http.NewRequestWithContext": 2,
+}
+
+// urlFieldWords 接続先URLを表すフィールド名に含まれる語
+var urlFieldWords = []string{"url", "endpoint", "baseurl", "addr", "host"}
+
+// checkInsecureTLSConfig tls.Configリテラルの InsecureSkipVerify・MinVersion と接続先URLフィールドを検査
+func (c *Checker) checkInsecureTLSConfig(lit *ast.CompositeLit, filePath string) {
+	isTLSConfig := isSelector(lit.Type, "tls", "Config")
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if isTLSConfig {
+			c.checkTLSField(key.Name, kv.Value, filePath)
+			continue
+		}
+		if isURLField(key.Name) {
+			c.checkPlainHTTP(kv.Value, filePath)
+		}
+	}
+}
+
+// checkInsecureTLSAssign cfg.InsecureSkipVerify = true のような代入を検査
+func (c *Checker) checkInsecureTLSAssign(as *ast.AssignStmt, filePath string) {
+	if len(as.Lhs) != len(as.Rhs) {
+		return
+	}
+	for i, lhs := range as.Lhs {
+		sel, ok := lhs.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		switch sel.Sel.Name {
+		case "InsecureSkipVerify", "MinVersion":
+			c.checkTLSField(sel.Sel.Name, as.Rhs[i], filePath)
+		}
+	}
+}
+
+// checkOutboundURL http.Get等に渡すリクエスト先URLが平文のhttp://でないか
+func (c *Checker) checkOutboundURL(call *ast.CallExpr, callStr string, filePath string) {
+	idx, ok := outboundURLFuncs[callStr]
+	if !ok || idx >= len(call.Args) {
+		return
+	}
+	c.checkPlainHTTP(call.Args[idx], filePath)
+}
+
+// checkTLSField tls.Configのフィールド値を検査
+func (c *Checker) checkTLSField(field string, value ast.Expr, filePath string) {
+	switch field {
+	case "InsecureSkipVerify":
+		if ident, ok := value.(*ast.Ident); ok && ident.Name == "true" {
+			c.reportInsecureTLS(value, filePath,
+				"InsecureSkipVerify: true により証明書の検証が無効化されています",
+				"証明書の検証は無効化せず、必要であればRootCAsに社内CAを追加してください")
+		}
+	case "MinVersion":
+		name, version, ok := tlsVersionOf(value)
+		if ok && version < c.minTLSVersion() {
+			c.reportInsecureTLS(value, filePath,
+				fmt.Sprintf("MinVersionに%sが指定されています", name),
+				fmt.Sprintf("MinVersionはtls.%s以上を指定してください", c.minTLSVersionName()))
+		}
+	}
+}
+
+// checkPlainHTTP 文字列リテラルが平文のhttp://で外部ホストを指していれば報告
+func (c *Checker) checkPlainHTTP(value ast.Expr, filePath string) {
+	lit, ok := value.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil || !strings.HasPrefix(s, "http://") {
+		return
+	}
+	host := strings.TrimPrefix(s, "http://")
+	if i := strings.IndexAny(host, ":/?"); i >= 0 {
+		host = host[:i]
+	}
+	allowed := c.config.Security.Rules.InsecureTLS.AllowedHosts
+	if len(allowed) == 0 {
+		allowed = []string{"localhost", "127.0.0.1", "::1"}
+	}
+	if containsString(allowed, host) {
+		return
+	}
+	c.reportInsecureTLS(value, filePath,
+		fmt.Sprintf("外部への接続先に平文のURL（%s）が指定されています", s),
+		"https:// を使用してください")
+}
+
+// tlsVersionOf tls.VersionTLS10 または数値リテラルからTLSバージョンを取得
+func tlsVersionOf(expr ast.Expr) (string, uint16, bool) {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == "tls" {
+			if v, ok := tlsVersions[e.Sel.Name]; ok {
+				return "tls." + e.Sel.Name, v, true
+			}
+		}
+	case *ast.BasicLit:
+		if e.Kind == token.INT {
+			if v, err := strconv.ParseUint(e.Value, 0, 16); err == nil {
+				return e.Value, uint16(v), true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// minTLSVersion 許容する最小TLSバージョン（設定がなければTLS1.2）
+func (c *Checker) minTLSVersion() uint16 {
+	if v, ok := tlsVersions[c.minTLSVersionName()]; ok {
+		return v
+	}
+	return tlsVersions["VersionTLS12"]
+}
+
+// minTLSVersionName 設定の min_version（"1.2" など）を定数名に変換
+func (c *Checker) minTLSVersionName() string {
+	switch c.config.Security.Rules.InsecureTLS.MinVersion {
+	case "1.3":
+		return "VersionTLS13"
+	default:
+		return "VersionTLS12"
+	}
+}
+
+// isURLField フィールド名が接続先URLを表すか
+func isURLField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, word := range urlFieldWords {
+		if strings.HasSuffix(lower, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportInsecureTLS 安全でない通信設定の違反を報告
+func (c *Checker) reportInsecureTLS(node ast.Node, filePath, message, suggestion string) {
+	rule := c.config.Security.Rules.InsecureTLS
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "insecure_tls",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
+
+// defaultSecretNames 暗号学的に安全な乱数が必要な値を示す名前
+var defaultSecretNames = []string{
+	"token", "secret", "nonce", "session", "password", "salt",
+	"otp", "csrf", "apikey", "privatekey",
+}
+
+// mathRandName ファイルでのmath/randのパッケージ名（importしていなければ空）
+func mathRandName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || (p != "math/rand" && p != "math/rand/v2") {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return "rand"
+	}
+	return ""
+}
+
+// checkWeakRandom トークン・セッションID等の生成にmath/randを使用していないか
+func (c *Checker) checkWeakRandom(fn *ast.FuncDecl, filePath string) {
+	pkg := mathRandName(c.file)
+	if pkg == "" || fn.Body == nil {
+		return
+	}
+	patterns := c.config.Security.Rules.WeakRandom.Patterns
+	if len(patterns) == 0 {
+		patterns = defaultSecretNames
+	}
+
+	reported := make(map[token.Pos]bool)
+	reportCalls := func(node ast.Node, reason string) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if ok && isPkgCall(call, pkg) && !reported[call.Pos()] {
+				reported[call.Pos()] = true
+				c.reportWeakRandom(call, filePath, reason)
+			}
+			return true
+		})
+	}
+
+	if isSensitiveName(fn.Name.Name, patterns) {
+		reportCalls(fn.Body, fmt.Sprintf("関数 '%s'", fn.Name.Name))
+		return
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				name := assignedName(lhs)
+				if name == "" || !isSensitiveName(name, patterns) {
+					continue
+				}
+				if len(node.Lhs) == len(node.Rhs) {
+					reportCalls(node.Rhs[i], fmt.Sprintf("'%s' への代入", name))
+				} else {
+					reportCalls(node, fmt.Sprintf("'%s' への代入", name))
+				}
+			}
+		case *ast.ValueSpec:
+			for i, ident := range node.Names {
+				if !isSensitiveName(ident.Name, patterns) || i >= len(node.Values) {
+					continue
+				}
+				reportCalls(node.Values[i], fmt.Sprintf("'%s' への代入", ident.Name))
+			}
+		}
+		return true
+	})
+}
+
+// isPkgCall 呼び出しが指定パッケージの関数か
+func isPkgCall(call *ast.CallExpr, pkg string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg
+}
+
+// assignedName 代入先の名前（識別子またはフィールド名）
+func assignedName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return assignedName(e.X)
+	}
+	return ""
+}
+
+// reportWeakRandom math/randの使用を報告
+func (c *Checker) reportWeakRandom(call *ast.CallExpr, filePath, reason string) {
+	rule := c.config.Security.Rules.WeakRandom
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "weak_random",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    fmt.Sprintf("%sでmath/randを使用しています（予測可能な乱数）", reason),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "秘密情報・トークンの生成にはcrypto/rand（rand.Read, rand.Text）を使用してください",
+	})
+}
+
+// permFuncs パーミッションを受け取る関数と引数位置
+var permFuncs = map[string]int{
+	"os.OpenFile":      2,
+	"os.WriteFile":     2,
+	"ioutil.WriteFile": 2,
+	"os.Mkdir":         1,
+	"os.MkdirAll":      1,
+	"os.Chmod":         1,
+}
+
+// checkFilePermissions os.OpenFile/os.WriteFile/os.Mkdir/os.Chmod の過剰なパーミッションを検出
+func (c *Checker) checkFilePermissions(call *ast.CallExpr, callStr string, filePath string) {
+	idx, ok := permFuncs[callStr]
+	if !ok || idx >= len(call.Args) {
+		return
+	}
+	rule := c.config.Security.Rules.FilePermissions
+	lit := permLit(call.Args[idx])
+	if lit == nil {
+		return
+	}
+	perm, err := strconv.ParseUint(lit.Value, 0, 32)
+	if err != nil {
+		return
+	}
+
+	maxMode := parseMode(rule.MaxFileMode, 0644)
+	if callStr == "os.Mkdir" || callStr == "os.MkdirAll" || callStr == "os.Chmod" {
+		maxMode = parseMode(rule.MaxDirMode, 0755)
+	}
+	if perm&^maxMode == 0 {
+		return
+	}
+
+	fixed := fmt.Sprintf("0%o", perm&maxMode)
+	message := fmt.Sprintf("%sのパーミッション0%oは上限0%oを超えています", callStr, perm, maxMode)
+	if callStr == "os.Chmod" {
+		message = fmt.Sprintf("os.Chmodでパーミッションを0%oに緩めています（上限0%o）", perm, maxMode)
+	}
+	pos := c.fset.Position(lit.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "file_permissions",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: fmt.Sprintf("必要最小限のパーミッション（%s等）を指定してください", fixed),
+		Fix: &report.Fix{
+			Description: fmt.Sprintf("パーミッションを%sに変更", fixed),
+			Edits:       []report.TextEdit{c.replaceEdit(lit, fixed)},
+		},
+	})
+}
+
+// permLit パーミッション引数の整数リテラル（os.FileMode(0777) のような変換も含む）
+func permLit(expr ast.Expr) *ast.BasicLit {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 1 &&
+		(isSelector(call.Fun, "os", "FileMode") || isSelector(call.Fun, "fs", "FileMode")) {
+		expr = call.Args[0]
+	}
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		return lit
+	}
+	return nil
+}
+
+// parseMode 設定の8進数文字列（"0644"）を解析（不正・未設定ならデフォルト値）
+func parseMode(s string, def uint64) uint64 {
+	if s == "" {
+		return def
+	}
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return def
+	}
+	return mode
+}
+
+// defaultClientFuncs http.DefaultClientを使うパッケージレベルの関数
+var defaultClientFuncs = []string{"Get", "Head", "Post", "PostForm"}
+
+// checkDefaultHTTPClient http.DefaultClient、http.Get等のパッケージ関数、http.DefaultTransportの共有を検出
+// http.DefaultTransport.(*http.Transport).Clone() による複製は許可する
+func (c *Checker) checkDefaultHTTPClient(file *ast.File, filePath string) {
+	httpPkg := importName(file, "net/http")
+	rule := c.config.Security.Rules.DefaultHTTPClient
+	if httpPkg == "" || strings.HasSuffix(filePath, "_test.go") || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+
+	cloned := make(map[ast.Expr]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if ta, ok := sel.X.(*ast.TypeAssertExpr); ok && sel.Sel.Name == "Clone" {
+				cloned[ta.X] = true
+			}
+			if isSelector(sel, httpPkg, sel.Sel.Name) && containsString(defaultClientFuncs, sel.Sel.Name) {
+				c.reportDefaultHTTPClient(n, filePath,
+					fmt.Sprintf("%s.%sはタイムアウトの無いhttp.DefaultClientを使用します", httpPkg, sel.Sel.Name))
+			}
+		case *ast.SelectorExpr:
+			switch {
+			case isSelector(n, httpPkg, "DefaultClient"):
+				c.reportDefaultHTTPClient(n, filePath, "http.DefaultClientを使用しています")
+			case isSelector(n, httpPkg, "DefaultTransport") && !cloned[n]:
+				c.reportDefaultHTTPClient(n, filePath, "http.DefaultTransportを共有しています")
+			}
+		}
+		return true
+	})
+}
+
+func (c *Checker) reportDefaultHTTPClient(node ast.Node, filePath, message string) {
+	rule := c.config.Security.Rules.DefaultHTTPClient
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "default_http_client",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "Timeoutと専用のTransport（http.DefaultTransport.(*http.Transport).Clone()等）を設定した *http.Client を明示的に生成して使用してください",
+	})
+}
+
+// defaultServerTimeouts http.Serverに必須のタイムアウト設定
+var defaultServerTimeouts = []string{"ReadTimeout", "WriteTimeout"}
+
+// defaultOutboundCalls ハンドラ内の外部呼び出し（pkg.Func またはメソッド名）
+var defaultOutboundCalls = []string{"Do", "http.Get", "http.Head", "http.Post", "http.PostForm"}
+
+// checkMissingTimeoutLit Timeoutの無いhttp.Client{}、Read/Writeタイムアウトの無いhttp.Server{}を検出
+func (c *Checker) checkMissingTimeoutLit(lit *ast.CompositeLit, filePath string) {
+	httpPkg := importName(c.file, "net/http")
+	if httpPkg == "" {
+		return
+	}
+	var required []string
+	switch {
+	case isSelector(lit.Type, httpPkg, "Client"):
+		required = []string{"Timeout"}
+	case isSelector(lit.Type, httpPkg, "Server"):
+		required = c.config.Security.Rules.MissingTimeout.ServerTimeouts
+		if len(required) == 0 {
+			required = defaultServerTimeouts
+		}
+	default:
+		return
+	}
+
+	var missing []string
+	for _, field := range required {
+		if !hasKeyedField(lit, field) {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	typeName := c.nodeText(filePath, lit.Type)
+	c.reportMissingTimeout(lit, filePath,
+		fmt.Sprintf("%s{}に%sが設定されていません", typeName, strings.Join(missing, "/")),
+		fmt.Sprintf("%s{%s: 30 * time.Second, ...} のようにタイムアウトを設定してください", typeName, missing[0]))
+}
+
+// hasKeyedField 複合リテラルにキー付きでフィールドが指定されているか
+func hasKeyedField(lit *ast.CompositeLit, name string) bool {
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkHandlerTimeout HTTPハンドラ内でcontext.WithTimeout/WithDeadlineを使わずに外部呼び出しをしていないか（ヒューリスティック）
+func (c *Checker) checkHandlerTimeout(fn *ast.FuncDecl, filePath string) {
+	if fn.Body == nil || httpHandlerWriter(fn.Type) == "" {
+		return
+	}
+	outbound := c.config.Security.Rules.MissingTimeout.OutboundCalls
+	if len(outbound) == 0 {
+		outbound = defaultOutboundCalls
+	}
+
+	var first *ast.CallExpr
+	hasTimeout := false
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch callStr := c.getCallExprString(call); {
+		case callStr == "context.WithTimeout" || callStr == "context.WithDeadline":
+			hasTimeout = true
+		case first == nil && c.matchesHelper(call, outbound):
+			first = call
+		}
+		return true
+	})
+	if first == nil || hasTimeout {
+		return
+	}
+	c.reportMissingTimeout(first, filePath,
+		fmt.Sprintf("ハンドラ '%s' がタイムアウトを設定せずに外部呼び出し（%s）をしています", fn.Name.Name, c.getCallExprString(first)),
+		"ctx, cancel := context.WithTimeout(r.Context(), ...) と defer cancel() でタイムアウトを設定し、ctxを外部呼び出しに渡してください")
+}
+
+func (c *Checker) reportMissingTimeout(node ast.Node, filePath, message, suggestion string) {
+	rule := c.config.Security.Rules.MissingTimeout
+	pos := c.fset.Position(node.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "missing_timeout",
+		Category:   "security",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
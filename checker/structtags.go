@@ -0,0 +1,872 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 構造体タグチェック（json以外のタグ）
+// ========================================
+
+// kebabCaseRe kebab-caseの名前
+var kebabCaseRe = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// lookupTag タグ文字列（バッククォート・ダブルクォートで囲まれたリテラル）からキーの値を取り出す
+func lookupTag(tagValue, key string) (string, bool) {
+	tag, err := strconv.Unquote(tagValue)
+	if err != nil {
+		return "", false
+	}
+	return reflect.StructTag(tag).Lookup(key)
+}
+
+// tagName タグの値のうち名前の部分（gormは column: の値、それ以外は最初の , まで）
+// 名前を持たない場合（"-"、",omitempty" 等）は空
+func tagName(key, value string) string {
+	if key == "gorm" {
+		for _, part := range strings.Split(value, ";") {
+			if name, ok := strings.CutPrefix(strings.TrimSpace(part), "column:"); ok {
+				return name
+			}
+		}
+		return ""
+	}
+	name, _, _ := strings.Cut(value, ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// matchesCase 名前が命名規則に沿っているか（未知の規則は常にtrue）
+func matchesCase(name, style string) bool {
+	switch style {
+	case "snake_case":
+		return isSnakeCase(name)
+	case "camelCase":
+		return isCamelCase(name) && !strings.ContainsAny(name, "_-")
+	case "kebab-case":
+		return kebabCaseRe.MatchString(name)
+	default:
+		return true
+	}
+}
+
+// toCase 名前を命名規則に変換
+func toCase(name, style string) string {
+	switch style {
+	case "camelCase":
+		return toCamelCase(toSnakeCase(name))
+	case "kebab-case":
+		return strings.ReplaceAll(toSnakeCase(name), "_", "-")
+	default:
+		return toSnakeCase(name)
+	}
+}
+
+// checkTagStyles タグのキーごとに設定された命名規則に沿っているか
+func (c *Checker) checkTagStyles(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.TagStyle
+	c.forEachTag(ts, func(tagValue string, pos token.Position) {
+		for _, style := range rule.Tags {
+			value, ok := lookupTag(tagValue, style.Key)
+			if !ok {
+				continue
+			}
+			name := tagName(style.Key, value)
+			if name == "" || matchesCase(name, style.Style) {
+				continue
+			}
+			severity := style.Severity
+			if severity == "" {
+				severity = rule.Severity
+			}
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "tag_style",
+				Category:   "struct_tags",
+				Severity:   rules.ParseSeverity(severity),
+				Message:    fmt.Sprintf("%sタグ '%s' は%sで命名してください", style.Key, name, style.Style),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: fmt.Sprintf("%s → %s", name, toCase(name, style.Style)),
+			})
+		}
+	})
+}
+
+// checkConfigTags 設定構造体（required_forに一致する構造体）の公開フィールドがmapstructure・env等のタグを持つか
+// タグが無いと、設定ライブラリがフィールド名から推測したキーで読み込まれる
+func (c *Checker) checkConfigTags(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.ConfigTag
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil || !matchesAnyPattern(rule.RequiredFor, ts.Name.Name) {
+		return
+	}
+	keys := rule.Tags
+	if len(keys) == 0 {
+		keys = []string{"mapstructure", "env"}
+	}
+
+	// 提案するタグの値（envは環境変数名の慣習に合わせて大文字）
+	suggest := toSnakeCase
+	if keys[0] == "env" {
+		suggest = func(s string) string { return strings.ToUpper(toSnakeCase(s)) }
+	}
+
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() || hasAnyTag(field.Tag, keys) {
+				continue
+			}
+			pos := c.fset.Position(name.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "config_tag",
+				Category:   "struct_tags",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("設定構造体 '%s' のフィールド '%s' に%sのいずれのタグもありません", ts.Name.Name, name.Name, strings.Join(keys, "・")),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: fmt.Sprintf("%s:\"%s\" を付与してください", keys[0], suggest(name.Name)),
+			})
+		}
+	}
+}
+
+// matchesAnyPattern 名前がいずれかのパターン（filepath.Matchの形式）に一致するか
+func matchesAnyPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyTag フィールドのタグがいずれかのキーを持つか
+func hasAnyTag(tag *ast.BasicLit, keys []string) bool {
+	if tag == nil {
+		return false
+	}
+	for _, key := range keys {
+		if _, ok := lookupTag(tag.Value, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// validatorParam バリデーターの引数の形式
+type validatorParam int
+
+const (
+	paramNone           validatorParam = iota // 引数を取らない
+	paramOptional                             // 引数は任意
+	paramRequired                             // 引数が必須
+	paramNumber                               // 数値（または時間）の引数が必須
+	paramOptionalNumber                       // 数値（または時間）の引数が任意（省略時は現在時刻との比較）
+)
+
+// knownValidators go-playground/validatorの組み込みのバリデーターと引数の形式
+var knownValidators = map[string]validatorParam{
+	// フィールド・制御
+	"required": paramNone, "omitempty": paramNone, "omitnil": paramNone, "isdefault": paramNone,
+	"dive": paramNone, "keys": paramNone, "endkeys": paramNone, "structonly": paramNone, "nostructlevel": paramNone,
+	"required_if": paramRequired, "required_unless": paramRequired, "required_with": paramRequired,
+	"required_with_all": paramRequired, "required_without": paramRequired, "required_without_all": paramRequired,
+	"excluded_if": paramRequired, "excluded_unless": paramRequired, "excluded_with": paramRequired,
+	"excluded_with_all": paramRequired, "excluded_without": paramRequired, "excluded_without_all": paramRequired,
+	"unique": paramOptional,
+	// 比較
+	"min": paramNumber, "max": paramNumber, "len": paramNumber,
+	"eq": paramRequired, "ne": paramRequired, "eq_ignore_case": paramRequired, "ne_ignore_case": paramRequired,
+	"gt": paramOptionalNumber, "gte": paramOptionalNumber, "lt": paramOptionalNumber, "lte": paramOptionalNumber,
+	"oneof": paramRequired, "oneofci": paramRequired,
+	"eqfield": paramRequired, "nefield": paramRequired, "gtfield": paramRequired, "gtefield": paramRequired,
+	"ltfield": paramRequired, "ltefield": paramRequired, "eqcsfield": paramRequired, "necsfield": paramRequired,
+	"gtcsfield": paramRequired, "gtecsfield": paramRequired, "ltcsfield": paramRequired, "ltecsfield": paramRequired,
+	"fieldcontains": paramRequired, "fieldexcludes": paramRequired,
+	// 文字列
+	"alpha": paramNone, "alphanum": paramNone, "alphaunicode": paramNone, "alphanumunicode": paramNone,
+	"ascii": paramNone, "printascii": paramNone, "multibyte": paramNone, "lowercase": paramNone, "uppercase": paramNone,
+	"boolean": paramNone, "number": paramNone, "numeric": paramNone,
+	"contains": paramRequired, "containsany": paramRequired, "containsrune": paramRequired,
+	"excludes": paramRequired, "excludesall": paramRequired, "excludesrune": paramRequired,
+	"startswith": paramRequired, "endswith": paramRequired, "startsnotwith": paramRequired, "endsnotwith": paramRequired,
+	// フォーマット
+	"email": paramNone, "url": paramNone, "http_url": paramNone, "uri": paramNone, "urn_rfc2141": paramNone,
+	"url_encoded": paramNone, "html": paramNone, "html_encoded": paramNone, "json": paramNone, "jwt": paramNone,
+	"uuid": paramNone, "uuid3": paramNone, "uuid4": paramNone, "uuid5": paramNone, "uuid_rfc4122": paramNone,
+	"uuid3_rfc4122": paramNone, "uuid4_rfc4122": paramNone, "uuid5_rfc4122": paramNone, "ulid": paramNone,
+	"base64": paramNone, "base64url": paramNone, "base64rawurl": paramNone, "datauri": paramNone,
+	"hexadecimal": paramNone, "hexcolor": paramNone, "rgb": paramNone, "rgba": paramNone, "hsl": paramNone, "hsla": paramNone,
+	"e164": paramNone, "isbn": paramNone, "isbn10": paramNone, "isbn13": paramNone, "issn": paramNone,
+	"latitude": paramNone, "longitude": paramNone, "ssn": paramNone, "credit_card": paramNone, "luhn_checksum": paramNone,
+	"btc_addr": paramNone, "btc_addr_bech32": paramNone, "eth_addr": paramNone, "md5": paramNone, "sha256": paramNone,
+	"semver": paramNone, "cron": paramNone, "cve": paramNone, "mongodb": paramNone, "timezone": paramNone,
+	"datetime": paramRequired, "bcp47_language_tag": paramNone, "country_code": paramNone,
+	"iso3166_1_alpha2": paramNone, "iso3166_1_alpha3": paramNone, "iso3166_1_alpha_numeric": paramNone,
+	"iso4217": paramNone, "postcode_iso3166_alpha2": paramRequired, "postcode_iso3166_alpha2_field": paramRequired,
+	// ネットワーク・ファイル
+	"ip": paramNone, "ipv4": paramNone, "ipv6": paramNone, "ip_addr": paramNone, "ip4_addr": paramNone, "ip6_addr": paramNone,
+	"cidr": paramNone, "cidrv4": paramNone, "cidrv6": paramNone, "tcp_addr": paramNone, "tcp4_addr": paramNone,
+	"tcp6_addr": paramNone, "udp_addr": paramNone, "udp4_addr": paramNone, "udp6_addr": paramNone, "unix_addr": paramNone,
+	"mac": paramNone, "hostname": paramNone, "hostname_rfc1123": paramNone, "hostname_port": paramNone, "fqdn": paramNone,
+	"file": paramNone, "filepath": paramNone, "dir": paramNone, "dirpath": paramNone, "image": paramNone,
+}
+
+// checkValidateSyntax validateタグのバリデーターが既知のものか、引数の形式が正しいか
+// バリデーター名の誤り（requierd等）は実行時まで気づかれず、バリデーションが無効になる
+func (c *Checker) checkValidateSyntax(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.ValidateSyntax
+	c.forEachTag(ts, func(tagValue string, pos token.Position) {
+		value, ok := lookupTag(tagValue, "validate")
+		if !ok || value == "-" {
+			return
+		}
+		for _, problem := range validateTagProblems(value, rule.Validators) {
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "validate_syntax",
+				Category:   "struct_tags",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    problem[0],
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: problem[1],
+			})
+		}
+	})
+}
+
+// validateTagProblems validateタグの値の問題（メッセージと提案の組）
+// , で区切ったディレクティブと、| で区切ったOR条件をそれぞれ検証する
+func validateTagProblems(value string, custom []string) [][2]string {
+	var problems [][2]string
+	seen := make(map[string]bool)
+	for _, directive := range strings.Split(value, ",") {
+		for _, alt := range strings.Split(directive, "|") {
+			msg, suggestion := validatorProblem(alt, custom)
+			if msg != "" && !seen[msg] {
+				seen[msg] = true
+				problems = append(problems, [2]string{msg, suggestion})
+			}
+		}
+	}
+	return problems
+}
+
+// validatorProblem 1つのバリデーター（name または name=param）の問題（無ければ空）
+func validatorProblem(v string, custom []string) (string, string) {
+	if v == "" {
+		return "validateタグに空のバリデーターがあります（連続した , や | 、末尾の , ）",
+			"余分な区切り文字を削除してください"
+	}
+	name, param, hasParam := strings.Cut(v, "=")
+	kind, ok := knownValidators[name]
+	if !ok {
+		if containsString(custom, name) {
+			return "", ""
+		}
+		suggestion := "go-playground/validatorのバリデーター名を確認するか、独自のバリデーターであればvalidatorsに追加してください"
+		if near := nearestValidator(name); near != "" {
+			suggestion = fmt.Sprintf("'%s' の誤りではありませんか", near)
+		}
+		return fmt.Sprintf("validateタグの '%s' は不明なバリデーターです", name), suggestion
+	}
+
+	switch {
+	case kind == paramNone && hasParam:
+		return fmt.Sprintf("バリデーター '%s' は引数を取りません", name), fmt.Sprintf("'%s' にしてください", name)
+	case (kind == paramRequired || kind == paramNumber) && param == "":
+		return fmt.Sprintf("バリデーター '%s' には引数が必要です", name), fmt.Sprintf("'%s=<値>' の形式で指定してください", name)
+	case (kind == paramNumber || kind == paramOptionalNumber) && hasParam && !isNumberOrDuration(param):
+		return fmt.Sprintf("バリデーター '%s' の引数 '%s' が数値ではありません", name, param), fmt.Sprintf("'%s=10' のように数値（または時間）を指定してください", name)
+	}
+	return "", ""
+}
+
+// isNumberOrDuration 数値またはtime.ParseDurationで解析できる時間か
+func isNumberOrDuration(s string) bool {
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	_, err := time.ParseDuration(s)
+	return err == nil
+}
+
+// nearestValidator 編集距離が2以下で最も近い既知のバリデーター（無ければ空）
+func nearestValidator(name string) string {
+	best, bestDist := "", 3
+	for known := range knownValidators {
+		if d := editDistance(name, known); d < bestDist || (d == bestDist && known < best) {
+			best, bestDist = known, d
+		}
+	}
+	return best
+}
+
+// editDistance レーベンシュタイン距離（隣接文字の入れ替えは1とする）
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// checkTagFieldNames json・yamlタグの名前がフィールド名をtransform（キーごとの指定はtransforms）で変換したものと一致するか
+// フィールド名を小文字にしただけの名前（UserID → userid）は、変換後の名前に区切りがあれば報告する
+// フィールド名の単語を含む名前は許容し、他のフィールドからコピーしたまま直し忘れたタグを検出する
+func (c *Checker) checkTagFieldNames(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.TagFieldName
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return
+	}
+	keys := rule.Tags
+	if len(keys) == 0 {
+		keys = []string{"json", "yaml"}
+	}
+	transform := rule.Transform
+	if transform == "" {
+		transform = "snake_case"
+	}
+
+	for _, field := range st.Fields.List {
+		if field.Tag == nil || len(field.Names) != 1 {
+			continue
+		}
+		name := field.Names[0].Name
+		if matchesAnyPattern(rule.Allowed, name) || matchesAnyPattern(rule.Allowed, ts.Name.Name+"."+name) {
+			continue
+		}
+		for _, key := range keys {
+			expected := toCase(name, transform)
+			if t := rule.Transforms[key]; t != "" {
+				expected = toCase(name, t)
+			}
+			value, _ := lookupTag(field.Tag.Value, key)
+			if message := tagFieldNameMessage(name, key, tagName(key, value), expected); message != "" {
+				c.reportTagFieldName(filePath, field, key, expected, message)
+			}
+		}
+	}
+}
+
+// sharesWords 一方の名前の単語の並びが他方に連続して含まれるか
+// 接頭辞・接尾辞を付けた名前（FirstParam → context_first_param）と、
+// 区切りの位置だけが異なる名前（連続した略語 DTOJSONTag → dto_json_tag）は許容する
+func sharesWords(got, expected string) bool {
+	joined := func(s string) string { return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(s)) }
+	if joined(got) == joined(expected) {
+		return true
+	}
+	split := func(s string) []string {
+		return strings.FieldsFunc(toSnakeCase(s), func(r rune) bool { return r == '_' || r == '-' })
+	}
+	a, b := split(got), split(expected)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	for i := 0; i+len(b) <= len(a); i++ {
+		if slices.Equal(a[i:i+len(b)], b) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagFieldNameMessage タグの名前gotがフィールド名と一致しない場合の違反のメッセージ（問題が無ければ空）
+func tagFieldNameMessage(name, key, got, expected string) string {
+	switch {
+	case got == "" || got == expected:
+		return ""
+	case got == strings.ToLower(name):
+		return fmt.Sprintf("フィールド '%s' の%sタグ '%s' はフィールド名を小文字にしただけです（期待値: '%s'）", name, key, got, expected)
+	case !sharesWords(got, expected):
+		return fmt.Sprintf("フィールド '%s' の%sタグ '%s' がフィールド名と一致しません（期待値: '%s'）", name, key, got, expected)
+	}
+	return ""
+}
+
+// reportTagFieldName タグの名前とフィールド名の不一致を報告
+func (c *Checker) reportTagFieldName(filePath string, field *ast.Field, key, expected, message string) {
+	pos := c.fset.Position(field.Tag.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "tag_field_name",
+		Category:   "struct_tags",
+		Severity:   rules.ParseSeverity(c.config.StructTags.Rules.TagFieldName.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: fmt.Sprintf("%s:\"%s\" にするか、意図した名前であればallowedに追加してください", key, expected),
+	})
+}
+
+// checkDTOJSONTags DTO（required_forに一致する構造体）の公開フィールドが明示的なjsonタグ（json:"-" を含む）を持つか
+// タグが無いとGoのフィールド名がそのまま通信上の名前になり、リネームで互換性が壊れる
+func (c *Checker) checkDTOJSONTags(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.DTOJSONTag
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil || !matchesAnyPattern(rule.RequiredFor, ts.Name.Name) {
+		return
+	}
+
+	for _, field := range st.Fields.List {
+		if hasAnyTag(field.Tag, []string{"json"}) {
+			continue
+		}
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			jsonName := toCase(name.Name, c.config.StructTags.Rules.JSONTag.Style)
+			pos := c.fset.Position(name.Pos())
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "dto_json_tag",
+				Category:   "struct_tags",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("'%s' のフィールド '%s' にjsonタグがありません", ts.Name.Name, name.Name),
+				Code:       c.getCodeLine(filePath, pos.Line),
+				Suggestion: fmt.Sprintf("json:\"%s\" を付与するか、シリアライズしない場合は json:\"-\" を付与してください", jsonName),
+				Fix:        c.jsonTagFix(field, jsonName),
+			})
+		}
+	}
+}
+
+// jsonTagFix フィールドにjsonタグを追加する修正（フィールドが1つの名前を持ち、タグが無いかバッククォートの場合のみ）
+// クォートの無い json:name がある場合は追加せずにクォートする
+func (c *Checker) jsonTagFix(field *ast.Field, jsonName string) *report.Fix {
+	if len(field.Names) != 1 {
+		return nil
+	}
+	tag := fmt.Sprintf("json:\"%s\"", jsonName)
+	fix := &report.Fix{Description: "jsonタグを追加"}
+	switch {
+	case field.Tag == nil:
+		end := c.fset.Position(field.Type.End()).Offset
+		fix.Edits = []report.TextEdit{{Start: end, End: end, NewText: " `" + tag + "`"}}
+	case strings.HasPrefix(field.Tag.Value, "`"):
+		start := c.fset.Position(field.Tag.Pos()).Offset + 1
+		// クォートの無い json:name は重複させずにその場でクォートする
+		if from, to, value, ok := unquotedTagKey(field.Tag.Value[1:len(field.Tag.Value)-1], "json"); ok {
+			if value != "" {
+				tag = fmt.Sprintf("json:\"%s\"", value)
+			}
+			fix.Description = "jsonタグの値をクォート"
+			fix.Edits = []report.TextEdit{{Start: start + from, End: start + to, NewText: tag}}
+			break
+		}
+		fix.Edits = []report.TextEdit{{Start: start, End: start, NewText: tag + " "}}
+	default:
+		return nil
+	}
+	return fix
+}
+
+// unquotedTagKey タグの内容から値がクォートされていない key:value（例: json:name）を探す
+// 見つかった場合は key: から値の終わり（空白またはタグの終わり）までの範囲と値を返す
+func unquotedTagKey(tag, key string) (int, int, string, bool) {
+	for i := 0; i+len(key) < len(tag); i++ {
+		if i > 0 && tag[i-1] != ' ' || !strings.HasPrefix(tag[i:], key+":") {
+			continue
+		}
+		from := i + len(key) + 1
+		if from < len(tag) && tag[from] == '"' {
+			continue
+		}
+		to := from
+		for to < len(tag) && tag[to] != ' ' {
+			to++
+		}
+		return i, to, tag[from:to], true
+	}
+	return 0, 0, "", false
+}
+
+// checkDuplicateTags 同じ構造体の複数のフィールドが同じjson・yamlの名前、同じDBのカラムに対応していないか
+// encoding/json等は重複したフィールドを黙って無視するため、どちらが使われるかが分かりにくい
+func (c *Checker) checkDuplicateTags(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.DuplicateTag
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return
+	}
+	keys := rule.Tags
+	if len(keys) == 0 {
+		keys = []string{"json", "yaml", "db", "gorm", "bson"}
+	}
+
+	for _, key := range keys {
+		first := make(map[string]string) // タグの名前 → 最初のフィールド名
+		for _, field := range st.Fields.List {
+			if field.Tag == nil || len(field.Names) == 0 {
+				continue
+			}
+			value, _ := lookupTag(field.Tag.Value, key)
+			name := tagName(key, value)
+			if name == "" {
+				continue
+			}
+			if prev, ok := first[name]; ok {
+				c.reportDuplicateTag(filePath, field, key, name, prev)
+				continue
+			}
+			first[name] = field.Names[0].Name
+		}
+	}
+}
+
+// reportDuplicateTag タグの名前の重複を報告
+func (c *Checker) reportDuplicateTag(filePath string, field *ast.Field, key, name, prev string) {
+	pos := c.fset.Position(field.Tag.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "duplicate_tag",
+		Category:   "struct_tags",
+		Severity:   rules.ParseSeverity(c.config.StructTags.Rules.DuplicateTag.Severity),
+		Message:    fmt.Sprintf("フィールド '%s' の%sタグ '%s' はフィールド '%s' と重複しています", field.Names[0].Name, key, name, prev),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "どちらかのフィールドのタグを別の名前にしてください",
+	})
+}
+
+// tagPair タグの key:"value" の組（valueはクォートを含む元の表記）
+type tagPair struct {
+	key, value string
+}
+
+// parseTagPairs タグの内容を key:"value" の組に分解する（reflect.StructTagと同じ規則、書式が不正ならfalse）
+func parseTagPairs(tag string) ([]tagPair, bool) {
+	var pairs []tagPair
+	for {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			return pairs, true
+		}
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, false
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, false
+		}
+		pairs = append(pairs, tagPair{key: key, value: tag[:i+1]})
+		tag = tag[i+1:]
+	}
+}
+
+// checkTagFormat タグがバッククォートで囲まれ、半角スペース1つで区切った key:"value" がorderの順に並んでいるか
+func (c *Checker) checkTagFormat(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.TagFormat
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return
+	}
+	order := rule.Order
+	if len(order) == 0 {
+		order = []string{"json", "yaml", "validate"}
+	}
+
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		pairs, ok := parseTagPairs(tag)
+		if !ok {
+			c.reportTagFormat(filePath, field.Tag, []string{`key:"value" の形式ではありません`}, "")
+			continue
+		}
+		if problems, formatted := tagFormatProblems(field.Tag.Value, tag, pairs, order); len(problems) > 0 {
+			c.reportTagFormat(filePath, field.Tag, problems, formatted)
+		}
+	}
+}
+
+// tagFormatProblems タグの書式の問題と、整形したタグのリテラル（バッククォートで表せない場合は空）
+func tagFormatProblems(literal, tag string, pairs []tagPair, order []string) ([]string, string) {
+	var problems []string
+	if !strings.HasPrefix(literal, "`") {
+		problems = append(problems, "バッククォートで囲まれていません")
+	}
+
+	sorted := slices.Clone(pairs)
+	slices.SortStableFunc(sorted, func(a, b tagPair) int {
+		return keyRank(a.key, order) - keyRank(b.key, order)
+	})
+	parts := make([]string, len(sorted))
+	for i, p := range sorted {
+		parts[i] = p.key + ":" + p.value
+	}
+	formatted := strings.Join(parts, " ")
+
+	if !slices.Equal(sorted, pairs) {
+		problems = append(problems, fmt.Sprintf("キーの順序が %s と異なります", strings.Join(order, ", ")))
+	} else if formatted != tag {
+		problems = append(problems, "key:\"value\" の区切りが半角スペース1つではありません")
+	}
+	if strings.Contains(formatted, "`") {
+		return problems, ""
+	}
+	return problems, "`" + formatted + "`"
+}
+
+// keyRank orderの中でのキーの位置（含まれないキーは末尾）
+func keyRank(key string, order []string) int {
+	if i := slices.Index(order, key); i >= 0 {
+		return i
+	}
+	return len(order)
+}
+
+// reportTagFormat タグの書式の問題を報告（formattedが空でなければ自動修正を付ける）
+func (c *Checker) reportTagFormat(filePath string, tag *ast.BasicLit, problems []string, formatted string) {
+	pos := c.fset.Position(tag.Pos())
+	v := report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "tag_format",
+		Category:   "struct_tags",
+		Severity:   rules.ParseSeverity(c.config.StructTags.Rules.TagFormat.Severity),
+		Message:    "タグの書式: " + strings.Join(problems, "、"),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: "バッククォートで囲み、半角スペース1つで区切った key:\"value\" をキーの順序どおりに並べてください",
+	}
+	if formatted != "" {
+		v.Suggestion = formatted
+		v.Fix = &report.Fix{
+			Description: "タグを整形",
+			Edits:       []report.TextEdit{c.replaceEdit(tag, formatted)},
+		}
+	}
+	c.report.AddViolation(v)
+}
+
+// checkValidationCall リクエスト構造体（required_forに一致する型）の変数をデコードした関数が、
+// その後にバリデーション（validate.Struct(req)・req.Validate() 等）を呼び出しているか
+// validation_tagがタグの有無を検証するのに対し、タグが実際に使われているかを検証する
+func (c *Checker) checkValidationCall(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.StructTags.Rules.ValidationCall
+	if fn.Body == nil {
+		return
+	}
+	decoders := rule.Decoders
+	if len(decoders) == 0 {
+		decoders = []string{"Unmarshal", "Decode", "Bind", "BindJSON", "BodyParser"}
+	}
+	validators := rule.Validators
+	if len(validators) == 0 {
+		validators = []string{"Struct", "StructCtx", "Validate", "ValidateStruct"}
+	}
+	varTypes := c.localTypeNames(fn)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if name, _ := calleeName(call); !containsString(decoders, name) {
+			return true
+		}
+		for _, arg := range call.Args {
+			ident, ok := ast.Unparen(stripAddr(arg)).(*ast.Ident)
+			if !ok || !matchesAnyPattern(rule.RequiredFor, varTypes[ident.Name]) {
+				continue
+			}
+			if !validatesAfter(fn.Body, ident.Name, call.End(), validators) {
+				c.reportValidationCall(filePath, call, ident.Name, varTypes[ident.Name])
+			}
+		}
+		return true
+	})
+}
+
+// stripAddr &x の x（それ以外はそのまま）
+func stripAddr(expr ast.Expr) ast.Expr {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		return u.X
+	}
+	return expr
+}
+
+// localTypeNames 関数の引数・ローカル変数の名前と型名（ポインタ・パッケージ修飾を除いた名前）
+// 型情報がある場合はそれを、無い場合は var x T・x := T{}・x := &T{}・x := new(T) から推定する
+func (c *Checker) localTypeNames(fn *ast.FuncDecl) map[string]string {
+	names := make(map[string]string)
+	add := func(ident *ast.Ident, typ ast.Expr) {
+		if c.info != nil {
+			if obj := c.info.ObjectOf(ident); obj != nil {
+				names[ident.Name] = namedTypeName(obj.Type())
+				return
+			}
+		}
+		if name := exprTypeName(typ); name != "" {
+			names[ident.Name] = name
+		}
+	}
+
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			add(name, field.Type)
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				typ := n.Type
+				if typ == nil && i < len(n.Values) {
+					typ = n.Values[i]
+				}
+				add(name, typ)
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && n.Tok == token.DEFINE && len(n.Lhs) == len(n.Rhs) {
+					add(ident, n.Rhs[i])
+				}
+			}
+		}
+		return true
+	})
+	return names
+}
+
+// exprTypeName 型・値の式から型名を推定（T, *T, pkg.T, T{}, &T{}, new(T)、http.*は除く）
+func exprTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		if isSelector(e, "http", e.Sel.Name) {
+			return "" // *http.Request等はリクエスト構造体ではない
+		}
+		return e.Sel.Name
+	case *ast.StarExpr:
+		return exprTypeName(e.X)
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return exprTypeName(e.X)
+		}
+	case *ast.CompositeLit:
+		return exprTypeName(e.Type)
+	case *ast.CallExpr:
+		if ident, ok := e.Fun.(*ast.Ident); ok && ident.Name == "new" && len(e.Args) == 1 {
+			return exprTypeName(e.Args[0])
+		}
+	}
+	return ""
+}
+
+// namedTypeName 型（ポインタを除く）の名前（net/httpの型は除く）
+func namedTypeName(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok && (named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "net/http") {
+		return named.Obj().Name()
+	}
+	return ""
+}
+
+// validatesAfter pos以降に変数nameを引数またはレシーバーとしてバリデーションを呼び出しているか
+func validatesAfter(body *ast.BlockStmt, name string, pos token.Pos, validators []string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if found || !ok || call.Pos() < pos {
+			return !found
+		}
+		method, _ := calleeName(call)
+		if !containsString(validators, method) {
+			return true
+		}
+		targets := call.Args
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			targets = append([]ast.Expr{sel.X}, targets...)
+		}
+		for _, target := range targets {
+			if ident, ok := ast.Unparen(stripAddr(target)).(*ast.Ident); ok && ident.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// reportValidationCall デコードした変数のバリデーションが無いことを報告
+func (c *Checker) reportValidationCall(filePath string, call *ast.CallExpr, name, typeName string) {
+	pos := c.fset.Position(call.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "validation_call",
+		Category:   "struct_tags",
+		Severity:   rules.ParseSeverity(c.config.StructTags.Rules.ValidationCall.Severity),
+		Message:    fmt.Sprintf("'%s'（%s）をデコードしていますが、バリデーションを呼び出していません", name, typeName),
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: fmt.Sprintf("デコード後、使用する前に validate.Struct(%s) や %s.Validate() でvalidateタグを検証してください", name, name),
+	})
+}
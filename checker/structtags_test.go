@@ -0,0 +1,311 @@
+package checker
+
+import "testing"
+
+func TestTagStyle(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    tag_style:
+      enabled: true
+      severity: "warning"
+      tags:
+        - key: "yaml"
+          style: "snake_case"
+        - key: "gorm"
+          style: "snake_case"
+`
+	runRuleTests(t, config, "tag_style", []ruleTest{
+		{
+			name:  "camelCase yaml and gorm column",
+			files: map[string]string{"a.go": "package p\n\ntype Config struct {\n\tMaxRetries int `yaml:\"maxRetries\"`\n\tUserID     int `gorm:\"column:userId\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "snake_case names and keys without style",
+			files: map[string]string{"a.go": "package p\n\ntype Config struct {\n\tMaxRetries int `yaml:\"max_retries\"`\n\tUserID     int `gorm:\"column:user_id;index\" bson:\"userId\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestConfigTag(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    config_tag:
+      enabled: true
+      severity: "info"
+      required_for: ["*Config"]
+      tags: ["mapstructure", "env"]
+`
+	runRuleTests(t, config, "config_tag", []ruleTest{
+		{
+			name:  "exported fields without loader tags",
+			files: map[string]string{"a.go": "package p\n\ntype DBConfig struct {\n\tHost string\n\tPort int `json:\"port\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "tagged fields, unexported fields and other structs",
+			files: map[string]string{"a.go": "package p\n\ntype DBConfig struct {\n\tHost string `mapstructure:\"host\"`\n\tPort int    `env:\"DB_PORT\"`\n\tpool int\n}\n\ntype User struct {\n\tName string\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestValidateSyntax(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    validate_syntax:
+      enabled: true
+      severity: "error"
+      validators: ["is_sku"]
+`
+	runRuleTests(t, config, "validate_syntax", []ruleTest{
+		{
+			name:  "misspelled validator and invalid parameter",
+			files: map[string]string{"a.go": "package p\n\ntype CreateRequest struct {\n\tName string `validate:\"requierd\"`\n\tAge  int    `validate:\"min=abc\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "known and custom validators",
+			files: map[string]string{"a.go": "package p\n\ntype CreateRequest struct {\n\tName  string `validate:\"required,max=100\"`\n\tEmail string `validate:\"omitempty,email|uuid\"`\n\tSKU   string `validate:\"is_sku\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestTagFieldName(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    tag_field_name:
+      enabled: true
+      severity: "warning"
+      tags: ["json"]
+      transform: "snake_case"
+`
+	runRuleTests(t, config, "tag_field_name", []ruleTest{
+		{
+			name:  "tag copied from another field",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tFirstName string `json:\"first_name\"`\n\tLastName  string `json:\"first_name\"`\n}\n"},
+			want:  1,
+		},
+		{
+			name:  "names derived from field names",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tFirstName string `json:\"first_name\"`\n\tLastName  string `json:\"last_name,omitempty\"`\n\tInternal  string `json:\"-\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestDTOJSONTag(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    dto_json_tag:
+      enabled: true
+      severity: "warning"
+      required_for: ["*Request", "*Response"]
+`
+	runRuleTests(t, config, "dto_json_tag", []ruleTest{
+		{
+			name:  "exported fields without json tag",
+			files: map[string]string{"a.go": "package p\n\ntype CreateUserRequest struct {\n\tName  string\n\tEmail string `validate:\"email\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "explicit tags and non DTO struct",
+			files: map[string]string{"a.go": "package p\n\ntype CreateUserRequest struct {\n\tName   string `json:\"name\"`\n\tSecret string `json:\"-\"`\n\tmemo   string\n}\n\ntype User struct {\n\tName string\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestDTOJSONTagFix(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    dto_json_tag:
+      enabled: true
+      severity: "warning"
+      required_for: ["*Request"]
+`
+	tests := []struct {
+		name string
+		tag  string // Nameフィールドのタグ（空の場合はタグ無し）
+		want string // 修正後のタグ
+	}{
+		{name: "no tag", want: "`json:\"name\"`"},
+		{name: "other tag", tag: "`validate:\"required\"`", want: "`json:\"name\" validate:\"required\"`"},
+		{name: "unquoted json value", tag: "`json:full_name,omitempty`", want: "`json:\"full_name,omitempty\"`"},
+		{name: "unquoted json value before other tag", tag: "`json:full_name validate:\"required\"`", want: "`json:\"full_name\" validate:\"required\"`"},
+		{name: "empty json value", tag: "`validate:\"required\" json:`", want: "`validate:\"required\" json:\"name\"`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := "\tName string"
+			if tt.tag != "" {
+				field += " " + tt.tag
+			}
+			root := t.TempDir()
+			writeTree(t, root, map[string]string{"a.go": "package p\n\ntype CreateUserRequest struct {\n" + field + "\n}\n"})
+			rep, err := NewChecker(loadTestConfig(t, config)).Check(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := "package p\n\ntype CreateUserRequest struct {\n\tName string " + tt.want + "\n}\n"
+			if got := plannedFiles(t, root, rep)["a.go"]; got != want {
+				t.Errorf("a.go after fix:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestDuplicateTag(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    duplicate_tag:
+      enabled: true
+      severity: "error"
+      tags: ["json", "gorm"]
+`
+	runRuleTests(t, config, "duplicate_tag", []ruleTest{
+		{
+			name:  "same json name and column",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tName     string `json:\"name\" gorm:\"column:name\"`\n\tNickname string `json:\"name\" gorm:\"column:name\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "distinct names and ignored fields",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tName     string `json:\"name\"`\n\tNickname string `json:\"nickname\"`\n\tA        string `json:\"-\"`\n\tB        string `json:\"-\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestTagFormat(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    tag_format:
+      enabled: true
+      severity: "info"
+      order: ["json", "validate"]
+`
+	runRuleTests(t, config, "tag_format", []ruleTest{
+		{
+			name:  "double quoted, extra spaces and key order",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tName  string \"json:\\\"name\\\"\"\n\tEmail string `json:\"email\"  yaml:\"email\"`\n\tAge   int    `validate:\"min=0\" json:\"age\"`\n}\n"},
+			want:  3,
+		},
+		{
+			name:  "formatted tags",
+			files: map[string]string{"a.go": "package p\n\ntype User struct {\n\tName  string `json:\"name\" validate:\"required\"`\n\tEmail string `json:\"email\" yaml:\"email\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestValidationCall(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    validation_call:
+      enabled: true
+      severity: "warning"
+      required_for: ["*Request"]
+`
+	runRuleTests(t, config, "validation_call", []ruleTest{
+		{
+			name: "decoded request not validated",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type CreateRequest struct {
+	Name string ` + "`json:\"name\" validate:\"required\"`" + `
+}
+
+func create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "validated after decode",
+			files: map[string]string{"a.go": `package p
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+type CreateRequest struct {
+	Name string ` + "`json:\"name\" validate:\"required\"`" + `
+}
+
+func create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		return
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestTagFieldNameTransforms(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    tag_field_name:
+      enabled: true
+      severity: "warning"
+      tags: ["json", "yaml"]
+      transform: "snake_case"
+      transforms:
+        yaml: "camelCase"
+`
+	runRuleTests(t, config, "tag_field_name", []ruleTest{
+		{
+			name:  "yaml name copied from another field",
+			files: map[string]string{"a.go": "package p\n\ntype Config struct {\n\tMaxRetries int `json:\"max_retries\" yaml:\"maxRetries\"`\n\tMaxIdle    int `json:\"max_idle\" yaml:\"maxRetries\"`\n}\n"},
+			want:  1,
+		},
+		{
+			name:  "names follow each transform",
+			files: map[string]string{"a.go": "package p\n\ntype Config struct {\n\tMaxRetries int `json:\"max_retries\" yaml:\"maxRetries\"`\n\tMaxIdle    int `json:\"max_idle\" yaml:\"maxIdle\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// ファイル・パッケージ単位の構造チェック
+// ========================================
+
+// checkFileLines ファイルの行数が上限を超えていないか（package句の位置に報告する）
+func (c *Checker) checkFileLines(file *ast.File, filePath string) {
+	rule := c.config.Structure.Rules.MaxFileLines
+	tf := c.fset.File(file.Pos())
+	if tf == nil || rule.Limit <= 0 || tf.LineCount() <= rule.Limit {
+		return
+	}
+	c.reportStructure(file.Package, "max_file_lines", rule.Severity,
+		fmt.Sprintf("ファイルは%d行あります（上限: %d行）", tf.LineCount(), rule.Limit),
+		"責務ごとにファイルを分割してください", filePath)
+}
+
+// checkGlobalVars パッケージレベルの変数（変更可能なグローバル状態）を検出
+// ブランク識別子（インタフェースの実装の確認等）、allowed_namesに一致する名前、allowed_callsで初期化した変数は除く
+func (c *Checker) checkGlobalVars(file *ast.File, filePath string) {
+	rule := c.config.Structure.Rules.NoGlobalVars
+	if c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok && !c.immutableInit(vs, rule.AllowedCalls) {
+				c.checkGlobalVarNames(vs, rule, filePath)
+			}
+		}
+	}
+}
+
+// checkGlobalVarNames 変数宣言の名前ごとに、許可されていない変数を報告する
+func (c *Checker) checkGlobalVarNames(vs *ast.ValueSpec, rule rules.NoGlobalVarsRule, filePath string) {
+	for _, name := range vs.Names {
+		if name.Name == "_" || rule.ExportedOnly && !name.IsExported() || matchesAnyPattern(rule.AllowedNames, name.Name) {
+			continue
+		}
+		c.reportStructure(name.Pos(), "no_global_vars", rule.Severity,
+			"パッケージレベルの変数 '"+name.Name+"' は変更可能なグローバル状態です",
+			"定数にするか、構造体のフィールドとして依存を注入してください", filePath)
+	}
+}
+
+// immutableInit 初期値がすべてallowed_calls（errors.New等）の呼び出しか
+func (c *Checker) immutableInit(vs *ast.ValueSpec, allowedCalls []string) bool {
+	if len(vs.Values) == 0 || len(allowedCalls) == 0 {
+		return false
+	}
+	for _, value := range vs.Values {
+		call, ok := value.(*ast.CallExpr)
+		if !ok || !containsString(allowedCalls, c.getCallExprString(call)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkInitFunc init関数を検出（allowed_inのファイルは除く）
+func (c *Checker) checkInitFunc(fn *ast.FuncDecl, filePath string) {
+	rule := c.config.Structure.Rules.NoInitFunc
+	if fn.Recv != nil || fn.Name.Name != "init" || c.isAllowedIn(rule.AllowedIn, filePath) {
+		return
+	}
+	c.reportStructure(fn.Pos(), "no_init_func", rule.Severity,
+		"init関数は暗黙に実行されるため使用しないでください",
+		"初期化処理を関数にして、mainや呼び出し元から明示的に呼び出してください", filePath)
+}
+
+// reportStructure structureカテゴリの違反を報告する
+func (c *Checker) reportStructure(at token.Pos, rule, severity, message, suggestion, filePath string) {
+	pos := c.fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "structure",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: suggestion,
+	})
+}
@@ -0,0 +1,100 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxFileLines(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    max_file_lines:
+      enabled: true
+      limit: 10
+      severity: "info"
+`
+	runRuleTests(t, config, "max_file_lines", []ruleTest{
+		{
+			name:  "file over limit",
+			files: map[string]string{"a.go": "package p\n" + strings.Repeat("\nvar _ = 1\n", 6)},
+			want:  1,
+		},
+		{
+			name:  "file within limit",
+			files: map[string]string{"a.go": "package p\n" + strings.Repeat("\nvar _ = 1\n", 3)},
+			want:  0,
+		},
+	})
+}
+
+func TestNoGlobalVars(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    no_global_vars:
+      enabled: true
+      severity: "info"
+      allowed_names: ["Err*"]
+      allowed_calls: ["errors.New", "regexp.MustCompile"]
+      allowed_in: ["**/cmd/**"]
+`
+	runRuleTests(t, config, "no_global_vars", []ruleTest{
+		{
+			name: "mutable package state",
+			files: map[string]string{"a.go": `package p
+
+var counter int
+
+var Cache = map[string]string{}
+`},
+			want: 2,
+		},
+		{
+			name: "sentinel errors, compiled patterns, constants and allowed path",
+			files: map[string]string{
+				"a.go": `package p
+
+import (
+	"errors"
+	"regexp"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var idRe = regexp.MustCompile("^[0-9]+$")
+
+const limit = 10
+`,
+				"cmd/tool/main.go": "package main\n\nvar verbose bool\n\nfunc main() {}\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestNoInitFunc(t *testing.T) {
+	const config = `
+structure:
+  enabled: true
+  rules:
+    no_init_func:
+      enabled: true
+      severity: "warning"
+      allowed_in: ["main.go"]
+`
+	runRuleTests(t, config, "no_init_func", []ruleTest{
+		{
+			name:  "init in library",
+			files: map[string]string{"a.go": "package p\n\nfunc init() {}\n"},
+			want:  1,
+		},
+		{
+			name:  "init in main.go",
+			files: map[string]string{"main.go": "package main\n\nfunc init() {}\n\nfunc main() {}\n"},
+			want:  0,
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// インライン抑制コメント
+// ========================================
+//
+// //standards:ignore rule_name[,rule_name...] 理由
+//
+// コードの後ろに書いた場合はその行、単独の行に書いた場合は次の行の違反を抑制する。
+// 関数のドキュメントコメントに書いた場合は関数全体を抑制する
+// ルール名に all を指定するとすべてのルールを抑制する
+//
+// プロジェクト単位のルールの違反にも適用するため、何も抑制しなかった抑制コメントは
+// すべてのルールを実行した後に報告する
+
+// ignoreDirective 抑制コメントの接頭辞（//standards:ignore rule_name 理由）
+const ignoreDirective = "//standards:ignore"
+
+// suppression ファイル内の抑制コメント
+type suppression struct {
+	line     int
+	column   int
+	code     string // コメントの行の内容
+	rules    []string
+	from, to int  // 抑制する行の範囲
+	used     bool // いずれかの違反を抑制した
+}
+
+// covers 抑制コメントが指定行のルールを抑制するか
+func (s *suppression) covers(line int, rule string) bool {
+	if line < s.from || line > s.to {
+		return false
+	}
+	return containsString(s.rules, rule) || containsString(s.rules, "all")
+}
+
+// parseSuppressions ファイル内の抑制コメントを集める
+func (c *Checker) parseSuppressions(file *ast.File, filePath string) []*suppression {
+	var list []*suppression
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			names := ignoredRules(comment.Text)
+			if len(names) == 0 {
+				continue
+			}
+			pos := c.fset.Position(comment.Pos())
+			code := c.getCodeLine(filePath, pos.Line)
+			s := &suppression{line: pos.Line, column: pos.Column, code: code, rules: names, from: pos.Line + 1, to: pos.Line + 1}
+			switch fn := docOwner(file, comment); {
+			case fn != nil:
+				s.from, s.to = pos.Line, c.fset.Position(fn.End()).Line
+			case trailingComment(code, pos.Column):
+				s.from, s.to = pos.Line, pos.Line
+			}
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// trailingComment 指定列から始まるコメントの前にコードがあるか
+func trailingComment(code string, column int) bool {
+	if column < 1 || column-1 > len(code) {
+		return false
+	}
+	return strings.TrimSpace(code[:column-1]) != ""
+}
+
+// docOwner コメントがドキュメントコメントに含まれる関数（含まれない場合はnil）
+func docOwner(file *ast.File, comment *ast.Comment) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Doc != nil && fn.Doc.Pos() <= comment.Pos() && comment.End() <= fn.Doc.End() {
+			return fn
+		}
+	}
+	return nil
+}
+
+// ignoredRules 抑制コメントが指定するルール名（抑制コメントでない場合はnil、ルール名はカンマ区切りで複数指定可）
+func ignoredRules(text string) []string {
+	if !strings.HasPrefix(text, ignoreDirective) {
+		return nil
+	}
+	fields := strings.Fields(strings.TrimPrefix(text, ignoreDirective))
+	if len(fields) == 0 || !strings.HasPrefix(text, ignoreDirective+" ") {
+		return nil
+	}
+	return strings.Split(fields[0], ",")
+}
+
+// hasIgnoreComment 指定位置の行のルールを抑制するコメントがあるか（違反の位置と異なる行で抑制するルール用）
+func (c *Checker) hasIgnoreComment(pos token.Pos, rule string) bool {
+	line := c.fset.Position(pos).Line
+	for _, s := range c.suppressions {
+		if s.covers(line, rule) {
+			s.used = true
+			return true
+		}
+	}
+	return false
+}
+
+// applySuppressions チェック中のファイルの違反のうち抑制コメントに一致するものを取り除き、抑制した件数を数える
+func (c *Checker) applySuppressions(filePath string) {
+	if len(c.suppressions) == 0 {
+		return
+	}
+	kept := c.report.Violations[:0]
+	for _, v := range c.report.Violations {
+		if v.File == filePath && suppressedBy(c.suppressions, v) {
+			c.report.AddSuppressed(1)
+			continue
+		}
+		kept = append(kept, v)
+	}
+	c.report.Violations = kept
+}
+
+// applyProjectSuppressions プロジェクト単位のルールの違反に違反のファイルの抑制コメントを適用してレポートに加える
+// settings.report_unused_suppressions が有効でreportUnusedがtrueの場合は、
+// ファイル単位・プロジェクト単位のどちらの違反も抑制しなかった抑制コメントを報告する
+func (c *Checker) applyProjectSuppressions(files []string, violations []report.Violation, reportUnused bool) {
+	for _, v := range violations {
+		if suppressedBy(c.fileSuppressions[v.File], v) {
+			c.report.AddSuppressed(1)
+			continue
+		}
+		c.report.AddViolation(v)
+	}
+
+	if !reportUnused || !c.config.Settings.ReportUnusedSuppressions {
+		return
+	}
+	for _, filePath := range files {
+		for _, s := range c.fileSuppressions[filePath] {
+			if s.used {
+				continue
+			}
+			c.report.AddViolation(report.Violation{
+				File:       filePath,
+				Line:       s.line,
+				Column:     s.column,
+				Rule:       "unused_suppression",
+				Category:   "suppression",
+				Severity:   rules.SeverityWarning,
+				Message:    fmt.Sprintf("抑制コメントに一致する違反がありません（%s）", strings.Join(s.rules, ",")),
+				Code:       s.code,
+				Suggestion: "不要になった抑制コメントを削除してください",
+			})
+		}
+	}
+}
+
+// suppressedBy 違反を抑制するコメントがあるか（一致した抑制コメントは使用済みにする）
+func suppressedBy(list []*suppression, v report.Violation) bool {
+	found := false
+	for _, s := range list {
+		if s.covers(v.Line, v.Rule) {
+			s.used = true
+			found = true
+		}
+	}
+	return found
+}
@@ -0,0 +1,169 @@
+package checker
+
+import "testing"
+
+func TestSuppressions(t *testing.T) {
+	const config = `
+error_handling:
+  enabled: true
+  rules:
+    no_panic:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "no_panic", []ruleTest{
+		{
+			name: "other rule and unrelated line",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	//standards:ignore no_fmt_println 起動時の設定ミス
+	panic("unreachable")
+}
+
+func g() {
+	//standards:ignore no_panic 起動時の設定ミス
+
+	panic("unreachable")
+}
+`},
+			want: 2,
+		},
+		{
+			name: "trailing comment covers only its own line",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	panic("unreachable") //standards:ignore no_panic 起動時の設定ミス
+	panic("unreachable")
+}
+`},
+			want: 1,
+		},
+		{
+			name: "comment on its own line covers only the next line",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	//standards:ignore no_panic 起動時の設定ミス
+	panic("unreachable")
+	panic("unreachable")
+}
+`},
+			want: 1,
+		},
+		{
+			name: "next line, rule list and doc comment",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	//standards:ignore no_panic 起動時の設定ミス
+	panic("unreachable")
+}
+
+func g() {
+	//standards:ignore error_var,no_panic 起動時の設定ミス
+	panic("unreachable")
+	panic("unreachable") //standards:ignore no_panic 起動時の設定ミス
+}
+
+// h 初期化に失敗した場合は続行できない
+//
+//standards:ignore all 起動時の設定ミス
+func h() {
+	panic("unreachable")
+	panic("unreachable")
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestUnusedSuppression(t *testing.T) {
+	const config = `
+settings:
+  report_unused_suppressions: true
+error_handling:
+  enabled: true
+  rules:
+    no_panic:
+      enabled: true
+      severity: "warning"
+`
+	runRuleTests(t, config, "unused_suppression", []ruleTest{
+		{
+			name: "nothing to suppress",
+			files: map[string]string{"a.go": `package p
+
+func f() int {
+	//standards:ignore no_panic 起動時の設定ミス
+	return 1
+}
+`},
+			want: 1,
+		},
+		{
+			name: "suppression used",
+			files: map[string]string{"a.go": `package p
+
+func f() {
+	//standards:ignore no_panic 起動時の設定ミス
+	panic("unreachable")
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestProjectRuleSuppressions(t *testing.T) {
+	const config = `
+settings:
+  report_unused_suppressions: true
+naming:
+  enabled: true
+  rules:
+    receiver_name:
+      enabled: true
+      severity: "warning"
+      forbidden: ["this", "self"]
+`
+	const src = `package p
+
+type Server struct{}
+
+//standards:ignore receiver_name 外部の生成コードに合わせる
+func (this *Server) Start() {}
+
+func (this *Server) Stop() {} //standards:ignore receiver_name 外部の生成コードに合わせる
+`
+	tests := []struct {
+		rule string
+		want int
+	}{
+		{rule: "receiver_name", want: 0},
+		{rule: "unused_suppression", want: 0},
+	}
+
+	cacheDir := t.TempDir()
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"a.go": src})
+	cfg := loadTestConfig(t, config)
+	for _, run := range []string{"first run", "cached run"} {
+		c := NewChecker(cfg)
+		c.SetCacheDir(cacheDir)
+		rep, err := c.Check(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range tests {
+			if got := countRule(rep, tt.rule); got != tt.want {
+				t.Errorf("%s: %s violations = %d, want %d", run, tt.rule, got, tt.want)
+			}
+		}
+		if rep.Summary.Suppressed != 2 {
+			t.Errorf("%s: suppressed = %d, want 2", run, rep.Summary.Suppressed)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package checker
+
+import (
+	"go/ast"
+	"slices"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// フィールドごとに必要なタグ（required_tags・omitempty）
+// ========================================
+
+// checkRequiredTags structsのパターンに一致する構造体の公開フィールドが、必要なタグを持つか
+// 埋め込みフィールドは対象外
+func (c *Checker) checkRequiredTags(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.RequiredTags
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return
+	}
+	for _, req := range rule.Structs {
+		if len(req.Tags) == 0 || !matchesAnyPattern(req.RequiredFor, ts.Name.Name) {
+			continue
+		}
+		for _, field := range st.Fields.List {
+			c.checkFieldTags(ts, field, req, filePath)
+		}
+	}
+}
+
+// checkFieldTags 公開フィールドがreqのタグ（any_ofの場合はいずれか）を持たなければ報告する
+func (c *Checker) checkFieldTags(ts *ast.TypeSpec, field *ast.Field, req rules.RequiredTags, filePath string) {
+	for _, name := range field.Names {
+		if name.IsExported() {
+			c.reportMissingTags(ts, field, name, req, filePath)
+		}
+	}
+}
+
+// reportMissingTags フィールドnameに無いreqのタグを報告する
+func (c *Checker) reportMissingTags(ts *ast.TypeSpec, field *ast.Field, name *ast.Ident, req rules.RequiredTags, filePath string) {
+	rule := c.config.StructTags.Rules.RequiredTags
+	var missing []string
+	for _, key := range req.Tags {
+		if !hasAnyTag(field.Tag, []string{key}) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 || (req.AnyOf && len(missing) < len(req.Tags)) {
+		return
+	}
+	what := strings.Join(missing, "・") + "タグがありません"
+	if req.AnyOf {
+		what = strings.Join(missing, "・") + "のいずれのタグもありません"
+	}
+	suggestions := make([]string, 0, len(missing))
+	for _, key := range missing {
+		suggestions = append(suggestions, key+":\""+toCase(name.Name, c.tagStyle(key))+"\"")
+	}
+	if req.AnyOf {
+		suggestions = suggestions[:1]
+	}
+	pos := c.fset.Position(name.Pos())
+	c.report.AddViolation(report.Violation{
+		File:       filePath,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       "required_tags",
+		Category:   "struct_tags",
+		Severity:   rules.ParseSeverity(rule.Severity),
+		Message:    "'" + ts.Name.Name + "' のフィールド '" + name.Name + "' に" + what,
+		Code:       c.getCodeLine(filePath, pos.Line),
+		Suggestion: strings.Join(suggestions, " ") + " を付与してください",
+	})
+}
+
+// tagStyle タグのキーの命名規則（jsonはjson_tag、それ以外はtag_styleの設定、無ければsnake_case）
+func (c *Checker) tagStyle(key string) string {
+	tags := c.config.StructTags.Rules
+	if key == "json" && tags.JSONTag.Style != "" {
+		return tags.JSONTag.Style
+	}
+	for _, style := range tags.TagStyle.Tags {
+		if style.Key == key && style.Style != "" {
+			return style.Style
+		}
+	}
+	return "snake_case"
+}
+
+// checkOmitempty required_forに一致する構造体の省略可能なフィールド（ポインタ・スライス・map）のjsonタグがomitemptyを持つか
+// 値が無い場合にnull・空の配列を返さず、フィールド自体を省略するため
+func (c *Checker) checkOmitempty(ts *ast.TypeSpec, filePath string) {
+	rule := c.config.StructTags.Rules.Omitempty
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil || !matchesAnyPattern(rule.RequiredFor, ts.Name.Name) {
+		return
+	}
+	types := rule.Types
+	if len(types) == 0 {
+		types = []string{"pointer", "slice", "map"}
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 1 || field.Tag == nil || !slices.Contains(types, optionalKind(field.Type)) {
+			continue
+		}
+		value, ok := lookupTag(field.Tag.Value, "json")
+		if !ok || tagName("json", value) == "" {
+			continue
+		}
+		_, opts, _ := strings.Cut(value, ",")
+		options := strings.Split(opts, ",")
+		if slices.Contains(options, "omitempty") || slices.Contains(options, "omitzero") {
+			continue
+		}
+		pos := c.fset.Position(field.Tag.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       filePath,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "omitempty",
+			Category:   "struct_tags",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    "'" + ts.Name.Name + "' の省略可能なフィールド '" + field.Names[0].Name + "' のjsonタグにomitemptyがありません",
+			Code:       c.getCodeLine(filePath, pos.Line),
+			Suggestion: "json:\"" + tagName("json", value) + ",omitempty\" にしてください",
+			Fix:        c.omitemptyFix(field.Tag),
+		})
+	}
+}
+
+// optionalKind 省略可能とみなす型の種類（pointer・slice・map、それ以外は空）
+func optionalKind(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return "pointer"
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "slice"
+		}
+	case *ast.MapType:
+		return "map"
+	}
+	return ""
+}
+
+// omitemptyFix jsonタグの名前の後に ,omitempty を挿入する修正（バッククォートのタグのみ）
+func (c *Checker) omitemptyFix(tag *ast.BasicLit) *report.Fix {
+	if !strings.HasPrefix(tag.Value, "`") {
+		return nil
+	}
+	i := strings.Index(tag.Value, `json:"`)
+	if i < 0 || (tag.Value[i-1] != '`' && tag.Value[i-1] != ' ') {
+		return nil
+	}
+	start := i + len(`json:"`)
+	end := strings.IndexAny(tag.Value[start:], `,"`)
+	if end < 0 {
+		return nil
+	}
+	at := c.fset.Position(tag.Pos()).Offset + start + end
+	return &report.Fix{
+		Description: "jsonタグにomitemptyを追加",
+		Edits:       []report.TextEdit{{Start: at, End: at, NewText: ",omitempty"}},
+	}
+}
@@ -0,0 +1,53 @@
+package checker
+
+import "testing"
+
+func TestRequiredTags(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    required_tags:
+      enabled: true
+      severity: "warning"
+      structs:
+        - required_for: ["*Model"]
+          tags: ["db"]
+`
+	runRuleTests(t, config, "required_tags", []ruleTest{
+		{
+			name:  "model fields without db tag",
+			files: map[string]string{"a.go": "package p\n\ntype UserModel struct {\n\tID   int64\n\tName string `json:\"name\"`\n}\n"},
+			want:  2,
+		},
+		{
+			name:  "tagged model and other structs",
+			files: map[string]string{"a.go": "package p\n\ntype UserModel struct {\n\tID   int64  `db:\"id\"`\n\tName string `db:\"name\"`\n\tmemo string\n}\n\ntype User struct {\n\tName string\n}\n"},
+			want:  0,
+		},
+	})
+}
+
+func TestOmitempty(t *testing.T) {
+	const config = `
+struct_tags:
+  enabled: true
+  rules:
+    omitempty:
+      enabled: true
+      severity: "info"
+      required_for: ["*Response"]
+`
+	runRuleTests(t, config, "omitempty", []ruleTest{
+		{
+			name:  "optional fields without omitempty",
+			files: map[string]string{"a.go": "package p\n\ntype UserResponse struct {\n\tName  *string          `json:\"name\"`\n\tTags  []string         `json:\"tags\"`\n\tAttrs map[string]string `json:\"attrs\"`\n}\n"},
+			want:  3,
+		},
+		{
+			name:  "omitempty, values and ignored fields",
+			files: map[string]string{"a.go": "package p\n\ntype UserResponse struct {\n\tName *string  `json:\"name,omitempty\"`\n\tID   int64    `json:\"id\"`\n\tTags []string `json:\"-\"`\n}\n"},
+			want:  0,
+		},
+	})
+}
@@ -0,0 +1,844 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// テストチェック
+// ========================================
+
+// testPackage ディレクトリ（パッケージ）ごとのテストファイル
+type testPackage struct {
+	dir   string
+	files []*FileContext
+}
+
+// testPackages チェック対象のファイルがあるディレクトリの *_test.go を解析して返す
+// exclude_patternsでテストファイルを除外していても対象にする
+func (ctx *ProjectContext) testPackages() []testPackage {
+	if ctx.tests != nil {
+		return ctx.tests
+	}
+	c := ctx.c
+	ctx.tests = []testPackage{}
+	seen := make(map[string]bool)
+	for _, path := range ctx.Files {
+		dir := filepath.Dir(path)
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+
+		entries, err := c.readDir(dir)
+		if err != nil {
+			continue
+		}
+		pkg := testPackage{dir: dir}
+		for _, e := range entries {
+			if e.IsDir() || !strings.HasSuffix(e.Name(), "_test.go") {
+				continue
+			}
+			if fctx := ctx.File(filepath.Join(dir, e.Name())); fctx != nil {
+				pkg.files = append(pkg.files, fctx)
+			}
+		}
+		if len(pkg.files) > 0 {
+			ctx.tests = append(ctx.tests, pkg)
+		}
+	}
+	return ctx.tests
+}
+
+// isTestFunc Test・Benchmark・Fuzz・Exampleで始まる、go testが実行する関数か
+func isTestFunc(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		if rest, ok := strings.CutPrefix(fn.Name.Name, prefix); ok {
+			return rest == "" || !unicode.IsLower([]rune(rest)[0])
+		}
+	}
+	return false
+}
+
+// testingParam *testing.T・*testing.B・*testing.F・testing.TB型の引数名（無ければ空）
+func testingParam(fn *ast.FuncDecl, testingPkg string) string {
+	for _, field := range fn.Type.Params.List {
+		typ := field.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		sel, ok := typ.(*ast.SelectorExpr)
+		if !ok || !isSelector(sel, testingPkg, sel.Sel.Name) || !containsString([]string{"T", "B", "F", "TB"}, sel.Sel.Name) {
+			continue
+		}
+		if _, isPtr := field.Type.(*ast.StarExpr); isPtr == (sel.Sel.Name == "TB") {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name != "_" {
+				return name.Name
+			}
+		}
+	}
+	return ""
+}
+
+// testHelper テストヘルパーの候補（テスト関数以外でtesting型の引数を取る関数）
+type testHelper struct {
+	fn    *ast.FuncDecl
+	fctx  *FileContext
+	param string // testing型の引数名
+}
+
+// checkTHelper 複数の関数から呼び出されるテストヘルパーがt.Helper()を呼び出しているか
+// 呼び出していないと、失敗時の行番号が呼び出し元ではなくヘルパー内を指す
+func (c *Checker) checkTHelper(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.THelper
+	minCallers := rule.MinCallers
+	if minCallers <= 0 {
+		minCallers = 2
+	}
+
+	for _, pkg := range ctx.testPackages() {
+		helpers := pkg.helpers()
+		callers := pkg.helperCallers(helpers)
+		names := make([]string, 0, len(helpers))
+		for name := range helpers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			h := helpers[name]
+			if len(callers[name]) < minCallers || callsTHelper(h.fn.Body, h.param) {
+				continue
+			}
+			pos := h.fctx.Fset.Position(h.fn.Pos())
+			lbrace := h.fctx.Fset.Position(h.fn.Body.Lbrace).Offset + 1
+			c.report.AddViolation(report.Violation{
+				File:       h.fctx.Path,
+				Line:       pos.Line,
+				Column:     pos.Column,
+				Rule:       "t_helper",
+				Category:   "testing",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    fmt.Sprintf("テストヘルパー '%s' は%d個の関数から呼び出されていますが %s.Helper() を呼び出していません", name, len(callers[name]), h.param),
+				Code:       c.getCodeLine(h.fctx.Path, pos.Line),
+				Suggestion: fmt.Sprintf("関数の先頭で %s.Helper() を呼び出し、失敗時に呼び出し元の行が報告されるようにしてください", h.param),
+				Fix: &report.Fix{
+					Description: h.param + ".Helper()を追加",
+					Edits:       []report.TextEdit{{Start: lbrace, End: lbrace, NewText: "\n\t" + h.param + ".Helper()"}},
+				},
+			})
+		}
+	}
+}
+
+// helpers パッケージのテストファイルで宣言されたテストヘルパーの候補（関数名→ヘルパー）
+func (pkg testPackage) helpers() map[string]testHelper {
+	helpers := make(map[string]testHelper)
+	for _, fctx := range pkg.files {
+		testingPkg := importName(fctx.File, "testing")
+		if testingPkg == "" {
+			continue
+		}
+		for _, decl := range fctx.File.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || isTestFunc(fn) {
+				continue
+			}
+			if param := testingParam(fn, testingPkg); param != "" {
+				helpers[fn.Name.Name] = testHelper{fn: fn, fctx: fctx, param: param}
+			}
+		}
+	}
+	return helpers
+}
+
+// helperCallers ヘルパーごとの呼び出し元の関数名（クロージャ内の呼び出しは外側の関数とみなす）
+func (pkg testPackage) helperCallers(helpers map[string]testHelper) map[string]map[string]bool {
+	callers := make(map[string]map[string]bool)
+	for _, fctx := range pkg.files {
+		for _, decl := range fctx.File.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				name, isMethod := calleeName(call)
+				if h, ok := helpers[name]; ok && name != fn.Name.Name && isMethod == (h.fn.Recv != nil) {
+					if callers[name] == nil {
+						callers[name] = make(map[string]bool)
+					}
+					callers[name][fn.Name.Name] = true
+				}
+				return true
+			})
+		}
+	}
+	return callers
+}
+
+// calleeName 呼び出す関数・メソッドの名前と、セレクタ（x.f()）形式の呼び出しか
+func calleeName(call *ast.CallExpr) (string, bool) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name, false
+	case *ast.SelectorExpr:
+		return fun.Sel.Name, true
+	}
+	return "", false
+}
+
+// callsTHelper ブロック内（クロージャを含む）で t.Helper() を呼び出しているか
+func callsTHelper(block *ast.BlockStmt, t string) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isSelector(call.Fun, t, "Helper") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkTestFileExists 本番コードに対応するテストファイルがあるか
+// granularity: file は <name>_test.go、package はディレクトリに1つ以上の *_test.go を要求する
+func (c *Checker) checkTestFileExists(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.TestFileExists
+
+	// ディレクトリ（パッケージ）ごとにテスト対象のファイルをまとめる
+	var dirs []string
+	byDir := make(map[string][]string)
+	for _, path := range ctx.Files {
+		if strings.HasSuffix(path, "_test.go") || c.isAllowedIn(rule.AllowedIn, path) {
+			continue
+		}
+		if !c.config.Settings.SkipGenerated && c.isGenerated(path, c.generatedMarker()) {
+			continue
+		}
+		dir := filepath.Dir(path)
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], path)
+	}
+
+	for _, dir := range dirs {
+		if rule.Granularity == "package" {
+			c.checkPackageTests(ctx, dir, byDir[dir], rule)
+			continue
+		}
+		for _, path := range byDir[dir] {
+			testPath := strings.TrimSuffix(path, ".go") + "_test.go"
+			if _, err := c.statPath(testPath); err == nil {
+				continue
+			}
+			fctx := ctx.File(path)
+			if fctx == nil || !isTestWorthy(fctx.File, rule.MinFunctions) {
+				continue
+			}
+			c.reportMissingTests(fctx,
+				fmt.Sprintf("%s に対応するテストファイルがありません", filepath.Base(path)),
+				fmt.Sprintf("%s を作成してください", filepath.Base(testPath)))
+		}
+	}
+}
+
+// checkPackageTests ディレクトリに *_test.go が1つも無い場合、テスト対象の最初のファイルに報告する
+func (c *Checker) checkPackageTests(ctx *ProjectContext, dir string, files []string, rule rules.TestFileExistsRule) {
+	entries, err := c.readDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), "_test.go") {
+			return
+		}
+	}
+
+	for _, path := range files {
+		fctx := ctx.File(path)
+		if fctx == nil || !isTestWorthy(fctx.File, rule.MinFunctions) {
+			continue
+		}
+		c.reportMissingTests(fctx,
+			fmt.Sprintf("パッケージ '%s' にテストファイル（*_test.go）がありません", fctx.File.Name.Name),
+			fmt.Sprintf("%s にテストを追加してください", c.relPath(dir)))
+		return
+	}
+}
+
+// reportMissingTests テストファイルが無いことをファイルのpackage句の位置に報告する
+func (c *Checker) reportMissingTests(fctx *FileContext, message, suggestion string) {
+	line := fctx.Fset.Position(fctx.File.Package).Line
+	c.report.AddViolation(report.Violation{
+		File:       fctx.Path,
+		Line:       line,
+		Rule:       "test_file_exists",
+		Category:   "testing",
+		Severity:   rules.ParseSeverity(c.config.Testing.Rules.TestFileExists.Severity),
+		Message:    message,
+		Code:       c.getCodeLine(fctx.Path, line),
+		Suggestion: suggestion,
+	})
+}
+
+// isTestWorthy 本体のある関数・メソッドをminFunctions（0以下の場合は1）個以上宣言しているか
+// 型・定数の宣言のみのファイルはテストを要求しない
+func isTestWorthy(file *ast.File, minFunctions int) bool {
+	minFunctions = max(minFunctions, 1)
+	n := 0
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			n++
+		}
+	}
+	return n >= minFunctions
+}
+
+// testifyPaths アサーションとみなすtestifyのパッケージ
+var testifyPaths = []string{"github.com/stretchr/testify/assert", "github.com/stretchr/testify/require"}
+
+// tFailMethods 失敗を報告する*testing.Tのメソッド
+var tFailMethods = []string{"Error", "Errorf", "Fatal", "Fatalf", "Fail", "FailNow"}
+
+// checkTableDriven アサーションや同じ関数の呼び出しを繰り返すテスト関数にテーブル駆動テストを勧める
+// テストケースのスライス・マップをrangeで回しているテストは対象外
+func (c *Checker) checkTableDriven(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.TableDriven
+	maxAssertions, maxSimilar := rule.MaxAssertions, rule.MaxSimilarCalls
+	if maxAssertions <= 0 {
+		maxAssertions = 5
+	}
+	if maxSimilar <= 0 {
+		maxSimilar = 3
+	}
+
+	for _, pkg := range ctx.testPackages() {
+		for _, fctx := range pkg.files {
+			c.checkTableDrivenFile(fctx, maxAssertions, maxSimilar)
+		}
+	}
+}
+
+// checkTableDrivenFile テストファイル内のTest関数を検査する
+func (c *Checker) checkTableDrivenFile(fctx *FileContext, maxAssertions, maxSimilar int) {
+	testingPkg := importName(fctx.File, "testing")
+	if testingPkg == "" {
+		return
+	}
+	var asserters []string
+	for _, path := range testifyPaths {
+		if name := importName(fctx.File, path); name != "" {
+			asserters = append(asserters, name)
+		}
+	}
+
+	for _, decl := range fctx.File.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !isTestFunc(fn) || !strings.HasPrefix(fn.Name.Name, "Test") || rangesOverCases(fn.Body) {
+			continue
+		}
+		t := testingParam(fn, testingPkg)
+		var message string
+		if n := countAssertions(fn.Body, t, asserters); n > maxAssertions {
+			message = fmt.Sprintf("テスト '%s' にはアサーションが%d個あります（上限: %d）", fn.Name.Name, n, maxAssertions)
+		} else if callee, n := repeatedCall(fn.Body, t, asserters); n > maxSimilar {
+			message = fmt.Sprintf("テスト '%s' で %s の呼び出しが%d回繰り返されています（上限: %d）", fn.Name.Name, callee, n, maxSimilar)
+		}
+		if message == "" {
+			continue
+		}
+		pos := fctx.Fset.Position(fn.Pos())
+		c.report.AddViolation(report.Violation{
+			File:       fctx.Path,
+			Line:       pos.Line,
+			Column:     pos.Column,
+			Rule:       "table_driven",
+			Category:   "testing",
+			Severity:   rules.ParseSeverity(c.config.Testing.Rules.TableDriven.Severity),
+			Message:    message,
+			Code:       c.getCodeLine(fctx.Path, pos.Line),
+			Suggestion: "入力と期待値を []struct{...} のテストケースにまとめ、for _, tt := range tests { t.Run(tt.name, ...) } で実行してください",
+		})
+	}
+}
+
+// rangesOverCases テストケース（複合リテラルまたは変数）をrangeで回しているか
+func rangesOverCases(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if rs, ok := n.(*ast.RangeStmt); ok {
+			switch rs.X.(type) {
+			case *ast.CompositeLit, *ast.Ident:
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isAssertion t.Error・t.Fatal等またはtestifyのassert・requireの呼び出しか
+func isAssertion(call *ast.CallExpr, t string, asserters []string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	return (x.Name == t && containsString(tFailMethods, sel.Sel.Name)) || containsString(asserters, x.Name)
+}
+
+// countAssertions 関数内（サブテストを含む）のアサーションの数
+func countAssertions(body *ast.BlockStmt, t string, asserters []string) int {
+	n := 0
+	ast.Inspect(body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok && isAssertion(call, t, asserters) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// repeatedCall 関数直下の文（got := f(...) や f(...)）で最も多く呼び出されている関数とその回数
+// tのメソッドとアサーションは除く
+func repeatedCall(body *ast.BlockStmt, t string, asserters []string) (string, int) {
+	counts := make(map[string]int)
+	best, bestCount := "", 0
+	for _, stmt := range body.List {
+		var expr ast.Expr
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if len(s.Rhs) == 1 {
+				expr = s.Rhs[0]
+			}
+		case *ast.ExprStmt:
+			expr = s.X
+		}
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || isAssertion(call, t, asserters) {
+			continue
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isSelector(sel, t, sel.Sel.Name) {
+			continue
+		}
+		callee := types.ExprString(call.Fun)
+		counts[callee]++
+		if counts[callee] > bestCount {
+			best, bestCount = callee, counts[callee]
+		}
+	}
+	return best, bestCount
+}
+
+// checkTestPackage テストファイルのパッケージが方針（style）に沿っているか
+// external: 公開APIのみを使う内部パッケージのテスト（package x）は外部テストパッケージ（package x_test）にする
+// internal: 外部テストパッケージを使わない
+func (c *Checker) checkTestPackage(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.TestPackage
+
+	for _, pkg := range ctx.testPackages() {
+		prod := ctx.productionFiles(pkg.dir)
+		if len(prod) == 0 {
+			continue
+		}
+		pkgName := prod[0].File.Name.Name
+		var unexported map[string]bool // 本番コードの非公開の識別子（externalの場合のみ、初回に収集）
+
+		for _, fctx := range pkg.files {
+			if c.isAllowedIn(rule.AllowedIn, fctx.Path) {
+				continue
+			}
+			var message, suggestion string
+			switch name := fctx.File.Name.Name; {
+			case rule.Style == "internal" && name == pkgName+"_test":
+				message = fmt.Sprintf("テストは内部テストパッケージ（package %s）で記述してください", pkgName)
+				suggestion = fmt.Sprintf("package %s に変更し、%s. の修飾を外してください", pkgName, pkgName)
+			case rule.Style != "internal" && name == pkgName && pkgName != "main":
+				if unexported == nil {
+					unexported = unexportedNames(prod)
+				}
+				if usesUnexported(fctx.File, unexported) {
+					continue
+				}
+				message = fmt.Sprintf("公開APIのみを使用するテストは外部テストパッケージ（package %s_test）で記述してください", pkgName)
+				suggestion = fmt.Sprintf("package %s_test に変更し、%s をimportしてください", pkgName, pkgName)
+			default:
+				continue
+			}
+
+			line := fctx.Fset.Position(fctx.File.Package).Line
+			c.report.AddViolation(report.Violation{
+				File:       fctx.Path,
+				Line:       line,
+				Rule:       "test_package",
+				Category:   "testing",
+				Severity:   rules.ParseSeverity(rule.Severity),
+				Message:    message,
+				Code:       c.getCodeLine(fctx.Path, line),
+				Suggestion: suggestion,
+			})
+		}
+	}
+}
+
+// productionFiles ディレクトリ内のチェック対象の本番コード（*_test.go以外）
+func (ctx *ProjectContext) productionFiles(dir string) []*FileContext {
+	var files []*FileContext
+	for _, path := range ctx.Files {
+		if filepath.Dir(path) != dir || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		if fctx := ctx.File(path); fctx != nil {
+			files = append(files, fctx)
+		}
+	}
+	return files
+}
+
+// unexportedNames 本番コードで宣言された非公開の識別子（トップレベルの宣言・メソッド・構造体フィールド）
+func unexportedNames(files []*FileContext) map[string]bool {
+	names := make(map[string]bool)
+	add := func(ident *ast.Ident) {
+		if !ident.IsExported() && ident.Name != "_" {
+			names[ident.Name] = true
+		}
+	}
+	for _, fctx := range files {
+		for _, decl := range fctx.File.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				add(d.Name)
+			case *ast.GenDecl:
+				ast.Inspect(d, func(n ast.Node) bool {
+					switch n := n.(type) {
+					case *ast.TypeSpec:
+						add(n.Name)
+					case *ast.ValueSpec:
+						for _, name := range n.Names {
+							add(name)
+						}
+					case *ast.Field:
+						for _, name := range n.Names {
+							add(name)
+						}
+					}
+					return true
+				})
+			}
+		}
+	}
+	return names
+}
+
+// usesUnexported テストファイルが本番コードの非公開の識別子を参照しているか
+// ファイル内で宣言された識別子（Objが解決済み）は除く
+func usesUnexported(file *ast.File, unexported map[string]bool) bool {
+	found := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Obj == nil && unexported[ident.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkTestRatio パッケージごとの本番コードに対するテストコードの比率が下限を下回っていないか
+// テストを実行せずに測れるカバレッジの目安として使う（コメント・空行は行数に含めない）
+func (c *Checker) checkTestRatio(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.TestRatio
+	minRatio := rule.MinRatio
+	if minRatio <= 0 {
+		minRatio = 0.5
+		if rule.Metric == "tests" {
+			minRatio = 1
+		}
+	}
+	minLines := rule.MinLines
+	if minLines <= 0 {
+		minLines = 100
+	}
+	tests := make(map[string]testPackage)
+	for _, pkg := range ctx.testPackages() {
+		tests[pkg.dir] = pkg
+	}
+
+	dirs, prodLines, first := c.productionLines(ctx, rule.AllowedIn)
+	for _, dir := range dirs {
+		if prodLines[dir] < minLines {
+			continue
+		}
+		ratio, detail := tests[dir].ratio(rule.Metric, prodLines[dir])
+		if ratio >= minRatio {
+			continue
+		}
+		fctx := first[dir]
+		line := fctx.Fset.Position(fctx.File.Package).Line
+		c.report.AddViolation(report.Violation{
+			File:       fctx.Path,
+			Line:       line,
+			Rule:       "test_ratio",
+			Category:   "testing",
+			Severity:   rules.ParseSeverity(rule.Severity),
+			Message:    fmt.Sprintf("パッケージ '%s' のテストの比率が%.2fです（%s、下限: %.2f）", fctx.File.Name.Name, ratio, detail, minRatio),
+			Code:       c.getCodeLine(fctx.Path, line),
+			Suggestion: fmt.Sprintf("%s にテストを追加してください", c.relPath(dir)),
+		})
+	}
+}
+
+// productionLines ディレクトリ（パッケージ）ごとの本番コードの行数と、各ディレクトリの最初のファイル
+func (c *Checker) productionLines(ctx *ProjectContext, allowedIn []string) ([]string, map[string]int, map[string]*FileContext) {
+	var dirs []string
+	lines := make(map[string]int)
+	first := make(map[string]*FileContext)
+	for _, path := range ctx.Files {
+		if strings.HasSuffix(path, "_test.go") || c.isAllowedIn(allowedIn, path) {
+			continue
+		}
+		if !c.config.Settings.SkipGenerated && c.isGenerated(path, c.generatedMarker()) {
+			continue
+		}
+		fctx := ctx.File(path)
+		if fctx == nil {
+			continue
+		}
+		dir := filepath.Dir(path)
+		if _, ok := first[dir]; !ok {
+			dirs = append(dirs, dir)
+			first[dir] = fctx
+		}
+		lines[dir] += codeLineCount(fctx.Src)
+	}
+	return dirs, lines, first
+}
+
+// ratio 本番コードの行数に対するテストの比率と、その内訳の説明
+// metric: lines はテストコードの行数の比、tests は本番コード100行あたりのTest関数の数
+func (pkg testPackage) ratio(metric string, prodLines int) (float64, string) {
+	if metric == "tests" {
+		n := pkg.testFuncCount()
+		return float64(n) * 100 / float64(prodLines), fmt.Sprintf("本番コード: %d行、Test関数: %d個", prodLines, n)
+	}
+
+	n := 0
+	for _, fctx := range pkg.files {
+		n += codeLineCount(fctx.Src)
+	}
+	return float64(n) / float64(prodLines), fmt.Sprintf("本番コード: %d行、テストコード: %d行", prodLines, n)
+}
+
+// testFuncCount Test関数の数
+func (pkg testPackage) testFuncCount() int {
+	n := 0
+	for _, fctx := range pkg.files {
+		for _, decl := range fctx.File.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isTestFunc(fn) && strings.HasPrefix(fn.Name.Name, "Test") {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// codeLineCount コメント・空行を除いた行数（トークンを含む行の数）
+func codeLineCount(src []byte) int {
+	fset := token.NewFileSet()
+	tf := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(tf, src, nil, scanner.ScanComments)
+
+	lines := make(map[int]bool)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		// 改行で自動挿入されたセミコロンはその行のトークンとして数えない
+		if tok == token.COMMENT || (tok == token.SEMICOLON && lit == "\n") {
+			continue
+		}
+		lines[tf.Line(pos)] = true
+	}
+	return len(lines)
+}
+
+// checkTestFuncNames テストファイルの関数名を検査する
+// Test・Benchmark・Fuzzの後が小文字の関数はgo testが実行しないため報告し、patternがあればTest関数の名前を照合する
+func (c *Checker) checkTestFuncNames(ctx *ProjectContext) {
+	for _, pkg := range ctx.testPackages() {
+		for _, fctx := range pkg.files {
+			c.checkTestFuncNamesFile(fctx)
+		}
+	}
+}
+
+// checkTestFuncNamesFile テストファイル内のtesting型の引数を取る関数の名前を検査する
+func (c *Checker) checkTestFuncNamesFile(fctx *FileContext) {
+	testingPkg := importName(fctx.File, "testing")
+	if testingPkg == "" {
+		return
+	}
+	for _, decl := range fctx.File.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || testingParam(fn, testingPkg) == "" {
+			continue
+		}
+		if message, suggestion := c.testFuncNameViolation(fn); message != "" {
+			c.reportTestingRule(fctx, fn.Name.Pos(), "test_func_name", c.config.Testing.Rules.TestFuncName.Severity, message, suggestion)
+		}
+	}
+}
+
+// testFuncNameViolation テスト関数の名前の違反（違反が無ければ空）
+func (c *Checker) testFuncNameViolation(fn *ast.FuncDecl) (message, suggestion string) {
+	name := fn.Name.Name
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz"} {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok || rest == "" || !unicode.IsLower([]rune(rest)[0]) {
+			continue
+		}
+		runes := []rune(rest)
+		runes[0] = unicode.ToUpper(runes[0])
+		return "関数 '" + name + "' は" + prefix + "の後が小文字のため、go testで実行されません",
+			"'" + prefix + string(runes) + "' に名前を変更してください"
+	}
+	pattern := c.patterns.TestFuncName
+	if pattern != nil && isTestFunc(fn) && strings.HasPrefix(name, "Test") && !pattern.MatchString(name) {
+		return "テスト関数 '" + name + "' の名前がパターン " + pattern.String() + " に一致しません",
+			"テスト関数の命名規則に従った名前に変更してください"
+	}
+	return "", ""
+}
+
+// checkTParallel テスト関数（subtestsの場合はt.Runのサブテストも）でt.Parallel()を呼び出しているか
+// require_inが空の場合はすべてのテストファイルを対象とする
+func (c *Checker) checkTParallel(ctx *ProjectContext) {
+	rule := c.config.Testing.Rules.TParallel
+	for _, pkg := range ctx.testPackages() {
+		for _, fctx := range pkg.files {
+			if len(rule.RequireIn) == 0 || c.isAllowedIn(rule.RequireIn, fctx.Path) {
+				c.checkTParallelFile(fctx, rule.Subtests)
+			}
+		}
+	}
+}
+
+// checkTParallelFile テストファイル内のTest関数を検査する（t.Setenv・t.Chdirを使うテストは並行実行できないため対象外）
+func (c *Checker) checkTParallelFile(fctx *FileContext, subtests bool) {
+	testingPkg := importName(fctx.File, "testing")
+	if testingPkg == "" {
+		return
+	}
+	severity := c.config.Testing.Rules.TParallel.Severity
+	for _, decl := range fctx.File.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !isTestFunc(fn) || !strings.HasPrefix(fn.Name.Name, "Test") || changesProcessState(fn.Body) {
+			continue
+		}
+		t := testingParam(fn, testingPkg)
+		if t == "" {
+			continue
+		}
+		if !callsDirectly(fn.Body, t, "Parallel") {
+			c.reportTestingRule(fctx, fn.Name.Pos(), "t_parallel", severity,
+				"テスト '"+fn.Name.Name+"' で"+t+".Parallel()を呼び出していません",
+				"テスト関数の先頭で "+t+".Parallel() を呼び出してください")
+		}
+		if subtests {
+			c.checkParallelSubtests(fctx, fn.Body, testingPkg, severity)
+		}
+	}
+}
+
+// checkParallelSubtests t.Run(name, func(t *testing.T) {...}) のサブテストでt.Parallel()を呼び出しているか
+func (c *Checker) checkParallelSubtests(fctx *FileContext, body *ast.BlockStmt, testingPkg, severity string) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		if name, isMethod := calleeName(call); !isMethod || name != "Run" {
+			return true
+		}
+		lit, ok := call.Args[1].(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		t := testingParam(&ast.FuncDecl{Type: lit.Type}, testingPkg)
+		if t != "" && !callsDirectly(lit.Body, t, "Parallel") {
+			c.reportTestingRule(fctx, lit.Pos(), "t_parallel", severity,
+				"サブテストで"+t+".Parallel()を呼び出していません",
+				"サブテストの関数の先頭で "+t+".Parallel() を呼び出してください")
+		}
+		return true
+	})
+}
+
+// callsDirectly ブロック内（関数リテラルを除く）で x.method() を呼び出しているか
+func callsDirectly(block *ast.BlockStmt, x, method string) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && isSelector(call.Fun, x, method) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// changesProcessState テスト（サブテストを含む）でt.Setenv・t.Chdirを呼び出しているか（t.Parallel()と併用できない）
+func changesProcessState(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			name, isMethod := calleeName(call)
+			found = isMethod && (name == "Setenv" || name == "Chdir")
+		}
+		return !found
+	})
+	return found
+}
+
+// reportTestingRule テストファイルの違反を報告する
+func (c *Checker) reportTestingRule(fctx *FileContext, at token.Pos, rule, severity, message, suggestion string) {
+	pos := fctx.Fset.Position(at)
+	c.report.AddViolation(report.Violation{
+		File:       fctx.Path,
+		Line:       pos.Line,
+		Column:     pos.Column,
+		Rule:       rule,
+		Category:   "testing",
+		Severity:   rules.ParseSeverity(severity),
+		Message:    message,
+		Code:       c.getCodeLine(fctx.Path, pos.Line),
+		Suggestion: suggestion,
+	})
+}
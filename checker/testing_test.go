@@ -0,0 +1,291 @@
+package checker
+
+import "testing"
+
+func TestTestFileExists(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    test_file_exists:
+      enabled: true
+      severity: "info"
+      granularity: "file"
+      allowed_in: ["**/cmd/**"]
+`
+	runRuleTests(t, config, "test_file_exists", []ruleTest{
+		{
+			name:  "function without test file",
+			files: map[string]string{"user.go": "package p\n\nfunc Find(id string) string { return id }\n"},
+			want:  1,
+		},
+		{
+			name: "test file, declarations only and allowed path",
+			files: map[string]string{
+				"user.go":          "package p\n\nfunc Find(id string) string { return id }\n",
+				"user_test.go":     "package p\n",
+				"types.go":         "package p\n\ntype User struct{ ID string }\n",
+				"cmd/tool/main.go": "package main\n\nfunc main() {}\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestTHelper(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    t_helper:
+      enabled: true
+      severity: "warning"
+`
+	const tests = `
+func TestA(t *testing.T) { mustEqual(t, 1, 1) }
+
+func TestB(t *testing.T) { mustEqual(t, 2, 2) }
+`
+	runRuleTests(t, config, "t_helper", []ruleTest{
+		{
+			name: "shared helper without t.Helper",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func mustEqual(t *testing.T, got, want int) {
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+` + tests},
+			want: 1,
+		},
+		{
+			name: "helper calls t.Helper",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func mustEqual(t *testing.T, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+` + tests},
+			want: 0,
+		},
+	})
+}
+
+func TestTableDriven(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    table_driven:
+      enabled: true
+      severity: "info"
+      max_similar_calls: 2
+`
+	runRuleTests(t, config, "table_driven", []ruleTest{
+		{
+			name: "repeated calls",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func double(n int) int { return n * 2 }
+
+func TestDouble(t *testing.T) {
+	a := double(1)
+	b := double(2)
+	c := double(3)
+	if a+b+c != 12 {
+		t.Error("unexpected sum")
+	}
+}
+`},
+			want: 1,
+		},
+		{
+			name: "test cases in a table",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func double(n int) int { return n * 2 }
+
+func TestDouble(t *testing.T) {
+	tests := []struct{ in, want int }{{1, 2}, {2, 4}, {3, 6}}
+	for _, tt := range tests {
+		if got := double(tt.in); got != tt.want {
+			t.Errorf("double(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestTestPackage(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    test_package:
+      enabled: true
+      severity: "info"
+      style: "external"
+`
+	const prod = "package user\n\nfunc Find(id string) string { return normalize(id) }\n\nfunc normalize(s string) string { return s }\n"
+	runRuleTests(t, config, "test_package", []ruleTest{
+		{
+			name: "internal test using only exported API",
+			files: map[string]string{
+				"user.go":      prod,
+				"user_test.go": "package user\n\nimport \"testing\"\n\nfunc TestFind(t *testing.T) { Find(\"1\") }\n",
+			},
+			want: 1,
+		},
+		{
+			name: "external test and internal test of unexported code",
+			files: map[string]string{
+				"user.go":           prod,
+				"user_test.go":      "package user_test\n\nimport \"testing\"\n\nfunc TestFind(t *testing.T) {}\n",
+				"normalize_test.go": "package user\n\nimport \"testing\"\n\nfunc TestNormalize(t *testing.T) { normalize(\"1\") }\n",
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestTestRatio(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    test_ratio:
+      enabled: true
+      severity: "info"
+      metric: "lines"
+      min_ratio: 0.5
+      min_lines: 4
+`
+	const prod = "package p\n\nfunc A() int { return 1 }\n\nfunc B() int { return 2 }\n\nfunc C() int { return 3 }\n"
+	runRuleTests(t, config, "test_ratio", []ruleTest{
+		{
+			name: "too little test code",
+			files: map[string]string{
+				"a.go":      prod,
+				"a_test.go": "package p\n",
+			},
+			want: 1,
+		},
+		{
+			name: "enough test code",
+			files: map[string]string{
+				"a.go": prod,
+				"a_test.go": `package p
+
+import "testing"
+
+func TestA(t *testing.T) {
+	if A()+B()+C() != 6 {
+		t.Fail()
+	}
+}
+`,
+			},
+			want: 0,
+		},
+	})
+}
+
+func TestTestFuncName(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    test_func_name:
+      enabled: true
+      severity: "warning"
+      pattern: "^Test[A-Z][A-Za-z0-9]*(_[A-Za-z0-9]+)*$"
+`
+	runRuleTests(t, config, "test_func_name", []ruleTest{
+		{
+			name: "lower case after Test and pattern mismatch",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func Testfind(t *testing.T) {}
+
+func TestFind__Empty(t *testing.T) {}
+`},
+			want: 2,
+		},
+		{
+			name: "names run by go test",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func TestFind(t *testing.T) {}
+
+func TestFind_Empty(t *testing.T) {}
+
+func BenchmarkFind(b *testing.B) {}
+`},
+			want: 0,
+		},
+	})
+}
+
+func TestTParallel(t *testing.T) {
+	const config = `
+testing:
+  enabled: true
+  rules:
+    t_parallel:
+      enabled: true
+      severity: "info"
+      subtests: true
+`
+	runRuleTests(t, config, "t_parallel", []ruleTest{
+		{
+			name: "test and subtest without t.Parallel",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {})
+}
+`},
+			want: 2,
+		},
+		{
+			name: "parallel tests and t.Setenv",
+			files: map[string]string{"a_test.go": `package p
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+	})
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("HOME", "/tmp")
+}
+`},
+			want: 0,
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package checker
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ========================================
+// ルール・ファイルごとの処理時間の計測
+// ========================================
+//
+// チェッカー自体の性能劣化を調べるため、ルールごと・ファイルごとの処理時間を集計する
+// 計測しない場合（Timingsを設定しない場合）は時刻を取得しない
+
+// Timings ルール・ファイルごとの処理時間（複数のgoroutine・Checkerで共有してよい）
+type Timings struct {
+	mu    sync.Mutex
+	rules map[string]time.Duration // "category/name" → 処理時間の合計
+	files map[string]time.Duration // ファイル → 読み込み・解析・チェックの処理時間
+}
+
+// Timing 処理時間
+type Timing struct {
+	Name     string
+	Duration time.Duration
+}
+
+// NewTimings 処理時間の集計を作成
+func NewTimings() *Timings {
+	return &Timings{
+		rules: make(map[string]time.Duration),
+		files: make(map[string]time.Duration),
+	}
+}
+
+// SetTimings ルール・ファイルごとの処理時間をtに集計する（nilの場合は計測しない）
+func (c *Checker) SetTimings(t *Timings) {
+	c.timings = t
+}
+
+// SlowestRules 処理時間の長いルール（最大n件、0以下の場合はすべて）
+func (t *Timings) SlowestRules(n int) []Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slowest(t.rules, n)
+}
+
+// SlowestFiles 処理時間の長いファイル（最大n件、0以下の場合はすべて）
+func (t *Timings) SlowestFiles(n int) []Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slowest(t.files, n)
+}
+
+// slowest 処理時間の降順に並べる
+func slowest(m map[string]time.Duration, n int) []Timing {
+	list := make([]Timing, 0, len(m))
+	for name, d := range m {
+		list = append(list, Timing{Name: name, Duration: d})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Duration != list[j].Duration {
+			return list[i].Duration > list[j].Duration
+		}
+		return list[i].Name < list[j].Name
+	})
+	if n > 0 && len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// newClock 1ファイル分のルールの処理時間を計測する（計測しない場合はnil）
+// ファイル単位のルール → ノード単位のルールの順に添字でルールを識別する
+func (t *Timings) newClock(set ruleSet) *ruleClock {
+	if t == nil {
+		return nil
+	}
+	rules := make([]Rule, 0, len(set.file)+len(set.node))
+	for _, rule := range set.file {
+		rules = append(rules, rule)
+	}
+	for _, rule := range set.node {
+		rules = append(rules, rule)
+	}
+	return &ruleClock{rules: rules, times: make([]time.Duration, len(rules))}
+}
+
+// addFile 1ファイル分の処理時間を集計に加える
+func (t *Timings) addFile(path string, d time.Duration, clock *ruleClock) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.files[path] += d
+	if clock == nil {
+		return
+	}
+	for i, rule := range clock.rules {
+		t.rules[rule.Category()+"/"+rule.Name()] += clock.times[i]
+	}
+}
+
+// addRule ルールの処理時間を集計に加える（プロジェクト単位のルール用）
+func (t *Timings) addRule(rule Rule, start time.Time) {
+	if t == nil {
+		return
+	}
+	d := time.Since(start)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rules[rule.Category()+"/"+rule.Name()] += d
+}
+
+// now 計測する場合のみ現在時刻を返す
+func (t *Timings) now() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// ruleClock ワーカーが1ファイルのチェック中にルールの処理時間を集める（ロックなしで加算する）
+type ruleClock struct {
+	rules []Rule
+	times []time.Duration
+}
+
+// start 計測する場合のみ現在時刻を返す
+func (k *ruleClock) start() time.Time {
+	if k == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// stop startからの経過時間をi番目のルールに加える
+func (k *ruleClock) stop(i int, start time.Time) {
+	if k == nil {
+		return
+	}
+	k.times[i] += time.Since(start)
+}
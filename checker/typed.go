@@ -0,0 +1,329 @@
+package checker
+
+import (
+	"context"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ========================================
+// 型情報付き解析（-typed モード）
+// ========================================
+
+// loadTypes 対象ファイルをパッケージ単位で型チェックし、型情報を収集する
+// go/packages（go list）でモジュール・ビルドタグに従ってパッケージと依存パッケージを読み込む
+// goコマンドが使えない等で読み込めなかったファイルは、依存をソースからimportして型チェックする
+// 型エラーは警告として出力し、型チェックは可能な範囲で続行する（型が得られなかった式はnilとして扱う）
+// ctxがキャンセルされた場合は型チェック中のパッケージの完了を待たずに打ち切る
+func (c *Checker) loadTypes(ctx context.Context, goFiles []string) {
+	c.info = newTypesInfo()
+
+	files := make(map[string]*ast.File)
+	for _, filePath := range goFiles {
+		if ctx.Err() != nil {
+			return
+		}
+		if file, err := c.parseFile(filePath); err == nil {
+			files[filePath] = file
+		}
+	}
+
+	// モジュールごとに読み込み、モジュール外のファイル・読み込めなかったファイルはソースからの型チェックに回す
+	byModule := make(map[string][]string)
+	rootOf := make(map[string]string)
+	for filePath := range files {
+		dir := filepath.Dir(filePath)
+		root, ok := rootOf[dir]
+		if !ok {
+			root = moduleRoot(dir)
+			rootOf[dir] = root
+		}
+		byModule[root] = append(byModule[root], filePath)
+	}
+	roots := make([]string, 0, len(byModule))
+	for root := range byModule {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+
+	var rest []string
+	for _, root := range roots {
+		if ctx.Err() != nil {
+			return
+		}
+		var handled map[string]bool
+		if root != "" {
+			handled = c.loadPackages(ctx, root, byModule[root], files)
+		}
+		for _, filePath := range byModule[root] {
+			if !handled[filePath] {
+				rest = append(rest, filePath)
+			}
+		}
+	}
+	sort.Strings(rest)
+	c.checkFromSource(ctx, rest, files)
+}
+
+// loadPackages モジュールのファイルをgo/packagesで読み込み、型チェックしたファイルとビルドタグで除外されたファイルを返す
+// 構文木はチェックと同じもの（c.parseFile）を使うため、型情報をそのままルールから参照できる
+func (c *Checker) loadPackages(ctx context.Context, root string, targets []string, files map[string]*ast.File) map[string]bool {
+	dirs := make(map[string]bool)
+	tests := false
+	for _, filePath := range targets {
+		dirs[filepath.Dir(filePath)] = true
+		tests = tests || strings.HasSuffix(filePath, "_test.go")
+	}
+	patterns := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		patterns = append(patterns, dir)
+	}
+	sort.Strings(patterns)
+
+	cfg := &packages.Config{
+		Context: ctx,
+		// 依存パッケージもソースから型チェックする（エクスポートデータはツールチェーンのバージョンに依存するため使わない）
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedImports | packages.NeedDeps |
+			packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:     root,
+		Fset:    c.fset,
+		Tests:   tests,
+		Overlay: c.absOverlay(),
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			if file, ok := files[filename]; ok {
+				return file, nil
+			}
+			return parser.ParseFile(fset, filename, src, parser.AllErrors|parser.ParseComments)
+		},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.warnf("typed: %s のパッケージを読み込めないため、依存をソースからimportして型チェックします: %v", root, err)
+		}
+		return nil
+	}
+
+	// テスト用のパッケージ（p [p.test]）はテスト以外のファイルも含むため、同じファイルはテスト用の型情報を使う
+	// ビルドタグで除外されたファイルは型チェックしない
+	owner := make(map[string]*packages.Package)
+	handled := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, filePath := range pkg.IgnoredFiles {
+			handled[filePath] = true
+		}
+		if strings.HasSuffix(pkg.ID, ".test") || pkg.Types == nil {
+			continue
+		}
+		for _, filePath := range pkg.CompiledGoFiles {
+			if _, ok := files[filePath]; !ok {
+				continue
+			}
+			if owner[filePath] == nil || strings.Contains(pkg.ID, " [") {
+				owner[filePath] = pkg
+			}
+		}
+	}
+	merged := make(map[*packages.Package]bool)
+	for _, filePath := range targets {
+		pkg := owner[filePath]
+		if pkg == nil {
+			continue
+		}
+		handled[filePath] = true
+		if merged[pkg] {
+			continue
+		}
+		merged[pkg] = true
+		mergeTypesInfo(c.info, pkg.TypesInfo)
+		if len(pkg.Errors) > 0 {
+			c.warnTypeErrors(pkg.PkgPath, len(pkg.Errors), pkg.Errors[0])
+		}
+	}
+	return handled
+}
+
+// checkFromSource ディレクトリ＋パッケージ名ごとに、依存をソースからimportして型チェックする
+func (c *Checker) checkFromSource(ctx context.Context, targets []string, files map[string]*ast.File) {
+	// ディレクトリ＋パッケージ名でグルーピング（外部テストパッケージを分離）
+	pkgFiles := make(map[string][]*ast.File)
+	for _, filePath := range targets {
+		file := files[filePath]
+		key := filepath.Dir(filePath) + "\x00" + file.Name.Name
+		pkgFiles[key] = append(pkgFiles[key], file)
+	}
+
+	keys := make([]string, 0, len(pkgFiles))
+	for key := range pkgFiles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return
+		}
+		dir := key[:strings.IndexByte(key, 0)]
+		path := c.importPath(filepath.Join(dir, "x.go"))
+		if path == "" {
+			path = filepath.Base(dir)
+		}
+
+		// go/typesは途中で中断できないため、パッケージごとの型情報に集めて完了したものだけを取り込む
+		// （キャンセルした場合、実行中の型チェックは結果を使わずに最後まで続く）
+		info := newTypesInfo()
+		var errs []error
+		conf := types.Config{
+			Importer: importer.ForCompiler(c.fset, "source", nil),
+			Error:    func(err error) { errs = append(errs, err) }, // 解決できない依存等があっても続行する
+		}
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = conf.Check(path, c.fset, pkgFiles[key], info)
+		}()
+		select {
+		case <-done:
+			mergeTypesInfo(c.info, info)
+			if len(errs) > 0 {
+				c.warnTypeErrors(path, len(errs), errs[0])
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// warnTypeErrors パッケージの型エラーを警告する（最初のエラーと件数のみ）
+func (c *Checker) warnTypeErrors(pkgPath string, n int, first error) {
+	if n == 1 {
+		c.warnf("typed: %s の型チェックでエラーがありました（型が得られない式は型情報を使うルールの対象外になります）: %v", pkgPath, first)
+		return
+	}
+	c.warnf("typed: %s の型チェックで%d件のエラーがありました（型が得られない式は型情報を使うルールの対象外になります）: %v", pkgPath, n, first)
+}
+
+// absOverlay SetOverlayで指定したファイルの内容（go/packagesに渡すため絶対パスにする）
+func (c *Checker) absOverlay() map[string][]byte {
+	if c.overlay == nil {
+		return nil
+	}
+	overlay := make(map[string][]byte, len(c.overlay))
+	for path, src := range c.overlay {
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		overlay[path] = src
+	}
+	return overlay
+}
+
+// moduleRoot dirを含むモジュールのルート（go.modが無い場合は空）
+func moduleRoot(dir string) string {
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return ""
+		}
+		d = parent
+	}
+}
+
+// newTypesInfo 収集する型情報
+func newTypesInfo() *types.Info {
+	return &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+}
+
+// mergeTypesInfo srcの型情報をdstに取り込む
+func mergeTypesInfo(dst, src *types.Info) {
+	for k, v := range src.Types {
+		dst.Types[k] = v
+	}
+	for k, v := range src.Defs {
+		dst.Defs[k] = v
+	}
+	for k, v := range src.Uses {
+		dst.Uses[k] = v
+	}
+	for k, v := range src.Implicits {
+		dst.Implicits[k] = v
+	}
+	for k, v := range src.Selections {
+		dst.Selections[k] = v
+	}
+	for k, v := range src.Scopes {
+		dst.Scopes[k] = v
+	}
+}
+
+// typeOf 式の型を返す（型情報が無い場合はnil）
+func (c *Checker) typeOf(expr ast.Expr) types.Type {
+	if c.info == nil {
+		return nil
+	}
+	return c.info.TypeOf(expr)
+}
+
+// objectOf 識別子が参照・定義するオブジェクトを返す（型情報が無い場合はnil）
+func (c *Checker) objectOf(ident *ast.Ident) types.Object {
+	if c.info == nil {
+		return nil
+	}
+	return c.info.ObjectOf(ident)
+}
+
+// calleeFunc 呼び出し先の関数・メソッドを返す（型情報が無い場合や関数値の呼び出しはnil）
+func (c *Checker) calleeFunc(call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fn := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fn
+	case *ast.SelectorExpr:
+		ident = fn.Sel
+	default:
+		return nil
+	}
+	f, _ := c.objectOf(ident).(*types.Func)
+	return f
+}
+
+// isErrorType 型がerrorインタフェースを実装しているか
+func isErrorType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	errType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	return types.Implements(t, errType)
+}
+
+// isNamedType 型（ポインタを含む）が pkgPath.name の名前付き型か
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Name() == name && obj.Pkg() != nil && obj.Pkg().Path() == pkgPath
+}
@@ -0,0 +1,87 @@
+package checker
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestLoadTypes(t *testing.T) {
+	const config = `
+settings:
+  typed: true
+concurrency:
+  enabled: true
+  rules:
+    lock_copy:
+      enabled: true
+      severity: "error"
+`
+	const gomod = "module example.com/p\n\ngo 1.21\n"
+	const counter = "package p\n\nimport \"sync\"\n\ntype counter struct {\n\tmu sync.Mutex\n\tn  int\n}\n"
+	const copyCounter = "package p\n\nfunc f(c *counter) int {\n\tcopied := *c\n\treturn copied.n\n}\n"
+	tests := []struct {
+		name        string
+		files       map[string]string
+		want        int    // lock_copyの違反の数
+		wantWarning string // 警告に含まれる文字列（空の場合は警告なし）
+	}{
+		{
+			name: "type from package in same module",
+			files: map[string]string{
+				"go.mod":                  gomod,
+				"internal/store/store.go": "package store\n\nimport \"sync\"\n\ntype Store struct {\n\tmu sync.Mutex\n\tN  int\n}\n",
+				"a.go":                    "package p\n\nimport \"example.com/p/internal/store\"\n\nfunc f(s *store.Store) int {\n\tcopied := *s\n\treturn copied.N\n}\n",
+			},
+			want: 1,
+		},
+		{
+			name: "declarations split by build tags",
+			files: map[string]string{
+				"go.mod":        gomod,
+				"counter_a.go":  "//go:build linux\n\n" + counter,
+				"counter_b.go":  "//go:build !linux\n\n" + counter,
+				"copy.go":       copyCounter,
+				"copy_test.go":  "package p\n\nimport \"testing\"\n\nfunc TestF(t *testing.T) { f(&counter{}) }\n",
+				"other_test.go": "package p_test\n\nimport \"testing\"\n\nfunc TestOther(t *testing.T) {}\n",
+			},
+			want: 1,
+		},
+		{
+			name: "type error reported",
+			files: map[string]string{
+				"go.mod":  gomod,
+				"a.go":    counter + "\nvar n int = \"zero\"\n",
+				"copy.go": copyCounter,
+			},
+			want:        1,
+			wantWarning: "example.com/p の型チェックでエラーがありました",
+		},
+		{
+			name:  "without go.mod",
+			files: map[string]string{"a.go": counter, "copy.go": copyCounter},
+			want:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, tt.files)
+			var warnings bytes.Buffer
+			c := NewChecker(loadTestConfig(t, config))
+			c.SetLogger(log.New(&warnings, "", 0))
+			rep, err := c.Check(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := countRule(rep, "lock_copy"); got != tt.want {
+				t.Errorf("lock_copy violations = %d, want %d: %+v", got, tt.want, rep.Violations)
+			}
+			if tt.wantWarning == "" && warnings.Len() > 0 || !strings.Contains(warnings.String(), tt.wantWarning) {
+				t.Errorf("warnings = %q, want %q", warnings.String(), tt.wantWarning)
+			}
+		})
+	}
+}
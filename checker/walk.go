@@ -0,0 +1,228 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ========================================
+// チェック対象ファイルの走査
+// ========================================
+
+// generatedMarker 自動生成ファイルの標準のマーカー（https://go.dev/s/generatedcode）
+var generatedMarker = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// goFileWalker チェック対象のGoファイルを走査する
+type goFileWalker struct {
+	c         *Checker
+	ctx       context.Context
+	root      string
+	fn        func(path string)
+	rootReal  string          // シンボリックリンクを解決したルート（follow_symlinks時のみ）
+	visited   map[string]bool // 辿ったリンク先ディレクトリ（循環・重複防止）
+	generated *regexp.Regexp  // 自動生成ファイルのマーカー（skip_generated時のみ）
+	skipped   int             // 自動生成のためスキップしたファイル数
+	walked    map[string]bool // 走査で見つけたファイル（SetOverlayの指定時のみ）
+}
+
+// walkGoFiles チェック対象のGoファイルを見つけるたびにfnを呼び出す
+// 戻り値は自動生成ファイルとしてスキップしたファイル数（ctxがキャンセルされた場合は走査を打ち切りctx.Err()を返す）
+func (c *Checker) walkGoFiles(ctx context.Context, dir string, fn func(path string)) (int, error) {
+	w := &goFileWalker{c: c, ctx: ctx, root: dir, fn: fn}
+	if c.config.Settings.FollowSymlinks && c.fsys == nil {
+		w.visited = make(map[string]bool)
+		if real, err := filepath.EvalSymlinks(dir); err == nil {
+			w.rootReal = real
+		}
+	}
+	if c.config.Settings.SkipGenerated {
+		w.generated = c.generatedMarker()
+	}
+	if c.pkgFiles != nil {
+		// SetPackageのファイルのみ（ディレクトリは走査しない）
+		for _, path := range c.pkgFiles {
+			w.visit(path)
+		}
+		return w.skipped, nil
+	}
+	if c.overlay != nil {
+		w.walked = make(map[string]bool)
+	}
+	if err := w.walk(dir, dir); err != nil {
+		return w.skipped, err
+	}
+
+	// ディスク上に存在しないSetOverlayのファイル（エディタで作成した未保存のファイル等）
+	for _, path := range c.unwalkedOverlay(dir, w.walked) {
+		w.visit(path)
+	}
+	return w.skipped, nil
+}
+
+// generatedMarker 自動生成ファイルを判定する正規表現（generated_markerが空の場合は標準のマーカー）
+func (c *Checker) generatedMarker() *regexp.Regexp {
+	if c.patterns != nil && c.patterns.GeneratedMarker != nil {
+		return c.patterns.GeneratedMarker
+	}
+	return generatedMarker
+}
+
+// walk realDirを走査し、realDir配下のパスをshownDir配下のパスとして扱う
+// （シンボリックリンク先はリンクのパスで報告する）
+func (w *goFileWalker) walk(realDir, shownDir string) error {
+	return w.c.walkDir(realDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := w.ctx.Err(); err != nil {
+			return err
+		}
+		path = shownDir + strings.TrimPrefix(path, realDir)
+
+		// ディレクトリはスキップ判定のみ
+		if d.IsDir() {
+			if path != w.root && w.skipDir(path, d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// シンボリックリンクのディレクトリ
+		if d.Type()&fs.ModeSymlink != 0 && w.visited != nil {
+			if target, ok := w.linkedDir(path); ok {
+				if w.skipDir(path, d.Name()) {
+					return nil
+				}
+				return w.walk(target, path)
+			}
+		}
+
+		if w.walked != nil {
+			w.walked[filepath.Clean(path)] = true
+		}
+		w.visit(path)
+		return nil
+	})
+}
+
+// visit チェック対象のファイルであればfnを呼び出す
+func (w *goFileWalker) visit(path string) {
+	if !w.include(path) {
+		return
+	}
+	if w.generated != nil && w.isGenerated(path) {
+		w.skipped++
+		return
+	}
+	w.fn(path)
+}
+
+// skipDir ディレクトリを走査しないか
+func (w *goFileWalker) skipDir(path, name string) bool {
+	settings := w.c.config.Settings
+	if settings.SkipHiddenDirs && strings.HasPrefix(name, ".") && name != "." && name != ".." {
+		return true
+	}
+	if settings.SkipTestdata && name == "testdata" {
+		return true
+	}
+
+	// 除外パターンにマッチするディレクトリをスキップ
+	for _, pattern := range settings.ExcludePatterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+		if matched, _ := filepath.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// linkedDir シンボリックリンク先が未走査のディレクトリであれば解決したパスを返す
+// ルート配下を指すリンクは通常の走査で対象になるため辿らない
+func (w *goFileWalker) linkedDir(path string) (string, bool) {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", false
+	}
+	info, err := os.Stat(target)
+	if err != nil || !info.IsDir() {
+		return "", false
+	}
+	if w.rootReal != "" && isDirOrParent(w.rootReal, target) {
+		return "", false
+	}
+	for dir := range w.visited {
+		if isDirOrParent(dir, target) {
+			return "", false
+		}
+	}
+	w.visited[target] = true
+	return target, true
+}
+
+// include ファイルがチェック対象か
+func (w *goFileWalker) include(path string) bool {
+	// .goファイルのみ
+	if !strings.HasSuffix(path, ".go") {
+		return false
+	}
+
+	// 除外パターンチェック
+	relPath, _ := filepath.Rel(w.root, path)
+	for _, pattern := range w.c.config.Settings.ExcludePatterns {
+		if matched, _ := filepath.Match(pattern, filepath.Base(path)); matched {
+			return false
+		}
+		if matched, _ := filepath.Match(pattern, relPath); matched {
+			return false
+		}
+	}
+
+	// チェック対象が限定されている場合は含まれるファイルのみ
+	return w.c.files == nil || w.c.files[filepath.Clean(path)]
+}
+
+// isGenerated ファイル先頭のコメント（package句より前）に自動生成のマーカーがあるか
+func (w *goFileWalker) isGenerated(path string) bool {
+	return w.c.isGenerated(path, w.generated)
+}
+
+// isGenerated ファイル先頭のコメント（package句より前）にmarkerに一致する行があるか
+func (c *Checker) isGenerated(path string, marker *regexp.Regexp) bool {
+	f, err := c.openFile(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), 1024*1024)
+	inBlock := false // /* */ コメントの途中
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if marker.MatchString(line) {
+			return true
+		}
+		trimmed := strings.TrimSpace(line)
+		if inBlock {
+			inBlock = !strings.Contains(trimmed, "*/")
+			continue
+		}
+		switch {
+		case trimmed == "", strings.HasPrefix(trimmed, "//"):
+		case strings.HasPrefix(trimmed, "/*"):
+			inBlock = !strings.Contains(trimmed[2:], "*/")
+		default:
+			// package句（コメント以外）に達した
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// runCompare compare サブコマンド（2つのJSON形式のレポートの新規・修正済み・変化なしの違反を表示）
+func runCompare(args []string) int {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	outputJSON := fs.Bool("json", false, "JSON形式で出力")
+	failOn := fs.String("fail-on", "none", "この重要度以上の新規の違反があれば終了コード1 (error, warning, info, none)")
+	lang := fs.String("lang", report.LanguageJapanese, "エラーの言語 ("+strings.Join(report.Languages(), ", ")+")")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: go-standards-checker compare [-json] [-fail-on level] [-lang ja|en] old.json new.json")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	cliLang = *lang
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+	if !rules.ValidFailOn(*failOn) {
+		fprintf(os.Stderr, "Error: -fail-on に指定できるのは error, warning, info, none です: %s\n", *failOn)
+		return 1
+	}
+
+	oldPath, newPath := fs.Arg(0), fs.Arg(1)
+	old, err := report.LoadReport(oldPath)
+	if err != nil {
+		fprintf(os.Stderr, "Error: レポートの読み込みに失敗しました: %v\n", err)
+		return 1
+	}
+	cur, err := report.LoadReport(newPath)
+	if err != nil {
+		fprintf(os.Stderr, "Error: レポートの読み込みに失敗しました: %v\n", err)
+		return 1
+	}
+
+	cmp := report.Compare(oldPath, old, newPath, cur)
+	if *outputJSON {
+		data, err := cmp.ToJSON()
+		if err != nil {
+			fprintf(os.Stderr, "Error: JSON出力に失敗しました: %v\n", err)
+			return 1
+		}
+		fmt.Println(data)
+	} else {
+		fmt.Print(cmp.ToText())
+	}
+
+	if *failOn != "none" && *failOn != "" && cmp.NewAtLeast(rules.ParseSeverity(*failOn)) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ========================================
+// cron形式のスケジュール
+// ========================================
+
+// schedule 実行時刻の判定
+type schedule interface {
+	// due nowに実行すべきか（lastは前回の実行時刻、未実行の場合はゼロ値）
+	due(now, last time.Time) bool
+}
+
+// cronSchedule 「分 時 日 月 曜日」の5フィールドのcron式
+type cronSchedule struct {
+	minute, hour, dom, month, dow []bool
+	anyDom, anyDow                bool // 日・曜日が * の場合（両方指定時はいずれかに一致すれば実行）
+}
+
+// everySchedule @every <間隔>（前回の実行から一定時間ごと）
+type everySchedule struct {
+	interval time.Duration
+}
+
+// cronMacros cronのマクロ
+var cronMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// parseSchedule cron式を解析する
+func parseSchedule(expr string) (schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d < time.Minute {
+			return nil, fmt.Errorf("invalid @every interval %q (1m以上)", rest)
+		}
+		return everySchedule{interval: d}, nil
+	}
+	if macro, ok := cronMacros[expr]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q (分 時 日 月 曜日の5フィールド)", expr)
+	}
+	s := &cronSchedule{anyDom: fields[2] == "*", anyDow: fields[4] == "*"}
+	var err error
+	for i, f := range []struct {
+		dst      *[]bool
+		min, max int
+	}{{&s.minute, 0, 59}, {&s.hour, 0, 23}, {&s.dom, 1, 31}, {&s.month, 1, 12}, {&s.dow, 0, 7}} {
+		if *f.dst, err = parseCronField(fields[i], f.min, f.max); err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+	}
+	s.dow[0] = s.dow[0] || s.dow[7] // 7も日曜日
+	return s, nil
+}
+
+// parseCronField 「*」「1,2」「1-5」「*/15」「10-30/5」の形式のフィールドを解析する
+func parseCronField(field string, min, max int) ([]bool, error) {
+	set := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rng, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step %q", part)
+			}
+			step = n
+		}
+
+		lo, hi, err := parseCronRange(rng, hasStep, min, max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", part)
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("value out of range %q (%d-%d)", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// parseCronRange 「*」「5」「1-5」の範囲（「5/10」のように間隔を指定した場合は5から最大値まで）
+func parseCronRange(rng string, hasStep bool, min, max int) (lo, hi int, err error) {
+	if rng == "*" {
+		return min, max, nil
+	}
+	from, to, isRange := strings.Cut(rng, "-")
+	if lo, err = strconv.Atoi(from); err != nil {
+		return 0, 0, err
+	}
+	switch {
+	case isRange:
+		hi, err = strconv.Atoi(to)
+	case hasStep:
+		hi = max
+	default:
+		hi = lo
+	}
+	return lo, hi, err
+}
+
+// due 現在時刻（分単位）がcron式に一致し、同じ分にまだ実行していないか
+func (s *cronSchedule) due(now, last time.Time) bool {
+	now = now.Truncate(time.Minute)
+	if !last.IsZero() && !last.Truncate(time.Minute).Before(now) {
+		return false
+	}
+	if !s.minute[now.Minute()] || !s.hour[now.Hour()] || !s.month[int(now.Month())] {
+		return false
+	}
+	dom, dow := s.dom[now.Day()], s.dow[int(now.Weekday())]
+	switch {
+	case s.anyDom && s.anyDow:
+		return true
+	case s.anyDom:
+		return dow
+	case s.anyDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// due 前回の実行から間隔が経過したか（未実行の場合はすぐに実行）
+func (s everySchedule) due(now, last time.Time) bool {
+	return last.IsZero() || now.Sub(last) >= s.interval
+}
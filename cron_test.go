@@ -0,0 +1,126 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// at 2024年の指定日時（ローカルタイム）
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.Local)
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "*/15 * * * *"},
+		{expr: "0 9-17 * * 1-5"},
+		{expr: "0,30 8 1,15 * *"},
+		{expr: "10-30/5 * * * *"},
+		{expr: "5/20 * * * *"},
+		{expr: "0 0 * * 7"},
+		{expr: "@hourly"},
+		{expr: "@daily"},
+		{expr: "@midnight"},
+		{expr: "@weekly"},
+		{expr: "@monthly"},
+		{expr: "@every 90m"},
+		{expr: "  @every 1h  "},
+		{expr: "", wantErr: true},
+		{expr: "* * * *", wantErr: true},
+		{expr: "* * * * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "* 24 * * *", wantErr: true},
+		{expr: "* * 0 * *", wantErr: true},
+		{expr: "* * 32 * *", wantErr: true},
+		{expr: "* * * 13 *", wantErr: true},
+		{expr: "* * * * 8", wantErr: true},
+		{expr: "30-10 * * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "*/x * * * *", wantErr: true},
+		{expr: "a * * * *", wantErr: true},
+		{expr: "1-x * * * *", wantErr: true},
+		{expr: "@yearly", wantErr: true},
+		{expr: "@every 30s", wantErr: true},
+		{expr: "@every soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		_, err := parseSchedule(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSchedule(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCronScheduleDue(t *testing.T) {
+	// 2024-06-03は月曜日、2024-06-09は日曜日
+	tests := []struct {
+		name string
+		expr string
+		now  time.Time
+		last time.Time
+		want bool
+	}{
+		{name: "every 15 minutes on step", expr: "*/15 * * * *", now: at(6, 3, 10, 45), want: true},
+		{name: "every 15 minutes off step", expr: "*/15 * * * *", now: at(6, 3, 10, 46), want: false},
+		{name: "seconds within matching minute", expr: "30 10 * * *", now: at(6, 3, 10, 30).Add(59 * time.Second), want: true},
+		{name: "minute before", expr: "30 10 * * *", now: at(6, 3, 10, 29).Add(59 * time.Second), want: false},
+		{name: "minute after", expr: "30 10 * * *", now: at(6, 3, 10, 31), want: false},
+		{name: "already run in same minute", expr: "30 10 * * *", now: at(6, 3, 10, 30).Add(40 * time.Second), last: at(6, 3, 10, 30).Add(5 * time.Second), want: false},
+		{name: "last run in previous minute", expr: "* * * * *", now: at(6, 3, 10, 30), last: at(6, 3, 10, 29).Add(59 * time.Second), want: true},
+		{name: "range of hours", expr: "0 9-17 * * *", now: at(6, 3, 17, 0), want: true},
+		{name: "outside range of hours", expr: "0 9-17 * * *", now: at(6, 3, 18, 0), want: false},
+		{name: "step from offset", expr: "5/20 * * * *", now: at(6, 3, 10, 45), want: true},
+		{name: "range with step", expr: "10-30/10 * * * *", now: at(6, 3, 10, 40), want: false},
+		{name: "month mismatch", expr: "0 0 * 7 *", now: at(6, 3, 0, 0), want: false},
+		{name: "weekday matches", expr: "0 9 * * 1-5", now: at(6, 3, 9, 0), want: true},
+		{name: "weekend excluded", expr: "0 9 * * 1-5", now: at(6, 9, 9, 0), want: false},
+		{name: "7 is sunday", expr: "0 9 * * 7", now: at(6, 9, 9, 0), want: true},
+		{name: "0 is sunday", expr: "0 9 * * 0", now: at(6, 9, 9, 0), want: true},
+		{name: "7 is not monday", expr: "0 9 * * 7", now: at(6, 3, 9, 0), want: false},
+		{name: "day of month only", expr: "0 9 3 * *", now: at(6, 3, 9, 0), want: true},
+		{name: "day of month only mismatch", expr: "0 9 4 * *", now: at(6, 3, 9, 0), want: false},
+		{name: "dom or dow: dom matches", expr: "0 9 3 * 5", now: at(6, 3, 9, 0), want: true},
+		{name: "dom or dow: dow matches", expr: "0 9 15 * 1", now: at(6, 3, 9, 0), want: true},
+		{name: "dom or dow: neither matches", expr: "0 9 15 * 5", now: at(6, 3, 9, 0), want: false},
+		{name: "hourly macro", expr: "@hourly", now: at(6, 3, 13, 0), want: true},
+		{name: "daily macro off hour", expr: "@daily", now: at(6, 3, 13, 0), want: false},
+		{name: "weekly macro on sunday", expr: "@weekly", now: at(6, 9, 0, 0), want: true},
+		{name: "monthly macro", expr: "@monthly", now: at(6, 1, 0, 0), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseSchedule(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.due(tt.now, tt.last); got != tt.want {
+				t.Errorf("due(%v, %v) = %v, want %v", tt.now, tt.last, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEveryScheduleDue(t *testing.T) {
+	s, err := parseSchedule("@every 90m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := at(6, 3, 10, 0)
+	tests := []struct {
+		name string
+		last time.Time
+		want bool
+	}{
+		{name: "never run", want: true},
+		{name: "interval elapsed", last: now.Add(-90 * time.Minute), want: true},
+		{name: "interval not elapsed", last: now.Add(-89 * time.Minute), want: false},
+	}
+	for _, tt := range tests {
+		if got := s.due(now, tt.last); got != tt.want {
+			t.Errorf("%s: due = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
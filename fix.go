@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-standards-checker/report"
+)
+
+// runFix 修正可能な違反を修正する（-fix）
+// dryRunの場合はファイルを変更せず、修正後との差分をunified diffで標準出力に書き出す
+// 修正した違反の数を返す
+func runFix(rep *report.Report, dryRun bool, status io.Writer) (int, error) {
+	changes, skipped, err := report.PlanFixes(rep.Violations)
+	if err != nil {
+		return 0, err
+	}
+
+	fixed, files := 0, 0
+	for _, fc := range changes {
+		if dryRun {
+			fmt.Print(rep.Diff(fc))
+		} else if err := fc.Apply(); err != nil {
+			fprintf(os.Stderr, "Warning: 修正を適用できませんでした: %v\n", err)
+			skipped += fc.Fixes
+			continue
+		}
+		fixed += fc.Fixes
+		files++
+	}
+
+	verb := "Fixed"
+	if dryRun {
+		verb = "Would fix"
+	}
+	fmt.Fprintf(status, "🔧 %s %d violations in %d files", verb, fixed, files)
+	if skipped > 0 {
+		fmt.Fprintf(status, " (%d skipped: overlapping or unformattable)", skipped)
+	}
+	fmt.Fprintln(status)
+	return fixed, nil
+}
@@ -1,5 +1,12 @@
 module github.com/go-standards-checker
 
-go 1.23
+go 1.24.0
 
 require gopkg.in/yaml.v3 v3.0.1
+
+require golang.org/x/tools v0.38.0
+
+require (
+	golang.org/x/mod v0.29.0 // indirect
+	golang.org/x/sync v0.17.0 // indirect
+)
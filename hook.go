@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+)
+
+// hookMarker このツールが生成したgit hookの目印
+const hookMarker = "# installed by go-standards-checker"
+
+// hookScripts hookの種類→スクリプト
+var hookScripts = map[string]string{
+	"pre-commit": `#!/bin/sh
+%s
+# ステージされたGoファイルのみをチェックし、errorがあればコミットを中止する
+exec %s -staged -s error
+`,
+	"pre-push": `#!/bin/sh
+%s
+# upstreamとの差分のGoファイルのみをチェックし、errorがあればプッシュを中止する
+upstream=$(git rev-parse --abbrev-ref --symbolic-full-name '@{upstream}' 2>/dev/null || echo origin/HEAD)
+exec %s -changed "$upstream" -s error
+`,
+}
+
+// runInstallHook install-hook サブコマンド
+func runInstallHook(args []string) int {
+	fs := flag.NewFlagSet("install-hook", flag.ExitOnError)
+	prePush := fs.Bool("pre-push", false, "pre-commitの代わりにpre-push hookを設定")
+	uninstall := fs.Bool("uninstall", false, "設定したhookを削除")
+	force := fs.Bool("force", false, "既存のhookを上書き")
+	command := fs.String("command", "go-standards-checker", "hookから実行するコマンド")
+	configPath := fs.String("config", "", "hookで使用する設定ファイルのパス")
+	lang := fs.String("lang", report.LanguageJapanese, "表示の言語 ("+strings.Join(report.Languages(), ", ")+")")
+	fs.Parse(args)
+	cliLang = *lang
+
+	hookName := "pre-commit"
+	if *prePush {
+		hookName = "pre-push"
+	}
+
+	hooksDir, err := gitOutput(".", "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		fprintf(os.Stderr, "Error: gitリポジトリが見つかりません: %v\n", err)
+		return 1
+	}
+	hookPath := filepath.Join(hooksDir, hookName)
+
+	existing, err := os.ReadFile(hookPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		fprintf(os.Stderr, "Error: %s の読み込みに失敗しました: %v\n", hookPath, err)
+		return 1
+	}
+	ours := bytes.Contains(existing, []byte(hookMarker))
+
+	if *uninstall {
+		if existing == nil {
+			fprintf(os.Stdout, "%s は設定されていません\n", hookName)
+			return 0
+		}
+		if !ours {
+			fprintf(os.Stderr, "Error: %s はgo-standards-checkerが設定したhookではありません\n", hookPath)
+			return 1
+		}
+		if err := os.Remove(hookPath); err != nil {
+			fprintf(os.Stderr, "Error: %s の削除に失敗しました: %v\n", hookPath, err)
+			return 1
+		}
+		fmt.Printf("🗑  Removed: %s\n", hookPath)
+		return 0
+	}
+
+	if existing != nil && !ours && !*force {
+		fprintf(os.Stderr, "Error: %s が既に存在します（上書きする場合は -force を指定してください）\n", hookPath)
+		return 1
+	}
+
+	commandLine := shellQuote(*command)
+	if *configPath != "" {
+		commandLine += " -c " + shellQuote(*configPath)
+	}
+	script := fmt.Sprintf(hookScripts[hookName], hookMarker, commandLine)
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		fprintf(os.Stderr, "Error: %s の作成に失敗しました: %v\n", hooksDir, err)
+		return 1
+	}
+	if err := os.WriteFile(hookPath, []byte(script), 0755); err != nil {
+		fprintf(os.Stderr, "Error: %s の書き込みに失敗しました: %v\n", hookPath, err)
+		return 1
+	}
+	fmt.Printf("✅ Installed: %s\n", hookPath)
+	return 0
+}
+
+// changedGoFiles gitで変更されたGoファイルの絶対パスを返す
+// stagedの場合はステージされたファイル、それ以外はrefとの分岐点から作業ツリーまでの差分のファイル（未コミットの変更を含む）
+func changedGoFiles(dir string, staged bool, ref string) ([]string, error) {
+	root, err := gitOutput(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"diff", "--name-only", "--diff-filter=ACMR"}
+	if staged {
+		args = append(args, "--cached")
+	} else {
+		args = append(args, "--merge-base", ref)
+	}
+	out, err := gitOutput(root, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, name := range strings.Split(out, "\n") {
+		if strings.HasSuffix(name, ".go") {
+			files = append(files, filepath.Join(root, filepath.FromSlash(name)))
+		}
+	}
+	return files, nil
+}
+
+// stagedContents ステージされたファイルの内容（インデックスのblob）を絶対パスごとに返す
+func stagedContents(dir string, files []string) (map[string][]byte, error) {
+	root, err := gitOutput(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	contents := make(map[string][]byte, len(files))
+	for _, file := range files {
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return nil, err
+		}
+		src, err := gitBytes(root, "show", ":"+filepath.ToSlash(rel))
+		if err != nil {
+			return nil, err
+		}
+		contents[file] = src
+	}
+	return contents, nil
+}
+
+// hunkHeaderRe unified diffのハンクの見出し（@@ -a,b +c,d @@）の変更後の範囲
+var hunkHeaderRe = regexp.MustCompile(`^@@ -[0-9,]+ \+([0-9]+)(?:,([0-9]+))? @@`)
+
+// changedLines refとの分岐点から作業ツリーまでの差分でGoファイルに追加・変更された行（-diff）
+// チェックする作業ツリーの内容と行番号を合わせるため、未コミットの変更も含める
+func changedLines(dir, ref string) (report.ChangedLines, error) {
+	root, err := gitOutput(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	out, err := gitOutput(root, "diff", "-U0", "--no-color", "--no-prefix", "--diff-filter=ACMR", "--merge-base", ref, "--", "*.go")
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(report.ChangedLines)
+	file := ""
+	for _, line := range strings.Split(out, "\n") {
+		if name, ok := strings.CutPrefix(line, "+++ "); ok {
+			file = filepath.Join(root, filepath.FromSlash(name))
+			continue
+		}
+		m := hunkHeaderRe.FindStringSubmatch(line)
+		if m == nil || file == "" {
+			continue
+		}
+		start, _ := strconv.Atoi(m[1])
+		count := 1
+		if m[2] != "" {
+			count, _ = strconv.Atoi(m[2])
+		}
+		changed.Add(file, start, count)
+	}
+	return changed, nil
+}
+
+// gitOutput gitコマンドを実行し、前後の空白を除いた標準出力を返す
+func gitOutput(dir string, args ...string) (string, error) {
+	out, err := gitBytes(dir, args...)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gitBytes gitコマンドを実行し、標準出力をそのまま返す
+func gitBytes(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// repoRoot 注釈の形式のパスの基準（dirを含むgitリポジトリのルート、gitで管理していない場合はカレントディレクトリ）
+// gitはシンボリックリンクを解決したパスを返すため、dirから同じ階層だけ遡ったパスを返して違反のパスと揃える
+func repoRoot(dir string) string {
+	top, err := gitOutput(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		if wd, err := os.Getwd(); err == nil {
+			return wd
+		}
+		return dir
+	}
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return top
+	}
+	rel, err := filepath.Rel(top, resolved)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return top
+	}
+	root := dir
+	if rel != "." {
+		for range strings.Split(rel, string(filepath.Separator)) {
+			root = filepath.Dir(root)
+		}
+	}
+	return root
+}
+
+// shellQuote シェルの単一引用符でクォート
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-standards-checker/report"
+)
+
+// cliLang CLIの表示・警告・エラーの言語（-lang・settings.language。設定の読み込み前は -lang のみ）
+var cliLang string
+
+// fprintf 書式に従って組み立てた文言を出力言語に翻訳して出力
+func fprintf(w io.Writer, format string, args ...any) {
+	fmt.Fprint(w, report.Localize(cliLang, fmt.Sprintf(format, args...)))
+}
+
+// fprintln 文言を出力言語に翻訳し、改行を付けて出力
+func fprintln(w io.Writer, s string) {
+	fmt.Fprintln(w, report.Localize(cliLang, s))
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/go-standards-checker/report"
+)
+
+// formatVerbRe 書式指定子
+var formatVerbRe = regexp.MustCompile(`%[-+# 0-9.*\[\]]*[a-zA-Z]`)
+
+// hasJapanese 平仮名・片仮名・漢字を含むか
+func hasJapanese(s string) bool {
+	for _, r := range s {
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+			return true
+		}
+	}
+	return false
+}
+
+// cliMessage 呼び出しの書式・文言の引数（文字列リテラル）を、埋め込む値を "x" として組み立てる
+func cliMessage(call *ast.CallExpr, index int) (string, bool) {
+	if len(call.Args) <= index {
+		return "", false
+	}
+	lit, ok := call.Args[index].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING || strings.HasPrefix(lit.Value, "`") {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	s = strings.ReplaceAll(s, "%%", "\x00")
+	s = formatVerbRe.ReplaceAllString(s, "x")
+	return strings.ReplaceAll(s, "\x00", "%"), true
+}
+
+// callName 呼び出す関数の名前（fmt.Fprintf・fprintf等）
+func callName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		if x, ok := fn.X.(*ast.Ident); ok {
+			return x.Name + "." + fn.Sel.Name
+		}
+	}
+	return ""
+}
+
+// TestCLIMessagesLocalized CLIの表示・警告・エラーがカタログで英語に翻訳でき、fmtで直接出力していないか
+// （-help の説明等の生文字列リテラルは対象外）
+func TestCLIMessagesLocalized(t *testing.T) {
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	checked := 0
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			pos := fset.Position(call.Pos())
+			var msg string
+			switch name := callName(call); name {
+			case "fprintf", "fprintln":
+				if msg, ok = cliMessage(call, 1); !ok {
+					t.Errorf("%s: %s with a non-literal message", pos, name)
+					return true
+				}
+			case "fmt.Errorf", "errors.New":
+				msg, _ = cliMessage(call, 0)
+			case "fmt.Printf", "fmt.Println", "fmt.Print":
+				if msg, _ := cliMessage(call, 0); hasJapanese(msg) {
+					t.Errorf("%s: %s prints %q without localizing (use fprintf/fprintln)", pos, name, msg)
+				}
+				return true
+			case "fmt.Fprintf", "fmt.Fprintln", "fmt.Fprint":
+				if msg, _ := cliMessage(call, 1); hasJapanese(msg) {
+					t.Errorf("%s: %s prints %q without localizing (use fprintf/fprintln)", pos, name, msg)
+				}
+				return true
+			default:
+				return true
+			}
+			if !hasJapanese(msg) {
+				return true
+			}
+			checked++
+			if got := report.Localize(report.LanguageEnglish, msg); hasJapanese(got) {
+				t.Errorf("%s: no English translation for %q (got %q)", pos, msg, got)
+			}
+			return true
+		})
+	}
+	if checked == 0 {
+		t.Fatal("no messages found")
+	}
+}
+
+func TestFprintfLocalizes(t *testing.T) {
+	defer func(lang string) { cliLang = lang }(cliLang)
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{lang: report.LanguageJapanese, want: "\nError: チェックに失敗しました: extends が循環しています: a.yaml\n"},
+		{lang: report.LanguageEnglish, want: "\nError: check failed: extends is circular: a.yaml\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			cliLang = tt.lang
+			var b strings.Builder
+			fprintf(&b, "\nError: チェックに失敗しました: %v\n", "extends が循環しています: a.yaml")
+			if got := b.String(); got != tt.want {
+				t.Errorf("fprintf = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
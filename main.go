@@ -1,45 +1,125 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/go-standards-checker/checker"
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/report"
 	"github.com/go-standards-checker/rules"
 )
 
 const version = "1.0.0"
 
 func main() {
+	// go vet -vettool からの呼び出し
+	if isVetToolInvocation(os.Args[1:]) {
+		runVetTool()
+	}
+
+	// サブコマンド
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "install-hook":
+			os.Exit(runInstallHook(os.Args[2:]))
+		case "trend":
+			os.Exit(runTrend(os.Args[2:]))
+		case "compare":
+			os.Exit(runCompare(os.Args[2:]))
+		}
+	}
+
 	// コマンドライン引数
 	var (
 		configPath  string
 		targetDir   string
 		outputJSON  bool
+		outputHTML  bool
+		format      string
 		minSeverity string
+		failOn      string
 		showVersion bool
 		initConfig  bool
+		typed       bool
+		staged      bool
+		changedRef  string
+		diffRef     string
+		diffLines   bool
+		noCache     bool
+		clearCache  bool
+		cacheDir    string
+		stream      bool
+		cpuProfile  string
+		memProfile  string
+		timing      bool
+		historyPath string
+		againstRef  string
+		ownersMode  string
+		serveAddr   string
+		baseline    string
+		fix         bool
+		fixDryRun   bool
+		stdinMode   bool
+		stdinFile   string
+		modules     bool
+		lang        string
 	)
 
-	flag.StringVar(&configPath, "config", "", "設定ファイルのパス (デフォルト: ./go-standards.yaml)")
+	flag.StringVar(&configPath, "config", "", "設定ファイルのパス・URL・プリセット名 (デフォルト: ./go-standards.yaml)")
 	flag.StringVar(&configPath, "c", "", "設定ファイルのパス (短縮形)")
 	flag.StringVar(&targetDir, "target", ".", "チェック対象ディレクトリ")
 	flag.StringVar(&targetDir, "t", ".", "チェック対象ディレクトリ (短縮形)")
 	flag.BoolVar(&outputJSON, "json", false, "JSON形式で出力")
+	flag.BoolVar(&outputHTML, "html", false, "HTML形式で出力（1ファイルで完結するレポート。CIの成果物向け）")
+	flag.StringVar(&format, "format", "", "出力形式 ("+strings.Join(report.FormatNames(), ", ")+"。デフォルト: settings.report_format)")
 	flag.StringVar(&minSeverity, "severity", "info", "最小重要度フィルター (error, warning, info)")
 	flag.StringVar(&minSeverity, "s", "info", "最小重要度フィルター (短縮形)")
+	flag.StringVar(&failOn, "fail-on", "", "この重要度以上の違反があれば終了コード1 (error, warning, info, none。デフォルト: settings.fail_on または error)")
 	flag.BoolVar(&showVersion, "version", false, "バージョン表示")
 	flag.BoolVar(&showVersion, "v", false, "バージョン表示 (短縮形)")
 	flag.BoolVar(&initConfig, "init", false, "設定ファイルのテンプレートを生成")
+	flag.BoolVar(&typed, "typed", false, "型情報付きで解析（パッケージ単位で型チェック）")
+	flag.BoolVar(&staged, "staged", false, "gitでステージされたGoファイルのみをチェック")
+	flag.BoolVar(&stream, "stream", false, "違反を見つけた順にJSON Lines形式で出力（サマリーは出力しない）")
+	flag.BoolVar(&noCache, "no-cache", false, "解析結果のキャッシュを使用しない")
+	flag.BoolVar(&clearCache, "clear-cache", false, "チェック前に解析結果のキャッシュ（キャッシュディレクトリ内のエントリ）を削除する")
+	flag.StringVar(&cacheDir, "cache-dir", "", "解析結果のキャッシュディレクトリ (デフォルト: settings.cache_dir または ~/.cache/go-standards-checker)")
+	flag.StringVar(&changedRef, "changed", "", "指定したgitのref（ブランチ・コミット）との差分のGoファイルのみをチェック")
+	flag.StringVar(&diffRef, "diff", "", "指定したgitのrefとの差分のGoファイルのみをチェックし、追加・変更された行の違反のみを報告する（PRのゲート向け）")
+	flag.BoolVar(&diffLines, "diff-lines", true, "-diff で追加・変更された行の違反のみを報告する（falseの場合は変更ファイルのすべての違反）")
+	flag.StringVar(&cpuProfile, "cpuprofile", "", "CPUプロファイルを指定したファイルに書き込む（go tool pprofで解析）")
+	flag.StringVar(&memProfile, "memprofile", "", "チェック後のヒーププロファイルを指定したファイルに書き込む")
+	flag.BoolVar(&timing, "timing", false, "処理時間の長いルール・ファイルを標準エラー出力に表示")
+	flag.StringVar(&againstRef, "against", "", "指定したgitのrefを同じ設定でチェックし、重み付きの違反数が増えた場合のみ失敗する（ラチェット）")
+	flag.StringVar(&ownersMode, "owners", "", "違反に担当者を割り当てる（codeowners: CODEOWNERS、blame: git blameの最終更新者）")
+	flag.StringVar(&serveAddr, "serve", "", "デーモンモードで起動し、settings.serve.schedules に従って定期的にチェックした結果をHTTP APIで公開する（例: :8080）")
+	flag.StringVar(&baseline, "baseline", "", "ベースラインファイルにある違反を除き、新たな違反のみを報告する（write <file> で現在の違反をベースラインとして書き込む）")
+	flag.BoolVar(&fix, "fix", false, "自動修正できる違反（ファイル名・JSONタグ・センチネルエラー名・fmt.Println等）を修正し、gofmtで整形する")
+	flag.BoolVar(&fixDryRun, "fix-dry-run", false, "-fix で行う修正をファイルを変更せずunified diffで表示する")
+	flag.BoolVar(&modules, "modules", false, "ターゲット配下のgo.modのあるディレクトリ（モジュール）をそれぞれの設定でチェックし、モジュールごと・全体の結果を出力する")
+	flag.BoolVar(&stdinMode, "stdin", false, "チェック対象のファイルの内容を標準入力から読み込み、そのファイルの違反のみを1件1行で出力する（エディタの未保存のバッファ向け）")
+	flag.StringVar(&stdinFile, "stdin-filename", "", "-stdin で読み込む内容のファイルのパス（ターゲットディレクトリ配下のパス）")
+	flag.StringVar(&lang, "lang", "", "違反のメッセージ・レポートの言語 ("+strings.Join(report.Languages(), ", ")+"。デフォルト: settings.language または ja)")
+	flag.StringVar(&historyPath, "history", "", "実行結果のサマリー（日時・コミット・件数・スコア）を追記する履歴ファイル（例: "+report.DefaultHistoryFile+"）")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Go Standards Checker v%s
 Go言語API開発標準ドキュメントへの準拠をチェックするツール
 
 Usage:
-  go-standards-checker [options] [target-directory]
+  go-standards-checker [options] [target-directory...]
+  go-standards-checker install-hook [-pre-push] [-uninstall] [-force] [-command path] [-config path] [-lang ja|en]
+  go-standards-checker trend [-history path] [-n count] [-json] [-lang ja|en]
+  go-standards-checker compare [-json] [-fail-on level] [-lang ja|en] old.json new.json
+  go vet -vettool=$(which go-standards-checker) [-standards.config path] [-standards.severity level] [packages]
 
 Options:
 `, version)
@@ -55,23 +135,111 @@ Examples:
   # カスタム設定ファイルを使用
   go-standards-checker -c ./my-rules.yaml
 
+  # 組み込みのプリセット（strict / standard / relaxed）でチェック
+  go-standards-checker -c strict
+
   # エラーのみ表示
   go-standards-checker -s error
 
+  # 警告でも失敗（CIで警告を許容しない）
+  go-standards-checker -fail-on warning
+
+  # 既存の違反をベースラインに記録し、以降は新たな違反のみを報告
+  go-standards-checker -baseline write baseline.json
+  go-standards-checker -baseline baseline.json
+
+  # 自動修正できる違反を修正（-fix-dry-run は差分の表示のみ）
+  go-standards-checker -fix-dry-run
+  go-standards-checker -fix
+
+  # キャッシュを削除してからチェック（-no-cache はキャッシュを使用しない）
+  go-standards-checker -clear-cache
+
   # JSON形式で出力
   go-standards-checker -json
 
+  # HTML形式のレポートをファイルに出力
+  go-standards-checker -html > report.html
+
+  # JUnit XML・Checkstyle XML形式で出力（Jenkins・GitLab等のCI向け）
+  go-standards-checker -format junit > standards-junit.xml
+  go-standards-checker -format checkstyle > checkstyle.xml
+
+  # PRの差分にインラインで表示（GitHub Actionsの注釈・reviewdog）
+  go-standards-checker -format github
+  go-standards-checker -format rdjson | reviewdog -f=rdjson -reporter=github-pr-review
+
+  # エディタの未保存のバッファをチェック（ファイル:行:列: 重要度 ルール メッセージ）
+  cat internal/app/app.go | go-standards-checker -stdin -stdin-filename internal/app/app.go
+
+  # 違反を見つけた順にJSON Lines形式で出力
+  go-standards-checker -stream
+
+  # 型情報付きで解析
+  go-standards-checker -typed
+
+  # 複数のモジュールをそれぞれの設定でチェックし、全体の結果を集計（終了コードは1つ）
+  go-standards-checker ./svc-a ./svc-b
+  go-standards-checker -modules .
+
   # 設定ファイルのテンプレートを生成
   go-standards-checker -init
 
+  # ステージされたファイル / mainとの差分のファイルのみをチェック
+  go-standards-checker -staged
+  go-standards-checker -changed main
+
+  # origin/mainとの差分で追加・変更された行の違反のみを報告（PRのゲート）
+  go-standards-checker -diff origin/main
+
+  # CODEOWNERSから違反の担当チームを求め、担当者別に集計
+  go-standards-checker -owners codeowners
+
+  # mainより違反が増えた場合のみ失敗（既存の違反は許容）
+  go-standards-checker -against main
+
+  # 実行履歴を記録し、推移を表示
+  go-standards-checker -history .gostandards-history.json
+  go-standards-checker trend
+
+  # 違反のメッセージ・レポートを英語で出力
+  go-standards-checker -lang en
+
+  # 2つのJSONレポートを比較し、新規・修正済み・変化なしの違反を表示（新規のエラーがあれば失敗）
+  go-standards-checker compare -fail-on error base.json head.json
+
+  # デーモンモード（設定のスケジュールで定期チェックし、結果をHTTP APIで公開）
+  go-standards-checker -serve :8080 -c go-standards.yaml
+
+  # チェッカー自体の性能を調査
+  go-standards-checker -timing -cpuprofile cpu.out
+  go tool pprof cpu.out
+
+  # git hookを設定（コミット時にステージされたファイルをチェック）
+  go-standards-checker install-hook
+  go-standards-checker install-hook -pre-push
+
+  # go vet からパッケージごとに実行
+  go vet -vettool=$(which go-standards-checker) ./...
+
 Categories:
   - naming:         命名規則
   - structure:      コード構造（行数、ネスト等）
   - error_handling: エラーハンドリング
   - logging:        ログ出力
+  - performance:    パフォーマンス
+  - context:        context.Contextの扱い
+  - concurrency:    並行処理（goroutine等）
+  - security:       セキュリティ
   - directory:      ディレクトリ構成
   - struct_tags:    構造体タグ
   - architecture:   レイヤーアーキテクチャ
+  - aws_lambda:     AWS Lambda
+  - testing:        テスト
+  - grpc:           gRPC
+  - comments:       コメント（TODO/FIXME）
+  - documentation:  ドキュメントコメント（godoc）
+  - interfaces:     インタフェースの設計（メソッド数・実装の数・引数名）
   - custom:         カスタムルール
 
 Severity Levels:
@@ -82,6 +250,7 @@ Severity Levels:
 	}
 
 	flag.Parse()
+	cliLang = lang
 
 	// バージョン表示
 	if showVersion {
@@ -95,9 +264,28 @@ Severity Levels:
 		os.Exit(0)
 	}
 
-	// 位置引数があればターゲットディレクトリとして使用
-	if flag.NArg() > 0 {
-		targetDir = flag.Arg(0)
+	// JSON Lines出力時は標準出力を違反のみ、修正の差分表示時は差分のみ、テキスト以外の形式ではレポートのみにする
+	// -stdin ではエディタが出力を読み込むため、違反以外は出力しない
+	var status io.Writer = os.Stdout
+	if stream || fixDryRun || outputJSON || outputHTML || (format != "" && format != "text") {
+		status = os.Stderr
+	}
+	if stdinMode {
+		status = io.Discard
+	}
+
+	// 位置引数があればターゲットディレクトリとして使用（-baseline write の場合は先頭が書き込み先）
+	args := flag.Args()
+	writeBaseline := baseline == "write"
+	if writeBaseline {
+		if len(args) == 0 {
+			fprintln(os.Stderr, "Error: -baseline write にはベースラインファイルのパスを指定してください")
+			os.Exit(1)
+		}
+		baseline, args = args[0], args[1:]
+	}
+	if len(args) > 0 {
+		targetDir = args[0]
 	}
 
 	// 設定読み込み
@@ -107,7 +295,7 @@ Severity Levels:
 	if configPath != "" {
 		cfg, err = rules.LoadConfig(configPath)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: 設定ファイルの読み込みに失敗しました: %v\n", err)
+			fprintf(os.Stderr, "Error: 設定ファイルの読み込みに失敗しました: %v\n", err)
 			os.Exit(1)
 		}
 	} else {
@@ -123,9 +311,9 @@ Severity Levels:
 			if _, err := os.Stat(path); err == nil {
 				cfg, err = rules.LoadConfig(path)
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: %s の読み込みに失敗しました: %v\n", path, err)
+					fprintf(os.Stderr, "Warning: %s の読み込みに失敗しました: %v\n", path, err)
 				} else {
-					fmt.Printf("📋 Using config: %s\n", path)
+					fmt.Fprintf(status, "📋 Using config: %s\n", path)
 					break
 				}
 			}
@@ -134,7 +322,7 @@ Severity Levels:
 		// 設定ファイルが見つからない場合はデフォルト設定
 		if cfg == nil {
 			cfg = rules.DefaultConfig()
-			fmt.Println("📋 Using default configuration")
+			fmt.Fprintln(status, "📋 Using default configuration")
 		}
 	}
 
@@ -143,250 +331,395 @@ Severity Levels:
 		cfg.Settings.MinSeverity = minSeverity
 	}
 
+	// 終了コードの判定基準をコマンドラインから上書き
+	if failOn != "" {
+		cfg.Settings.FailOn = failOn
+	}
+	if !rules.ValidFailOn(cfg.Settings.FailOn) {
+		fprintf(os.Stderr, "Error: fail_on に指定できるのは error, warning, info, none です: %s\n", cfg.Settings.FailOn)
+		os.Exit(1)
+	}
+
 	// JSON出力設定
 	if outputJSON {
 		cfg.Settings.ReportFormat = "json"
 	}
+	if outputHTML {
+		cfg.Settings.ReportFormat = "html"
+	}
+	if format != "" {
+		cfg.Settings.ReportFormat = format
+	}
+	if stdinMode && format == "" && !outputJSON && !outputHTML {
+		cfg.Settings.ReportFormat = "line"
+	}
+	if cfg.Settings.ReportFormat == "" {
+		cfg.Settings.ReportFormat = "text"
+	}
+	if _, ok := report.LookupFormatter(cfg.Settings.ReportFormat); !ok {
+		fprintf(os.Stderr, "Error: 出力形式に指定できるのは %s です: %s\n", strings.Join(report.FormatNames(), ", "), cfg.Settings.ReportFormat)
+		os.Exit(1)
+	}
+	if lang != "" {
+		cfg.Settings.Language = lang
+	}
+	if !report.ValidLanguage(cfg.Settings.Language) {
+		fprintf(os.Stderr, "Error: 言語に指定できるのは %s です: %s\n", strings.Join(report.Languages(), ", "), cfg.Settings.Language)
+		os.Exit(1)
+	}
+	cliLang = cfg.Settings.Language
+	if cfg.Settings.ReportFormat != "text" && !stdinMode {
+		status = os.Stderr
+	}
+
+	// 型情報付き解析
+	if typed {
+		cfg.Settings.Typed = true
+	}
+
+	// 担当者の割り当て
+	if ownersMode != "" {
+		cfg.Settings.Owners = ownersMode
+	}
+
+	// キャッシュディレクトリ
+	if cacheDir != "" {
+		cfg.Settings.CacheDir = cacheDir
+	}
+
+	// ベースライン
+	if baseline != "" {
+		cfg.Settings.Baseline = baseline
+	}
+	if stream && cfg.Settings.Baseline != "" {
+		fprintln(os.Stderr, "Error: -baseline は -stream と同時に指定できません")
+		os.Exit(1)
+	}
+	if stream && (fix || fixDryRun) {
+		fprintln(os.Stderr, "Error: -fix・-fix-dry-run は -stream と同時に指定できません")
+		os.Exit(1)
+	}
+	if staged && (fix || fixDryRun) {
+		// ステージされた内容で求めた修正を作業ツリーのファイルに適用できないため
+		fprintln(os.Stderr, "Error: -fix・-fix-dry-run は -staged と同時に指定できません")
+		os.Exit(1)
+	}
+
+	if stdinMode && (stream || fix || fixDryRun || writeBaseline || staged || changedRef != "" || diffRef != "" || againstRef != "" || serveAddr != "") {
+		fprintln(os.Stderr, "Error: -stdin は -stream・-fix・-fix-dry-run・-baseline write・-staged・-changed・-diff・-against・-serve と同時に指定できません")
+		os.Exit(1)
+	}
+
+	// デーモンモード（対象は設定のスケジュールで指定する）
+	if serveAddr != "" {
+		os.Exit(runServe(cfg, serveAddr))
+	}
 
 	// ターゲットディレクトリを絶対パスに
 	absTargetDir, err := filepath.Abs(targetDir)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: ターゲットディレクトリの解決に失敗しました: %v\n", err)
+		fprintf(os.Stderr, "Error: ターゲットディレクトリの解決に失敗しました: %v\n", err)
 		os.Exit(1)
 	}
 
 	// ディレクトリ存在確認
 	if info, err := os.Stat(absTargetDir); err != nil || !info.IsDir() {
-		fmt.Fprintf(os.Stderr, "Error: ディレクトリが見つかりません: %s\n", absTargetDir)
+		fprintf(os.Stderr, "Error: ディレクトリが見つかりません: %s\n", absTargetDir)
 		os.Exit(1)
 	}
 
-	// チェック実行
-	fmt.Printf("🔍 Checking: %s\n\n", absTargetDir)
+	// -diff は -changed と同じファイルをチェックし、行単位で絞り込む
+	if diffRef != "" {
+		if staged || changedRef != "" {
+			fprintln(os.Stderr, "Error: -diff は -staged・-changed と同時に指定できません")
+			os.Exit(1)
+		}
+		changedRef = diffRef
+	}
+
+	// 基準のrefとの比較はツリー全体の件数で行う
+	if againstRef != "" && (staged || changedRef != "") {
+		fprintln(os.Stderr, "Error: -against は -staged・-changed・-diff と同時に指定できません")
+		os.Exit(1)
+	}
+	if againstRef != "" || cfg.Settings.Owners != "" {
+		// gitはシンボリックリンクを解決したパスを返すため合わせる
+		if resolved, err := filepath.EvalSymlinks(absTargetDir); err == nil {
+			absTargetDir = resolved
+		}
+	}
 
-	c := checker.NewChecker(cfg)
-	report, err := c.Check(absTargetDir)
+	// 違反の担当者（CODEOWNERSまたはgit blame）
+	var owners *ownerResolver
+	if cfg.Settings.Owners != "" {
+		owners, err = newOwnerResolver(cfg.Settings.Owners, absTargetDir)
+		if err != nil {
+			fprintf(os.Stderr, "Error: 担当者の割り当てに失敗しました: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 解析結果のキャッシュ（ユーザーのキャッシュディレクトリに作成）
+	opts, err := cacheOptions(cfg.Settings.CacheDir, noCache, clearCache, status)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: チェックに失敗しました: %v\n", err)
+		fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
+	// 複数のモジュール（ターゲット）をそれぞれの設定でチェック
+	if modules || len(args) > 1 {
+		if stdinMode || stream || fix || fixDryRun || staged || changedRef != "" || diffRef != "" || againstRef != "" || baseline != "" || ownersMode != "" || historyPath != "" {
+			fprintln(os.Stderr, "Error: 複数のモジュールのチェックは -stdin・-stream・-fix・-fix-dry-run・-staged・-changed・-diff・-against・-baseline・-owners・-history と同時に指定できません")
+			os.Exit(1)
+		}
+		if len(args) == 0 {
+			args = []string{targetDir}
+		}
+		root, targets, err := moduleTargets(args, modules)
+		if err != nil {
+			fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		mr := &moduleRun{base: cfg, baseFixed: configPath != "", failOn: failOn, opts: opts, status: status}
+		os.Exit(runModules(mr, root, targets))
+	}
+
+	// 重要度フィルターを満たす違反を逐次出力
+	if stream {
+		minLevel := rules.ParseSeverity(cfg.Settings.MinSeverity).Level()
+		sink := report.JSONLinesSink(os.Stdout)
+		opts = append(opts, checker.WithSink(func(v report.Violation) {
+			if v.Severity.Level() >= minLevel {
+				if owners != nil {
+					v.Owner = owners.owner(v)
+				}
+				sink(v)
+			}
+		}))
+	}
+
+	// 標準入力の内容をチェック（そのファイルの違反のみを報告する）
+	var stdinPath string
+	if stdinMode {
+		var stdinOpts []checker.Option
+		stdinPath, stdinOpts, err = stdinOptions(os.Stdin, stdinFile, absTargetDir)
+		if err != nil {
+			fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, stdinOpts...)
+	}
+
+	// 変更ファイルのみをチェック
+	if staged || changedRef != "" {
+		// gitはシンボリックリンクを解決したパスを返すため合わせる
+		if resolved, err := filepath.EvalSymlinks(absTargetDir); err == nil {
+			absTargetDir = resolved
+		}
+		files, err := changedGoFiles(absTargetDir, staged, changedRef)
+		if err != nil {
+			fprintf(os.Stderr, "Error: 変更ファイルの取得に失敗しました: %v\n", err)
+			os.Exit(1)
+		}
+		if len(files) == 0 {
+			fprintln(status, "✅ 変更されたGoファイルはありません")
+			os.Exit(0)
+		}
+		opts = append(opts, checker.WithFiles(files...))
+
+		// ステージされた内容をチェックする（一部の変更のみをステージした場合も作業ツリーの内容は使わない）
+		if staged {
+			contents, err := stagedContents(absTargetDir, files)
+			if err != nil {
+				fprintf(os.Stderr, "Error: ステージされた内容の取得に失敗しました: %v\n", err)
+				os.Exit(1)
+			}
+			opts = append(opts, checker.WithOverlay(contents))
+		}
+	}
+
+	// 他のファイルの参照を変更する修正も求める（キャッシュは使用しない）
+	if fix || fixDryRun {
+		opts = append(opts, checker.WithFix())
+	}
+
+	// プロファイル・処理時間の計測
+	prof, err := startProfiling(cpuProfile, memProfile, timing)
+	if err != nil {
+		fprintf(os.Stderr, "Error: プロファイルの開始に失敗しました: %v\n", err)
+		os.Exit(1)
+	}
+
+	// チェック実行
+	fmt.Fprintf(status, "🔍 Checking: %s\n\n", absTargetDir)
+
+	// Ctrl+Cで中断した場合はチェックを終えたファイルの結果のみを出力する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	c := checker.New(cfg, append(slices.Clip(opts), prof.options()...)...)
+	rep, err := c.Run(ctx, absTargetDir)
+	interrupted := ctx.Err() != nil
+	stop()
+	prof.stop(os.Stderr)
+	if interrupted && rep != nil {
+		fprintln(os.Stderr, "Warning: 中断しました（チェックを終えたファイルの結果のみを出力します）")
+	} else if err != nil {
+		fprintf(os.Stderr, "Error: チェックに失敗しました: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 自動修正（重要度フィルターを満たす違反のみ。修正した場合は修正後のファイルを再チェックする）
+	if fix || fixDryRun {
+		if interrupted {
+			fprintln(os.Stderr, "Error: 中断したため修正しませんでした")
+			os.Exit(130)
+		}
+		fixed, err := runFix(rep.Filter(rules.ParseSeverity(cfg.Settings.MinSeverity)), fixDryRun, status)
+		if err != nil {
+			fprintf(os.Stderr, "Error: 修正に失敗しました: %v\n", err)
+			os.Exit(1)
+		}
+		if fixDryRun {
+			os.Exit(0)
+		}
+		if fixed > 0 {
+			fmt.Fprintf(status, "🔍 Re-checking: %s\n\n", absTargetDir)
+			rep, err = checker.New(cfg, opts...).Run(context.Background(), absTargetDir)
+			if err != nil {
+				fprintf(os.Stderr, "Error: チェックに失敗しました: %v\n", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	// 担当者の割り当て
+	if owners != nil {
+		rep.AssignOwners(owners.owner)
+	}
+
+	// バックログの経過日数（git blame）
+	if len(rep.Backlog) > 0 {
+		setTodoAges(rep, absTargetDir, time.Now())
+	}
+
+	// ベースライン（書き込みの場合はすべての違反を記録して終了）
+	if writeBaseline {
+		os.Exit(runWriteBaseline(rep, cfg.Settings.Baseline, interrupted, status))
+	}
+	if cfg.Settings.Baseline != "" {
+		b, err := report.LoadBaseline(cfg.Settings.Baseline)
+		if err != nil {
+			fprintf(os.Stderr, "Error: ベースラインの読み込みに失敗しました: %v\n", err)
+			os.Exit(1)
+		}
+		rep.ExcludeBaseline(b)
+	}
+
+	// 追加・変更された行以外の違反を除外（-diff）
+	if diffRef != "" && diffLines {
+		lines, err := changedLines(absTargetDir, diffRef)
+		if err != nil {
+			fprintf(os.Stderr, "Error: 変更行の取得に失敗しました: %v\n", err)
+			os.Exit(1)
+		}
+		rep.ExcludeOutsideDiff(lines)
+	}
+
+	// 標準入力のファイル以外の違反（ディレクトリ構成・プロジェクト単位の違反等）を除外
+	if stdinMode {
+		rep.KeepFile(stdinPath)
+	}
+
+	// 前回の実行のスコア（-history、読み込めない場合は記録時に警告する）
+	if historyPath != "" {
+		if entries, err := report.LoadHistory(historyPath); err == nil {
+			rep.SetPrevious(entries)
+		}
+	}
+
 	// 重要度フィルタリング
-	filteredReport := report.Filter(rules.ParseSeverity(cfg.Settings.MinSeverity))
+	rep.SetRepoRoot(repoRoot(absTargetDir))
+	filteredReport := rep.Filter(rules.ParseSeverity(cfg.Settings.MinSeverity))
 
-	// レポート出力
-	if cfg.Settings.ReportFormat == "json" {
-		output, err := filteredReport.ToJSON()
+	// レポート出力（-stream の場合は違反を出力済み）
+	if !stream {
+		output, _, err := filteredReport.Render(cfg.Settings.ReportFormat)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: JSON出力に失敗しました: %v\n", err)
+			fprintf(os.Stderr, "Error: レポートの出力に失敗しました: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println(output)
-	} else {
-		fmt.Print(filteredReport.ToText())
+		os.Stdout.Write(output)
+	}
+
+	// 実行履歴（中断した場合・変更ファイルのみをチェックした場合は全体の推移にならないため記録しない）
+	if historyPath != "" && !interrupted && !staged && changedRef == "" && !stdinMode {
+		commit, _ := gitOutput(absTargetDir, "rev-parse", "HEAD")
+		if err := report.AppendHistory(historyPath, rep.HistoryEntry(commit, time.Now())); err != nil {
+			fprintf(os.Stderr, "Warning: 実行履歴の記録に失敗しました: %v\n", err)
+		}
 	}
 
-	// 終了コード
-	os.Exit(filteredReport.ExitCode())
+	// レポートのアップロード（失敗してもチェック結果の終了コードは変えない。中断した場合・-stdin の場合は送らない）
+	if cfg.Settings.Upload.Enabled && !interrupted && !stdinMode {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		dest, err := filteredReport.Upload(ctx, cfg.Settings.Upload, uploadMeta(absTargetDir, time.Now()))
+		cancel()
+		if err != nil {
+			fprintf(os.Stderr, "Warning: レポートのアップロードに失敗しました: %v\n", err)
+		} else {
+			fmt.Fprintf(os.Stderr, "📤 Uploaded report: %s\n", dest)
+		}
+	}
+
+	// Webhook通知（失敗してもチェック結果の終了コードは変えない。中断した場合・-stdin の場合は送らない）
+	for _, n := range cfg.Settings.Notifications {
+		if interrupted || stdinMode {
+			break
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := rep.Notify(ctx, n); err != nil {
+			fprintf(os.Stderr, "Warning: 通知の送信に失敗しました: %v\n", err)
+		}
+		cancel()
+	}
+
+	// 終了コード（中断した場合はSIGINTによる終了と同じ130）
+	if interrupted {
+		os.Exit(130)
+	}
+
+	// 基準のrefと比較（違反が増えた場合のみ失敗）
+	if againstRef != "" {
+		fmt.Fprintf(os.Stderr, "\n🔍 Checking %s for comparison...\n", againstRef)
+		baseReport, err := checkAgainst(context.Background(), cfg, absTargetDir, againstRef)
+		if err != nil {
+			fprintf(os.Stderr, "Error: %s のチェックに失敗しました: %v\n", againstRef, err)
+			os.Exit(1)
+		}
+		if printRegressionGate(os.Stderr, againstRef, baseReport, filteredReport) {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	failures := filteredReport.PolicyFailures(cfg.Settings.ExitPolicy)
+	for _, f := range failures {
+		fprintf(os.Stderr, "❌ 許容する件数を超えました（%s）\n", f)
+	}
+	if len(failures) > 0 {
+		os.Exit(1)
+	}
+	os.Exit(0)
 }
 
 // generateConfigTemplate 設定ファイルテンプレートを生成
 func generateConfigTemplate() {
-	template := `# Go Standards Checker 設定ファイル
-# このファイルをプロジェクトルートに配置してください
-
-# ========================================
-# 基本設定
-# ========================================
-settings:
-  # 除外パターン
-  exclude_patterns:
-    - "*_test.go"      # テストファイル
-    - "vendor/*"       # vendorディレクトリ
-    - ".git/*"         # gitディレクトリ
-    - "*.pb.go"        # Protocol Buffers生成ファイル
-  # レポート形式: text, json
-  report_format: "text"
-  # 最小重要度: error, warning, info
-  min_severity: "info"
-
-# ========================================
-# 命名規則チェック
-# ========================================
-naming:
-  enabled: true
-  rules:
-    package_name:
-      enabled: true
-      pattern: "^[a-z][a-z0-9]*$"
-      severity: "error"
-      message: "パッケージ名は小文字のみで構成してください"
-    
-    file_name:
-      enabled: true
-      pattern: "^[a-z][a-z0-9_]*\\.go$"
-      severity: "warning"
-      message: "ファイル名はスネークケース小文字で命名してください"
-    
-    exported_names:
-      enabled: true
-      severity: "warning"
-      message: "公開シンボルはPascalCaseで命名してください"
-    
-    interface_name:
-      enabled: true
-      suffixes: ["er", "or", "Repository", "Service", "Client", "Handler"]
-      severity: "info"
-      message: "インタフェース名は標準的なサフィックスを使用してください"
-    
-    error_var:
-      enabled: true
-      pattern: "^Err[A-Z]"
-      severity: "warning"
-      message: "センチネルエラーはErrプレフィックスで定義してください"
-
-# ========================================
-# コード構造チェック
-# ========================================
-structure:
-  enabled: true
-  rules:
-    max_function_lines:
-      enabled: true
-      limit: 50
-      severity: "warning"
-      message: "関数は50行以内を目安にしてください"
-    
-    max_nesting_level:
-      enabled: true
-      limit: 3
-      severity: "warning"
-      message: "ネストは3レベル以内を目安にしてください"
-    
-    max_parameters:
-      enabled: true
-      limit: 5
-      severity: "info"
-      message: "関数のパラメータは5個以内を目安にしてください"
-    
-    max_return_values:
-      enabled: true
-      limit: 3
-      severity: "info"
-      message: "関数の戻り値は3個以内を目安にしてください"
-
-# ========================================
-# エラーハンドリングチェック
-# ========================================
-error_handling:
-  enabled: true
-  rules:
-    no_ignored_errors:
-      enabled: true
-      severity: "error"
-      message: "エラーは必ず明示的にハンドリングしてください"
-      allowed_patterns:
-        - "defer.*Close"
-        - "fmt\\.Print"
-    
-    no_panic:
-      enabled: true
-      severity: "warning"
-      message: "panicの使用は避け、エラーを返却してください"
-      allowed_in:
-        - "main.go"
-        - "*_test.go"
-
-# ========================================
-# ログ出力チェック
-# ========================================
-logging:
-  enabled: true
-  rules:
-    no_fmt_println:
-      enabled: true
-      severity: "warning"
-      message: "本番コードでfmt.Printlnは使用せず、適切なログライブラリを使用してください"
-
-# ========================================
-# ディレクトリ構成チェック
-# ========================================
-directory:
-  enabled: true
-  rules:
-    required_dirs:
-      enabled: true
-      severity: "info"
-      dirs:
-        - "cmd"
-        - "internal"
-      message: "標準ディレクトリ構成を使用してください"
-    
-    recommended_dirs:
-      enabled: false
-      severity: "info"
-      dirs:
-        - "internal/handler"
-        - "internal/service"
-        - "internal/repository"
-      message: "レイヤードアーキテクチャに基づくディレクトリ構成を推奨します"
-
-# ========================================
-# 構造体タグチェック
-# ========================================
-struct_tags:
-  enabled: true
-  rules:
-    json_tag:
-      enabled: true
-      style: "snake_case"
-      severity: "warning"
-      message: "JSONタグはスネークケースで記述してください"
-    
-    validation_tag:
-      enabled: true
-      severity: "info"
-      required_for:
-        - "*Request"
-        - "*Input"
-      message: "リクエスト構造体にはvalidateタグを付与してください"
-
-# ========================================
-# カスタムルール（正規表現ベース）
-# ========================================
-custom_rules:
-  # ハードコードされた認証情報の検出
-  - name: "no_hardcoded_secrets"
-    enabled: true
-    severity: "error"
-    pattern: '(?i)(password|secret|api_key)\s*[:=]\s*["\'][^"\']{8,}["\']'
-    message: "認証情報をハードコードしないでください"
-    exclude_files:
-      - "*_test.go"
-  
-  # TODO/FIXMEの形式チェック
-  - name: "todo_format"
-    enabled: true
-    severity: "info"
-    pattern: '(TODO|FIXME)(?!\([a-zA-Z]+\))'
-    message: "TODO/FIXMEには担当者を記載してください"
-    exclude_files: []
-
-# ========================================
-# プロジェクト固有ルール
-# ========================================
-# ここに独自ルールを追加してください
-project_rules: []
-`
-
 	filename := "go-standards.yaml"
-	if err := os.WriteFile(filename, []byte(template), 0644); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: 設定ファイルの生成に失敗しました: %v\n", err)
+	if err := os.WriteFile(filename, []byte(configTemplate()), 0644); err != nil {
+		fprintf(os.Stderr, "Error: 設定ファイルの生成に失敗しました: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("✅ 設定ファイルを生成しました: %s\n", filename)
-	fmt.Println("\n次のステップ:")
-	fmt.Println("  1. go-standards.yaml をプロジェクトに合わせてカスタマイズ")
-	fmt.Println("  2. go-standards-checker を実行してチェック")
+	fprintf(os.Stdout, "✅ 設定ファイルを生成しました: %s\n", filename)
+	fprintln(os.Stdout, "\n次のステップ:")
+	fprintln(os.Stdout, "  1. go-standards.yaml をプロジェクトに合わせてカスタマイズ")
+	fprintln(os.Stdout, "  2. go-standards-checker を実行してチェック")
 }
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 複数のモジュール（ターゲット）のチェック
+// ========================================
+
+// moduleRun 複数のモジュールのチェックの設定
+type moduleRun struct {
+	base      *rules.Config    // モジュールに設定ファイルが無い場合・-c を指定した場合の設定
+	baseFixed bool             // -c を指定した（モジュールの設定ファイルを使わない）
+	failOn    string           // -fail-on（指定した場合はモジュールの設定より優先）
+	opts      []checker.Option // キャッシュ等のオプション
+	status    io.Writer        // 進捗の出力先
+}
+
+// discoverModules root配下のgo.modのあるディレクトリ（パス順）
+// "." で始まるディレクトリ・vendor・testdata・node_modulesは探さない
+func discoverModules(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata" || name == "node_modules") {
+			return filepath.SkipDir
+		}
+		if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+// moduleConfig モジュールのディレクトリの設定ファイル（無ければ共通の設定）
+// 戻り値のパスは読み込んだ設定ファイル（共通の設定の場合は空）
+func (mr *moduleRun) moduleConfig(dir string) (*rules.Config, string, error) {
+	cfg, path := mr.base, ""
+	if !mr.baseFixed {
+		for _, name := range []string{"go-standards.yaml", "go-standards.yml", ".go-standards.yaml", ".go-standards.yml"} {
+			candidate := filepath.Join(dir, name)
+			if _, err := os.Stat(candidate); err != nil {
+				continue
+			}
+			loaded, err := rules.LoadConfig(candidate)
+			if err != nil {
+				return nil, "", fmt.Errorf("%s の読み込みに失敗しました: %w", candidate, err)
+			}
+			cfg, path = loaded, candidate
+			break
+		}
+	}
+
+	// 出力形式・最小重要度・言語は全体で揃え、-fail-on はモジュールの設定より優先する
+	derived := *cfg
+	derived.Settings.ReportFormat = mr.base.Settings.ReportFormat
+	derived.Settings.MinSeverity = mr.base.Settings.MinSeverity
+	derived.Settings.Language = mr.base.Settings.Language
+	if mr.failOn != "" {
+		derived.Settings.FailOn = mr.failOn
+	}
+	return &derived, path, nil
+}
+
+// run モジュールごとにチェックし、集計したレポートを返す（中断した場合はそれまでの集計とctx.Err()）
+// テキスト形式の場合はモジュールごとのレポートをoutに出力する
+func (mr *moduleRun) run(ctx context.Context, root string, targets []string, out io.Writer) (*report.Report, error) {
+	agg := report.NewReport(root)
+	agg.SetLanguage(mr.base.Settings.Language)
+	agg.SetRepoRoot(repoRoot(root))
+	defer agg.Finalize()
+	for _, target := range targets {
+		cfg, cfgPath, err := mr.moduleConfig(target)
+		if err != nil {
+			return nil, err
+		}
+		rel := relativeTo(root, target)
+		if cfgPath != "" {
+			fmt.Fprintf(mr.status, "📋 %s: Using config: %s\n", rel, cfgPath)
+		}
+		fmt.Fprintf(mr.status, "🔍 Checking: %s\n", target)
+		rep, err := checker.New(cfg, mr.opts...).Run(ctx, target)
+		if err != nil {
+			return agg, err
+		}
+		filtered := rep.Filter(rules.ParseSeverity(cfg.Settings.MinSeverity))
+		if cfg.Settings.ReportFormat == "text" {
+			fmt.Fprintln(out)
+			io.WriteString(out, filtered.ToText())
+		}
+		agg.AddModule(rel, filtered, filtered.PolicyFailures(cfg.Settings.ExitPolicy))
+	}
+	return agg, nil
+}
+
+// runModules 複数のモジュールをそれぞれの設定でチェックし、モジュールごとのレポートと全体の集計を出力する
+// 戻り値は終了コード（いずれかのモジュールが自身の判定基準を超えた場合は1、中断した場合は130）
+func runModules(mr *moduleRun, root string, targets []string) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	agg, err := mr.run(ctx, root, targets, os.Stdout)
+	interrupted := ctx.Err() != nil
+	stop()
+	switch {
+	case interrupted:
+		fprintln(os.Stderr, "Warning: 中断しました")
+		return 130
+	case errors.Is(err, context.Canceled):
+		return 130
+	case err != nil:
+		fprintf(os.Stderr, "Error: チェックに失敗しました: %v\n", err)
+		return 1
+	}
+
+	format := mr.base.Settings.ReportFormat
+	if format == "text" {
+		fmt.Println()
+		fmt.Print(agg.ModulesText())
+	} else {
+		output, _, err := agg.Render(format)
+		if err != nil {
+			fprintf(os.Stderr, "Error: レポートの出力に失敗しました: %v\n", err)
+			return 1
+		}
+		os.Stdout.Write(output)
+	}
+
+	failed := agg.FailedModules()
+	for _, m := range failed {
+		fprintf(os.Stderr, "❌ %s: 許容する件数を超えました（%s）\n", m.Path, strings.Join(m.Failures, ", "))
+	}
+	if len(failed) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// relativeTo rootからの相対パス（root外の場合はそのまま）
+func relativeTo(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
+
+// moduleTargets チェックするモジュールのディレクトリと、集計のパスの基準のディレクトリ
+// discoverの場合は各引数の配下のgo.modのあるディレクトリ、それ以外は引数のディレクトリをそのまま使う
+func moduleTargets(args []string, discover bool) (string, []string, error) {
+	root, err := os.Getwd()
+	if err != nil {
+		return "", nil, err
+	}
+	var targets []string
+	for _, arg := range args {
+		dir, err := filepath.Abs(arg)
+		if err != nil {
+			return "", nil, err
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return "", nil, fmt.Errorf("ディレクトリが見つかりません: %s", dir)
+		}
+		if !discover {
+			targets = append(targets, dir)
+			continue
+		}
+		found, err := discoverModules(dir)
+		if err != nil {
+			return "", nil, err
+		}
+		targets = append(targets, found...)
+	}
+	if len(args) == 1 && discover {
+		root, _ = filepath.Abs(args[0])
+	}
+	if len(targets) == 0 {
+		return "", nil, errors.New("go.mod のあるディレクトリが見つかりません")
+	}
+	return root, targets, nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-standards-checker/report"
+)
+
+// ========================================
+// 違反の担当者の割り当て（-owners）
+// ========================================
+
+// ownerResolver 違反の担当者を求める
+type ownerResolver struct {
+	root   string                       // gitのリポジトリルート（gitで管理されていない場合はターゲットディレクトリ）
+	rules  []codeOwnersRule             // CODEOWNERS（codeownersの場合）
+	blame  bool                         // git blameで最終更新者を求める
+	blamed map[string]map[int]blameLine // ファイル→行→最終更新（blameの結果のキャッシュ）
+}
+
+// codeOwnersRule CODEOWNERSの1行
+type codeOwnersRule struct {
+	pattern *regexp.Regexp
+	owners  string
+}
+
+// codeOwnersPaths CODEOWNERSの探索場所（GitHub・GitLabと同じ順）
+var codeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// newOwnerResolver 担当者の求め方（codeowners / blame）に応じたresolverを作成
+func newOwnerResolver(mode, targetDir string) (*ownerResolver, error) {
+	root := targetDir
+	if top, err := gitOutput(targetDir, "rev-parse", "--show-toplevel"); err == nil {
+		root = top
+	}
+	r := &ownerResolver{root: root}
+
+	switch mode {
+	case "codeowners":
+		for _, name := range codeOwnersPaths {
+			rules, err := loadCodeOwners(filepath.Join(root, name))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			r.rules = rules
+			return r, nil
+		}
+		return nil, fmt.Errorf("CODEOWNERS not found in %s", root)
+	case "blame":
+		if root == targetDir {
+			if _, err := gitOutput(targetDir, "rev-parse", "--git-dir"); err != nil {
+				return nil, err
+			}
+		}
+		r.blame = true
+		r.blamed = make(map[string]map[int]blameLine)
+		return r, nil
+	default:
+		return nil, fmt.Errorf("unknown owners mode %q (codeowners or blame)", mode)
+	}
+}
+
+// owner 違反の担当者（見つからない場合は空）
+func (r *ownerResolver) owner(v report.Violation) string {
+	rel, err := filepath.Rel(r.root, v.File)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	rel = filepath.ToSlash(rel)
+
+	if r.blame {
+		lines, ok := r.blamed[rel]
+		if !ok {
+			lines = gitBlame(r.root, rel)
+			r.blamed[rel] = lines
+		}
+		return lines[v.Line].author
+	}
+
+	// 最後に一致した行が優先される
+	for i := len(r.rules) - 1; i >= 0; i-- {
+		if r.rules[i].pattern.MatchString(rel) {
+			return r.rules[i].owners
+		}
+	}
+	return ""
+}
+
+// blameLine git blameで求めた行の最終更新
+type blameLine struct {
+	author string    // 最終更新者（メールアドレス）
+	time   time.Time // 最終更新日時
+}
+
+// gitBlame ファイル（rootからの相対パス）の行ごとの最終更新
+func gitBlame(root, rel string) map[int]blameLine {
+	lines := make(map[int]blameLine)
+	out, err := gitOutput(root, "blame", "--line-porcelain", "--", rel)
+	if err != nil {
+		return lines
+	}
+
+	// ヘッダー行「<sha> <元の行> <現在の行> [<行数>]」の後に「author-mail <...>」「author-time <UNIX時刻>」が続く
+	line := 0
+	for _, text := range strings.Split(out, "\n") {
+		fields := strings.Fields(text)
+		switch {
+		case len(fields) >= 3 && len(fields[0]) == 40:
+			line, _ = strconv.Atoi(fields[2])
+		case strings.HasPrefix(text, "author-mail "):
+			l := lines[line]
+			l.author = strings.Trim(strings.TrimPrefix(text, "author-mail "), "<>")
+			lines[line] = l
+		case strings.HasPrefix(text, "author-time "):
+			if sec, err := strconv.ParseInt(strings.TrimPrefix(text, "author-time "), 10, 64); err == nil {
+				l := lines[line]
+				l.time = time.Unix(sec, 0)
+				lines[line] = l
+			}
+		}
+	}
+	return lines
+}
+
+// loadCodeOwners CODEOWNERSを読み込む
+func loadCodeOwners(path string) ([]codeOwnersRule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rules []codeOwnersRule
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		owners := ""
+		if len(fields) > 1 {
+			owners = strings.Join(fields[1:], " ")
+		}
+		rules = append(rules, codeOwnersRule{pattern: codeOwnersPattern(fields[0]), owners: owners})
+	}
+	return rules, scanner.Err()
+}
+
+// codeOwnersPattern CODEOWNERSのパターン（gitignore形式）を正規表現に変換
+// 先頭または途中に / があればルートからの位置、無ければ任意の階層に一致する。
+// ディレクトリに一致した場合はその配下のすべてのファイルが対象になる
+func codeOwnersPattern(pattern string) *regexp.Regexp {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.Trim(pattern, "/")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch ch := pattern[i]; ch {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	sb.WriteString("(?:/.*)?$")
+	// 各文字をエスケープしているため常にコンパイルできる
+	return regexp.MustCompile(sb.String())
+}
@@ -0,0 +1,319 @@
+// Package checker Go Standards Checkerを他のツールに組み込むための公開API
+//
+//	cfg, err := rules.LoadConfig("go-standards.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	rep, err := checker.New(cfg, checker.WithParallelism(4)).Run(ctx, "./svc-a", "./svc-b")
+package checker
+
+import (
+	"context"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	internal "github.com/go-standards-checker/checker"
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ルールを実装するためのインタフェース・コンテキスト（詳細は内部パッケージのドキュメントを参照）
+type (
+	Rule           = internal.Rule
+	FileRule       = internal.FileRule
+	NodeRule       = internal.NodeRule
+	ProjectRule    = internal.ProjectRule
+	FileContext    = internal.FileContext
+	ProjectContext = internal.ProjectContext
+)
+
+// Register すべてのCheckerで使用するルールを登録（init()から呼び出す）
+func Register(rule Rule) {
+	internal.Register(rule)
+}
+
+// Rules 組み込みルールと登録済みルールの一覧
+func Rules() []Rule {
+	return internal.RegisteredRules()
+}
+
+// Checker 組み込み用チェッカー
+type Checker struct {
+	config *rules.Config
+	opts   options
+}
+
+// Option Checkerの設定を変更する関数
+type Option func(*options)
+
+type options struct {
+	fsys        fs.FS
+	logger      *log.Logger
+	parallelism int
+	rules       []Rule
+	files       []string
+	overlay     map[string][]byte
+	cacheFile   string
+	cacheDir    string
+	fix         bool
+	sink        report.Sink
+	timings     *Timings
+	pkg         *parsedPackage
+}
+
+// parsedPackage WithPackageで指定した解析済みのパッケージ
+type parsedPackage struct {
+	fset  *token.FileSet
+	files []*ast.File
+	srcs  map[string][]byte
+	info  *types.Info
+}
+
+// WithFS チェック対象を読み込むファイルシステムを指定（未指定の場合はOSのファイルシステム）
+//
+// 指定した場合、Runに渡すターゲットはfs.FS内のパス（"." など）とし、型情報付き解析は行わない
+func WithFS(fsys fs.FS) Option {
+	return func(o *options) {
+		o.fsys = fsys
+	}
+}
+
+// WithLogger 警告の出力先を指定（未指定の場合は標準出力）
+func WithLogger(logger *log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithParallelism 同時にチェックするターゲット数の上限を指定（デフォルト: 1）
+func WithParallelism(n int) Option {
+	return func(o *options) {
+		o.parallelism = n
+	}
+}
+
+// WithRules このCheckerのみで使用するルールを追加
+func WithRules(rules ...Rule) Option {
+	return func(o *options) {
+		o.rules = append(o.rules, rules...)
+	}
+}
+
+// WithFiles チェック対象を指定したファイルに限定（git hook等で変更ファイルのみをチェックする場合）
+//
+// パスはターゲットと同じ基準（OSの場合は絶対パス、WithFSの場合はfs.FS内のパス）で指定する
+func WithFiles(paths ...string) Option {
+	return func(o *options) {
+		o.files = append(o.files, paths...)
+	}
+}
+
+// WithPackage 解析済みのパッケージをチェックする（analysisのドライバー等、AST・型情報を既に持つ場合）
+//
+// ディレクトリの走査・ファイルの再解析・型チェックは行わず、filesのファイルのみをチェックする。
+// Runに渡すターゲットは相対パス・プロジェクト単位のルールの基準とするディレクトリ（モジュールのルート等）とする。
+// srcsはファイルの内容（パス→内容）、infoは型情報（nilの場合は型情報なし）
+func WithPackage(fset *token.FileSet, files []*ast.File, srcs map[string][]byte, info *types.Info) Option {
+	return func(o *options) {
+		o.pkg = &parsedPackage{fset: fset, files: files, srcs: srcs, info: info}
+	}
+}
+
+// WithOverlay ディスク上の内容の代わりに使うファイルの内容を指定（エディタの未保存のバッファをチェックする場合）
+//
+// パスはWithFilesと同じ基準で指定する。ディスク上に存在しないファイルもチェック対象になる
+func WithOverlay(files map[string][]byte) Option {
+	return func(o *options) {
+		if o.overlay == nil {
+			o.overlay = make(map[string][]byte, len(files))
+		}
+		for path, src := range files {
+			o.overlay[path] = src
+		}
+	}
+}
+
+// DefaultCacheFile キャッシュファイルの既定名
+const DefaultCacheFile = internal.DefaultCacheFile
+
+// WithCache 解析結果をキャッシュするファイルを指定（未指定の場合はキャッシュしない）
+//
+// ファイル内容と設定が変わっていないファイルはチェックを省略する。
+// 相対パスの場合はターゲットごとのディレクトリに作成する（例: WithCache(DefaultCacheFile)）
+func WithCache(path string) Option {
+	return func(o *options) {
+		o.cacheFile = path
+	}
+}
+
+// WithCacheDir 解析結果をキャッシュするディレクトリを指定（WithCacheより優先）
+//
+// ファイルごとの結果をファイル内容・設定・ターゲットのハッシュをキーに保存するため、
+// 複数のターゲット・設定で同じディレクトリを共有できる（例: DefaultCacheDir() の結果）
+func WithCacheDir(dir string) Option {
+	return func(o *options) {
+		o.cacheDir = dir
+	}
+}
+
+// DefaultCacheDir キャッシュディレクトリの既定値（ユーザーのキャッシュディレクトリ配下、例: ~/.cache/go-standards-checker）
+func DefaultCacheDir() (string, error) {
+	return internal.DefaultCacheDir()
+}
+
+// ClearCache キャッシュディレクトリ内のエントリを削除する（存在しない場合は何もしない）
+//
+// このツールが作成したエントリの形式のファイルのみを削除し、他のファイルとディレクトリ自体は残す
+func ClearCache(dir string) error {
+	return internal.ClearCache(dir)
+}
+
+// WithFix 違反の修正（report.Fix）を、他のファイルの参照を変更するものも含めて求める（report.PlanFixes に渡す場合）
+//
+// 未指定の場合、モジュール全体の走査が必要な修正（エラー変数の名前の変更）は求めない。
+// 指定した場合はキャッシュを使用しない
+func WithFix() Option {
+	return func(o *options) {
+		o.fix = true
+	}
+}
+
+// WithSink 違反をレポートに保持せず、見つけた順にsinkへ渡す（大規模なチェックや逐次表示向け）
+//
+// Runの戻り値のレポートは件数（Summary）のみを持つ。複数のターゲットを並行してチェックする場合も
+// sinkは同時に呼び出されない。JSON Linesで出力する場合は report.JSONLinesSink を使用する
+func WithSink(sink report.Sink) Option {
+	return func(o *options) {
+		var mu sync.Mutex
+		o.sink = func(v report.Violation) {
+			mu.Lock()
+			defer mu.Unlock()
+			sink(v)
+		}
+	}
+}
+
+// Timings ルール・ファイルごとの処理時間の集計
+type (
+	Timings = internal.Timings
+	Timing  = internal.Timing
+)
+
+// NewTimings 処理時間の集計を作成（WithTimingsに渡す）
+func NewTimings() *Timings {
+	return internal.NewTimings()
+}
+
+// WithTimings ルール・ファイルごとの処理時間をtに集計する（チェッカー自体の性能調査用）
+//
+// 計測のため時刻を頻繁に取得するので、計測しない場合より遅くなる
+func WithTimings(t *Timings) Option {
+	return func(o *options) {
+		o.timings = t
+	}
+}
+
+// New チェッカーを作成（cfgがnilの場合はデフォルト設定）
+func New(cfg *rules.Config, opts ...Option) *Checker {
+	if cfg == nil {
+		cfg = rules.DefaultConfig()
+	}
+	o := options{parallelism: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.parallelism < 1 {
+		o.parallelism = 1
+	}
+	return &Checker{config: cfg, opts: o}
+}
+
+// Run ターゲットディレクトリをチェックし、結果を1つのレポートにまとめて返す（未指定の場合は "."）
+//
+// ctxがキャンセルされた場合は走査・チェックを打ち切り、それまでにチェックを終えたファイルの
+// 結果をまとめたレポートとctx.Err()を返す（LSP・デーモン等で古いリクエストを取り消す場合）
+func (c *Checker) Run(ctx context.Context, targets ...string) (*report.Report, error) {
+	if len(targets) == 0 {
+		targets = []string{"."}
+	}
+
+	reports := make([]*report.Report, len(targets))
+	errs := make([]error, len(targets))
+	sem := make(chan struct{}, c.opts.parallelism)
+	var wg sync.WaitGroup
+start:
+	for i, target := range targets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break start
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			reports[i], errs[i] = c.check(ctx, target)
+		}()
+	}
+	wg.Wait()
+
+	// キャンセルされた場合は各ターゲットのエラーより打ち切りを優先する
+	canceled := ctx.Err()
+	if canceled == nil {
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(reports) == 1 && reports[0] != nil {
+		return reports[0], canceled
+	}
+
+	merged := report.NewReport(strings.Join(targets, ", "))
+	merged.SetLanguage(c.config.Settings.Language)
+	for _, r := range reports {
+		if r != nil {
+			merged.Merge(r)
+		}
+	}
+	merged.Finalize()
+	return merged, canceled
+}
+
+// check 単一ターゲットをチェック
+func (c *Checker) check(ctx context.Context, target string) (*report.Report, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	ic := internal.NewChecker(c.config)
+	ic.SetFS(c.opts.fsys)
+	ic.SetLogger(c.opts.logger)
+	ic.SetSink(c.opts.sink)
+	ic.SetTimings(c.opts.timings)
+	if c.opts.files != nil {
+		ic.SetFiles(c.opts.files)
+	}
+	ic.SetOverlay(c.opts.overlay)
+	if pkg := c.opts.pkg; pkg != nil {
+		ic.SetPackage(pkg.fset, pkg.files, pkg.srcs, pkg.info)
+	}
+	if cacheFile := c.opts.cacheFile; cacheFile != "" {
+		if !filepath.IsAbs(cacheFile) {
+			cacheFile = filepath.Join(target, cacheFile)
+		}
+		ic.SetCache(cacheFile)
+	}
+	ic.SetCacheDir(c.opts.cacheDir)
+	ic.SetFix(c.opts.fix)
+	for _, rule := range c.opts.rules {
+		ic.AddRule(rule)
+	}
+	return ic.CheckContext(ctx, target)
+}
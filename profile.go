@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/go-standards-checker/pkg/checker"
+)
+
+// timingTop -timing で表示するルール・ファイルの件数
+const timingTop = 10
+
+// profiler -cpuprofile・-memprofile・-timing による性能調査
+type profiler struct {
+	cpuFile    *os.File
+	memProfile string
+	timings    *checker.Timings
+	start      time.Time
+}
+
+// startProfiling CPUプロファイルの取得と処理時間の計測を開始する
+func startProfiling(cpuProfile, memProfile string, timing bool) (*profiler, error) {
+	p := &profiler{memProfile: memProfile, start: time.Now()}
+	if timing {
+		p.timings = checker.NewTimings()
+	}
+	if cpuProfile != "" {
+		f, err := os.Create(cpuProfile)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		p.cpuFile = f
+	}
+	return p, nil
+}
+
+// options 処理時間を計測する場合のCheckerのオプション
+func (p *profiler) options() []checker.Option {
+	if p.timings == nil {
+		return nil
+	}
+	return []checker.Option{checker.WithTimings(p.timings)}
+}
+
+// stop プロファイルを書き出し、-timing の場合は処理時間のサマリーをwに出力する
+// os.Exitではdeferが実行されないため、チェック後に明示的に呼び出す
+func (p *profiler) stop(w io.Writer) {
+	elapsed := time.Since(p.start)
+	if p.cpuFile != nil {
+		pprof.StopCPUProfile()
+		p.cpuFile.Close()
+	}
+	if p.memProfile != "" {
+		if err := writeMemProfile(p.memProfile); err != nil {
+			fprintf(os.Stderr, "Warning: メモリプロファイルの書き込みに失敗しました: %v\n", err)
+		}
+	}
+	if p.timings != nil {
+		printTimings(w, p.timings, elapsed)
+	}
+}
+
+// writeMemProfile ヒーププロファイルを書き出す
+func writeMemProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	runtime.GC() // 最新の割り当て状況を反映する
+	return pprof.WriteHeapProfile(f)
+}
+
+// printTimings 処理時間の長いルール・ファイルを出力する
+func printTimings(w io.Writer, t *checker.Timings, elapsed time.Duration) {
+	fmt.Fprintf(w, "\n⏱  Timing (total %s)\n", elapsed.Round(time.Millisecond))
+	fmt.Fprintf(w, "\nSlowest rules (top %d):\n", timingTop)
+	for _, r := range t.SlowestRules(timingTop) {
+		fmt.Fprintf(w, "  %10s  %s\n", r.Duration.Round(time.Microsecond), r.Name)
+	}
+	fmt.Fprintf(w, "\nSlowest files (top %d):\n", timingTop)
+	for _, f := range t.SlowestFiles(timingTop) {
+		fmt.Fprintf(w, "  %10s  %s\n", f.Duration.Round(time.Microsecond), f.Name)
+	}
+}
@@ -0,0 +1,215 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// PRのインラインコメント向けの出力形式（GitHub Actions・GitLab・reviewdog）
+// ========================================
+
+// toolName 注釈・診断の出力元として表示する名前
+const toolName = "go-standards-checker"
+
+// SetRepoRoot 注釈の形式（github・rdjson・gitlab）のパスの基準を設定する
+// PRの差分はリポジトリのルートからの相対パスで照合されるため、サブディレクトリをチェックする場合も注釈が付くようにする
+func (r *Report) SetRepoRoot(root string) {
+	r.repoRoot = root
+}
+
+// annotationPath 注釈に出力するファイルのパス（リポジトリのルートからの相対パス、未設定の場合はプロジェクトからの相対パス）
+func (r *Report) annotationPath(path string) string {
+	if r.repoRoot == "" {
+		return r.relPath(path)
+	}
+	if rel, err := filepath.Rel(r.repoRoot, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(path)
+}
+
+// ToGitHub GitHub Actionsのワークフローコマンド形式で出力（1件1行、PRの差分に注釈として表示される）
+// error・warningはそれぞれ ::error・::warning、infoは ::notice で出力する
+func (r *Report) ToGitHub() ([]byte, error) {
+	var b strings.Builder
+	for _, f := range r.violationsByFile() {
+		for _, v := range f.Violations {
+			b.WriteString("::" + githubLevel(v.Severity) + " " + githubProperties(r.annotationPath(v.File), v) + "::")
+			b.WriteString(githubEscapeData(annotationMessage(v)) + "\n")
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// githubLevel 重要度に対応するワークフローコマンド
+func githubLevel(s rules.Severity) string {
+	switch s {
+	case rules.SeverityError:
+		return "error"
+	case rules.SeverityWarning:
+		return "warning"
+	}
+	return "notice"
+}
+
+// githubProperties ワークフローコマンドのプロパティ（file・line・col・title）
+func githubProperties(path string, v Violation) string {
+	props := []string{"file=" + githubEscapeProperty(path)}
+	if v.Line > 0 {
+		props = append(props, "line="+strconv.Itoa(v.Line))
+	}
+	if v.Column > 0 {
+		props = append(props, "col="+strconv.Itoa(v.Column))
+	}
+	props = append(props, "title="+githubEscapeProperty(toolName+" ("+v.Category+"/"+v.Rule+")"))
+	return strings.Join(props, ",")
+}
+
+// githubEscapeData ワークフローコマンドのメッセージのエスケープ
+func githubEscapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// githubEscapeProperty ワークフローコマンドのプロパティ値のエスケープ
+func githubEscapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// annotationMessage 注釈の本文（提案があれば2行目に付ける）
+func annotationMessage(v Violation) string {
+	if v.Suggestion == "" {
+		return v.Message
+	}
+	return v.Message + "\n💡 " + v.Suggestion
+}
+
+// rdjsonResult reviewdogのDiagnosticResult（rdjson形式）
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+// rdjsonSource 診断の出力元
+type rdjsonSource struct {
+	Name string `json:"name"`
+}
+
+// rdjsonDiagnostic 1件の違反
+type rdjsonDiagnostic struct {
+	Message  string         `json:"message"`
+	Location rdjsonLocation `json:"location"`
+	Severity string         `json:"severity"`
+	Code     rdjsonCode     `json:"code"`
+}
+
+// rdjsonLocation 違反の位置（行・列は1始まり）
+type rdjsonLocation struct {
+	Path  string       `json:"path"`
+	Range *rdjsonRange `json:"range,omitempty"`
+}
+
+// rdjsonRange 違反の範囲（開始位置のみ）
+type rdjsonRange struct {
+	Start rdjsonPosition `json:"start"`
+}
+
+// rdjsonPosition 行・列
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+// rdjsonCode ルールの識別子（カテゴリ/ルール）
+type rdjsonCode struct {
+	Value string `json:"value"`
+}
+
+// ToRDJSON reviewdogのrdjson形式で出力（reviewdog -f=rdjson で読み込む）
+func (r *Report) ToRDJSON() ([]byte, error) {
+	result := rdjsonResult{Source: rdjsonSource{Name: toolName}, Diagnostics: []rdjsonDiagnostic{}}
+	for _, f := range r.violationsByFile() {
+		for _, v := range f.Violations {
+			d := rdjsonDiagnostic{
+				Message:  annotationMessage(v),
+				Location: rdjsonLocation{Path: r.annotationPath(v.File)},
+				Severity: strings.ToUpper(string(v.Severity)),
+				Code:     rdjsonCode{Value: v.Category + "/" + v.Rule},
+			}
+			if v.Line > 0 {
+				d.Location.Range = &rdjsonRange{Start: rdjsonPosition{Line: v.Line, Column: v.Column}}
+			}
+			result.Diagnostics = append(result.Diagnostics, d)
+		}
+	}
+	return marshalJSONLine(result)
+}
+
+// gitlabIssue GitLabのCode Quality レポートの1件（マージリクエストの差分に表示される）
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+// gitlabLocation 違反の位置
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+// gitlabLines 違反の行
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+// ToGitLab GitLabのCode Quality レポート形式で出力（artifacts:reports:codequality に指定する）
+// fingerprintは行番号・出力言語を含まないため、違反の前の行を編集しても同じ違反として扱われる
+func (r *Report) ToGitLab() ([]byte, error) {
+	issues := []gitlabIssue{}
+	seen := make(map[string]int)
+	for _, f := range r.violationsByFile() {
+		for _, v := range f.Violations {
+			path := r.annotationPath(v.File)
+			key := strings.Join([]string{v.Category, v.Rule, path, strings.TrimSpace(v.Code), v.sourceMessage()}, "\x00")
+			seen[key]++
+			sum := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(seen[key])))
+			issues = append(issues, gitlabIssue{
+				Description: annotationMessage(v),
+				CheckName:   v.Category + "/" + v.Rule,
+				Fingerprint: hex.EncodeToString(sum[:16]),
+				Severity:    gitlabSeverity(v.Severity),
+				Location:    gitlabLocation{Path: path, Lines: gitlabLines{Begin: max(v.Line, 1)}},
+			})
+		}
+	}
+	return marshalJSONLine(issues)
+}
+
+// gitlabSeverity 重要度に対応するCode Qualityの重要度
+func gitlabSeverity(s rules.Severity) string {
+	switch s {
+	case rules.SeverityError:
+		return "critical"
+	case rules.SeverityWarning:
+		return "major"
+	}
+	return "info"
+}
+
+// marshalJSONLine インデントしたJSONを改行付きで作成
+func marshalJSONLine(v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,40 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-standards-checker/rules"
+)
+
+func TestAnnotationPathsFromRepoRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoRoot string
+		want     string
+	}{
+		{name: "nested target", repoRoot: "/repo", want: "services/api/handler/user.go"},
+		{name: "target is repo root", repoRoot: "/repo/services/api", want: "handler/user.go"},
+		{name: "repo root not set", want: "handler/user.go"},
+	}
+	formats := []string{"github", "rdjson", "gitlab"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReport("/repo/services/api")
+			r.SetRepoRoot(tt.repoRoot)
+			r.AddViolation(Violation{File: "/repo/services/api/handler/user.go", Line: 12, Category: "naming", Rule: "receiver_name", Message: "m"})
+			filtered := r.Filter(rules.SeverityInfo)
+
+			for _, format := range formats {
+				out, _, err := filtered.Render(format)
+				if err != nil {
+					t.Fatalf("%s: %v", format, err)
+				}
+				if !strings.Contains(string(out), tt.want) || strings.Contains(string(out), "/"+tt.want) {
+					t.Errorf("%s: path %q not found in %s", format, tt.want, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TodoItem TODO/FIXMEコメント（バックログの項目）
+type TodoItem struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Keyword string `json:"keyword"`            // キーワード（TODO、FIXME等）
+	Owner   string `json:"owner,omitempty"`    // TODO(owner) の担当者
+	Issue   string `json:"issue,omitempty"`    // チケット番号
+	Link    string `json:"link,omitempty"`     // チケットのURL
+	Text    string `json:"text"`               // キーワード・担当者を除いた本文
+	Added   string `json:"added,omitempty"`    // git blameによる追加日（YYYY-MM-DD）
+	AgeDays int    `json:"age_days,omitempty"` // 追加からの経過日数
+}
+
+// AddTodo バックログに項目を追加
+func (r *Report) AddTodo(item TodoItem) {
+	r.Backlog = append(r.Backlog, item)
+}
+
+// SetTodoAges 各項目の追加日を求め、nowからの経過日数を設定する
+// addedがfalseを返した項目（gitで管理されていない行等）は追加日なしとする
+func (r *Report) SetTodoAges(added func(item TodoItem) (time.Time, bool), now time.Time) {
+	for i := range r.Backlog {
+		at, ok := added(r.Backlog[i])
+		if !ok {
+			continue
+		}
+		r.Backlog[i].Added = at.Format("2006-01-02")
+		r.Backlog[i].AgeDays = int(now.Sub(at).Hours() / 24)
+	}
+}
+
+// backlogText 担当者ごとに古い順に並べたバックログ
+func (r *Report) backlogText() string {
+	groups := make(map[string][]TodoItem)
+	var owners []string
+	for _, item := range r.Backlog {
+		owner := item.Owner
+		if owner == "" {
+			owner = UnownedLabel
+		}
+		if _, ok := groups[owner]; !ok {
+			owners = append(owners, owner)
+		}
+		groups[owner] = append(groups[owner], item)
+	}
+	sort.Strings(owners)
+
+	var sb strings.Builder
+	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	sb.WriteString(fmt.Sprintf("                         BACKLOG (%d TODO/FIXME)\n", len(r.Backlog)))
+	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n\n")
+	for _, owner := range owners {
+		items := groups[owner]
+		sort.SliceStable(items, func(i, j int) bool { return items[i].AgeDays > items[j].AgeDays })
+		sb.WriteString(fmt.Sprintf("👤 %s (%d)\n", owner, len(items)))
+		for _, item := range items {
+			sb.WriteString(fmt.Sprintf("  • %s %s:%d", item.Keyword, r.relPath(item.File), item.Line))
+			if item.Added != "" {
+				sb.WriteString(fmt.Sprintf(" [%dd, %s]", item.AgeDays, item.Added))
+			}
+			if item.Issue != "" && !strings.Contains(item.Text, item.Issue) {
+				sb.WriteString(" " + item.Issue)
+			}
+			if item.Text != "" {
+				sb.WriteString(": " + item.Text)
+			}
+			sb.WriteString("\n")
+			if item.Link != "" {
+				sb.WriteString(fmt.Sprintf("    🔗 %s\n", item.Link))
+			}
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,118 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// baselineVersion ベースラインファイルの形式のバージョン
+const baselineVersion = 1
+
+// Baseline 導入時点の違反のスナップショット（-baseline）
+// 以降のチェックではベースラインにある違反を除き、新たに増えた違反のみを報告する
+type Baseline struct {
+	Version    int             `json:"version"`
+	Violations []BaselineEntry `json:"violations"`
+}
+
+// BaselineEntry ベースラインの違反
+// ルール・ファイル・フィンガープリントで照合するため、行番号が変わっても同じ違反として扱う
+type BaselineEntry struct {
+	Rule        string `json:"rule"`
+	File        string `json:"file"`        // プロジェクトからの相対パス
+	Fingerprint string `json:"fingerprint"` // 該当コード行（前後の空白を除く、無い場合は翻訳前のメッセージ）のハッシュ
+	Line        int    `json:"line"`        // 作成時の行番号（参考、照合には使わない）
+	Message     string `json:"message"`
+}
+
+// key 照合に使うキー
+func (e BaselineEntry) key() string {
+	return e.Rule + "\x00" + e.File + "\x00" + e.Fingerprint
+}
+
+// baselineEntry 違反のベースラインのエントリ
+func (r *Report) baselineEntry(v Violation) BaselineEntry {
+	text := strings.TrimSpace(v.Code)
+	if text == "" {
+		text = v.sourceMessage()
+	}
+	sum := sha256.Sum256([]byte(text))
+	return BaselineEntry{
+		Rule:        v.Rule,
+		File:        r.relPath(v.File),
+		Fingerprint: hex.EncodeToString(sum[:8]),
+		Line:        v.Line,
+		Message:     v.Message,
+	}
+}
+
+// relPath プロジェクトからの相対パス（スラッシュ区切り）
+func (r *Report) relPath(path string) string {
+	if rel, err := filepath.Rel(r.ProjectPath, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(path)
+}
+
+// Baseline レポートのすべての違反のベースラインを作成
+func (r *Report) Baseline() *Baseline {
+	b := &Baseline{Version: baselineVersion, Violations: make([]BaselineEntry, 0, len(r.Violations))}
+	for _, v := range r.Violations {
+		b.Violations = append(b.Violations, r.baselineEntry(v))
+	}
+	return b
+}
+
+// ExcludeBaseline ベースラインにある違反を取り除き、取り除いた件数を返す
+// 同じルール・ファイル・コードの違反が複数ある場合は、ベースラインにある件数までを取り除く
+func (r *Report) ExcludeBaseline(b *Baseline) int {
+	remaining := make(map[string]int, len(b.Violations))
+	for _, e := range b.Violations {
+		remaining[e.key()]++
+	}
+
+	kept := r.Violations[:0]
+	excluded := 0
+	for _, v := range r.Violations {
+		key := r.baselineEntry(v).key()
+		if remaining[key] > 0 {
+			remaining[key]--
+			excluded++
+			continue
+		}
+		kept = append(kept, v)
+	}
+	r.Violations = kept
+	r.Summary.Baselined += excluded
+	return excluded
+}
+
+// WriteBaseline ベースラインをファイルに書き込む
+func WriteBaseline(path string, b *Baseline) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// LoadBaseline ベースラインファイルを読み込む
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if b.Version != baselineVersion {
+		return nil, fmt.Errorf("%s: unsupported baseline version %d", path, b.Version)
+	}
+	return &b, nil
+}
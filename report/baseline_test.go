@@ -0,0 +1,75 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// reportIn 出力言語langで違反を追加したレポート
+func reportIn(lang string, violations []Violation) *Report {
+	r := NewReport("/proj")
+	r.SetLanguage(lang)
+	for _, v := range violations {
+		r.AddViolation(v)
+	}
+	return r
+}
+
+func TestBaselineAcrossLanguages(t *testing.T) {
+	violations := []Violation{
+		{File: "/proj/a.go", Line: 3, Rule: "max_function_lines", Message: "関数 'f' は80行あります（上限: 50行）"},
+		{File: "/proj/a.go", Line: 9, Rule: "no_panic", Message: "panicの使用は避け、エラーを返却してください", Code: "\tpanic(err)"},
+	}
+	tests := []struct {
+		name     string
+		from, to string
+	}{
+		{name: "ja to en", from: LanguageJapanese, to: LanguageEnglish},
+		{name: "en to ja", from: LanguageEnglish, to: LanguageJapanese},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := reportIn(tt.from, violations)
+			after := reportIn(tt.to, violations)
+			if before.Violations[0].Message == after.Violations[0].Message {
+				t.Fatalf("message not translated: %q", before.Violations[0].Message)
+			}
+
+			if n := after.ExcludeBaseline(before.Baseline()); n != len(violations) {
+				t.Errorf("excluded = %d, want %d: %+v", n, len(violations), after.Violations)
+			}
+		})
+	}
+}
+
+func TestCompareAcrossLanguages(t *testing.T) {
+	violations := []Violation{
+		{File: "/proj/a.go", Line: 3, Rule: "max_function_lines", Message: "関数 'f' は80行あります（上限: 50行）"},
+	}
+	dir := t.TempDir()
+	load := func(name string, r *Report) *Report {
+		t.Helper()
+		data, err := r.ToJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := LoadReport(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return loaded
+	}
+
+	old := load("old.json", reportIn(LanguageJapanese, violations))
+	cur := load("new.json", reportIn(LanguageEnglish, violations))
+	c := Compare("old.json", old, "new.json", cur)
+	if c.Summary != (CompareCounts{Unchanged: 1}) {
+		t.Errorf("summary = %+v, want 1 unchanged", c.Summary)
+	}
+}
@@ -0,0 +1,31 @@
+package report
+
+// ChangedLines ファイル（絶対パス）ごとの追加・変更された行番号
+type ChangedLines map[string]map[int]bool
+
+// Add ファイルのstart行からcount行を追加する
+func (c ChangedLines) Add(file string, start, count int) {
+	if c[file] == nil {
+		c[file] = make(map[int]bool)
+	}
+	for line := start; line < start+count; line++ {
+		c[file][line] = true
+	}
+}
+
+// ExcludeOutsideDiff 追加・変更された行以外の違反を取り除き、取り除いた件数を返す（-diff）
+// 行を持たない違反（ディレクトリ構成・パッケージ単位の違反等）も取り除く
+func (r *Report) ExcludeOutsideDiff(changed ChangedLines) int {
+	kept := r.Violations[:0]
+	excluded := 0
+	for _, v := range r.Violations {
+		if !changed[v.File][v.Line] {
+			excluded++
+			continue
+		}
+		kept = append(kept, v)
+	}
+	r.Violations = kept
+	r.Summary.OutsideDiff += excluded
+	return excluded
+}
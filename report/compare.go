@@ -0,0 +1,245 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 2つのレポートの比較（compare）
+// ========================================
+
+// Comparison 2つのJSON形式のレポートの違反の比較
+// ベースラインと同じくルール・ファイル・フィンガープリントで照合するため、行番号が変わっても同じ違反として扱う
+type Comparison struct {
+	Old                 string                   `json:"old"`
+	New                 string                   `json:"new"`
+	Summary             CompareCounts            `json:"summary"`
+	BySeverity          map[string]CompareCounts `json:"by_severity"`
+	ByRule              []RuleComparison         `json:"by_rule"`
+	NewViolations       []Violation              `json:"new_violations"`       // 新しいレポートにのみある違反
+	FixedViolations     []Violation              `json:"fixed_violations"`     // 古いレポートにのみある違反
+	UnchangedViolations []Violation              `json:"unchanged_violations"` // 両方のレポートにある違反（新しいレポートの位置）
+}
+
+// CompareCounts 新規・修正済み・変化なしの件数
+type CompareCounts struct {
+	New       int `json:"new"`
+	Fixed     int `json:"fixed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// RuleComparison ルールごとの件数
+type RuleComparison struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"` // 新しいレポート（無ければ古いレポート）の違反の重要度
+	CompareCounts
+}
+
+// LoadReport JSON形式のレポート（-format json の出力）を読み込む
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if r.ProjectPath == "" {
+		return nil, fmt.Errorf("%s: JSON形式のレポートではありません", path)
+	}
+	return &r, nil
+}
+
+// Compare oldからnewへの違反の変化を求める（違反のファイルはそれぞれのプロジェクトからの相対パスにする）
+// 同じルール・ファイル・コードの違反が複数ある場合は件数で照合する
+func Compare(oldName string, old *Report, newName string, cur *Report) *Comparison {
+	c := &Comparison{
+		Old:                 oldName,
+		New:                 newName,
+		BySeverity:          make(map[string]CompareCounts),
+		NewViolations:       []Violation{},
+		FixedViolations:     []Violation{},
+		UnchangedViolations: []Violation{},
+	}
+	remaining := make(map[string][]Violation, len(old.Violations))
+	for _, v := range old.Violations {
+		key := old.baselineEntry(v).key()
+		remaining[key] = append(remaining[key], old.relViolation(v))
+	}
+	for _, v := range cur.Violations {
+		key := cur.baselineEntry(v).key()
+		if len(remaining[key]) > 0 {
+			remaining[key] = remaining[key][1:]
+			c.UnchangedViolations = append(c.UnchangedViolations, cur.relViolation(v))
+			continue
+		}
+		c.NewViolations = append(c.NewViolations, cur.relViolation(v))
+	}
+	for _, v := range old.Violations {
+		key := old.baselineEntry(v).key()
+		if len(remaining[key]) > 0 {
+			c.FixedViolations = append(c.FixedViolations, remaining[key][0])
+			remaining[key] = remaining[key][1:]
+		}
+	}
+	sortViolations(c.NewViolations)
+	sortViolations(c.FixedViolations)
+	sortViolations(c.UnchangedViolations)
+	c.count()
+	return c
+}
+
+// relViolation ファイルをプロジェクトからの相対パスにした違反
+func (r *Report) relViolation(v Violation) Violation {
+	v.File = r.relPath(v.File)
+	return v
+}
+
+// sortViolations ファイル・行・列・ルールの順に並べる
+func sortViolations(vs []Violation) {
+	sort.SliceStable(vs, func(i, j int) bool {
+		a, b := vs[i], vs[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Rule < b.Rule
+	})
+}
+
+// count 全体・重要度ごと・ルールごとの件数を集計する
+// ルールは新規の多い順（同数の場合は修正済みの多い順、ルール名順）
+func (c *Comparison) count() {
+	byRule := make(map[string]*RuleComparison)
+	add := func(v Violation, inc func(*CompareCounts)) {
+		inc(&c.Summary)
+		counts := c.BySeverity[string(v.Severity)]
+		inc(&counts)
+		c.BySeverity[string(v.Severity)] = counts
+		rc, ok := byRule[v.Rule]
+		if !ok {
+			rc = &RuleComparison{Rule: v.Rule, Severity: string(v.Severity)}
+			byRule[v.Rule] = rc
+		}
+		inc(&rc.CompareCounts)
+	}
+	for _, v := range c.NewViolations {
+		add(v, func(n *CompareCounts) { n.New++ })
+	}
+	for _, v := range c.UnchangedViolations {
+		add(v, func(n *CompareCounts) { n.Unchanged++ })
+	}
+	for _, v := range c.FixedViolations {
+		add(v, func(n *CompareCounts) { n.Fixed++ })
+	}
+
+	c.ByRule = make([]RuleComparison, 0, len(byRule))
+	for _, rc := range byRule {
+		c.ByRule = append(c.ByRule, *rc)
+	}
+	sort.Slice(c.ByRule, func(i, j int) bool {
+		a, b := c.ByRule[i], c.ByRule[j]
+		if a.New != b.New {
+			return a.New > b.New
+		}
+		if a.Fixed != b.Fixed {
+			return a.Fixed > b.Fixed
+		}
+		return a.Rule < b.Rule
+	})
+}
+
+// NewAtLeast 重要度がminSeverity以上の新規の違反の件数
+func (c *Comparison) NewAtLeast(minSeverity rules.Severity) int {
+	n := 0
+	for _, v := range c.NewViolations {
+		if v.Severity.Level() >= minSeverity.Level() {
+			n++
+		}
+	}
+	return n
+}
+
+// ToJSON 比較結果をJSON形式で出力
+func (c *Comparison) ToJSON() (string, error) {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ToText 比較結果をテキスト形式で出力（変化なしの違反は件数のみ）
+func (c *Comparison) ToText() string {
+	var sb strings.Builder
+	sb.WriteString("🔀 Go Standards Checker - Compare\n\n")
+	sb.WriteString("Old: " + c.Old + "\n")
+	sb.WriteString("New: " + c.New + "\n\n")
+	sb.WriteString(fmt.Sprintf("🆕 New:       %d\n", c.Summary.New))
+	sb.WriteString(fmt.Sprintf("✅ Fixed:     %d\n", c.Summary.Fixed))
+	sb.WriteString(fmt.Sprintf("➖ Unchanged: %d\n", c.Summary.Unchanged))
+	if len(c.ByRule) == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("\nBy Severity:\n")
+	for _, s := range []rules.Severity{rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo} {
+		n := c.BySeverity[string(s)]
+		sb.WriteString(fmt.Sprintf("  %s %-8s  %+5d  new %d  fixed %d  unchanged %d\n",
+			severityIcon(s), s, n.New-n.Fixed, n.New, n.Fixed, n.Unchanged))
+	}
+	sb.WriteString(c.ruleTable())
+	writeCompared(&sb, "New Violations", c.NewViolations)
+	writeCompared(&sb, "Fixed Violations", c.FixedViolations)
+	return sb.String()
+}
+
+// ruleTable ルールごとの件数の一覧
+func (c *Comparison) ruleTable() string {
+	width := len("Rule")
+	for _, rc := range c.ByRule {
+		width = max(width, len(rc.Rule))
+	}
+	var sb strings.Builder
+	sb.WriteString("\nBy Rule:\n")
+	sb.WriteString(fmt.Sprintf("  %-*s  %-8s  %5s  %5s  %9s\n", width, "Rule", "Severity", "New", "Fixed", "Unchanged"))
+	for _, rc := range c.ByRule {
+		sb.WriteString(fmt.Sprintf("  %-*s  %-8s  %5d  %5d  %9d\n", width, rc.Rule, rc.Severity, rc.New, rc.Fixed, rc.Unchanged))
+	}
+	return sb.String()
+}
+
+// writeCompared 違反の一覧を1件1行で出力する（無い場合は出力しない）
+func writeCompared(sb *strings.Builder, title string, vs []Violation) {
+	if len(vs) == 0 {
+		return
+	}
+	sb.WriteString("\n" + title + ":\n")
+	for _, v := range vs {
+		sb.WriteString(fmt.Sprintf("  %s %s:%d:%d [%s] %s\n", severityIcon(v.Severity), v.File, v.Line, max(v.Column, 1), v.Rule, v.Message))
+	}
+}
+
+// severityIcon 重要度のアイコン
+func severityIcon(s rules.Severity) string {
+	switch s {
+	case rules.SeverityError:
+		return "🔴"
+	case rules.SeverityWarning:
+		return "🟡"
+	default:
+		return "🔵"
+	}
+}
@@ -0,0 +1,165 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext unified diffで変更の前後に出力する行数
+const diffContext = 3
+
+// maxDiffCells 行単位のLCSを求める表の最大サイズ（超える場合は変更範囲全体を置き換えとして出力する）
+const maxDiffCells = 4 << 20
+
+// diffLine 差分の1行（' ': 共通、'-': 削除、'+': 追加）
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff 2つのテキストのunified diff（差分が無い場合は空）
+func unifiedDiff(oldName, newName, a, b string) string {
+	if a == b {
+		return ""
+	}
+	lines := diffLines(strings.SplitAfter(a, "\n"), strings.SplitAfter(b, "\n"))
+
+	var sb strings.Builder
+	sb.WriteString("--- " + oldName + "\n+++ " + newName + "\n")
+	for i := 0; i < len(lines); {
+		if lines[i].kind == ' ' {
+			i++
+			continue
+		}
+		// 間の共通行がdiffContext*2以下の変更は同じハンクにまとめる
+		end := i
+		for j := i; j < len(lines) && j <= end+diffContext*2; j++ {
+			if lines[j].kind != ' ' {
+				end = j
+			}
+		}
+		start := max(i-diffContext, 0)
+		stop := min(end+1+diffContext, len(lines))
+		writeHunk(&sb, lines, start, stop)
+		i = stop
+	}
+	return sb.String()
+}
+
+// writeHunk lines[start:stop] をハンクとして出力する
+func writeHunk(sb *strings.Builder, lines []diffLine, start, stop int) {
+	oldLine, newLine := 1, 1
+	for _, l := range lines[:start] {
+		if l.kind != '+' {
+			oldLine++
+		}
+		if l.kind != '-' {
+			newLine++
+		}
+	}
+	oldCount, newCount := 0, 0
+	for _, l := range lines[start:stop] {
+		if l.kind != '+' {
+			oldCount++
+		}
+		if l.kind != '-' {
+			newCount++
+		}
+	}
+	if oldCount == 0 {
+		oldLine--
+	}
+	if newCount == 0 {
+		newLine--
+	}
+	sb.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldLine, oldCount, newLine, newCount))
+	for _, l := range lines[start:stop] {
+		sb.WriteByte(l.kind)
+		sb.WriteString(l.text)
+		if !strings.HasSuffix(l.text, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// diffLines 行の列の差分（共通の先頭・末尾を除いた範囲をLCSで比較する）
+func diffLines(a, b []string) []diffLine {
+	// SplitAfterは末尾が改行の場合に空の要素を返す
+	if len(a) > 0 && a[len(a)-1] == "" {
+		a = a[:len(a)-1]
+	}
+	if len(b) > 0 && b[len(b)-1] == "" {
+		b = b[:len(b)-1]
+	}
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, s := range a[:prefix] {
+		lines = append(lines, diffLine{' ', s})
+	}
+	lines = append(lines, lcsDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, s := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', s})
+	}
+	return lines
+}
+
+// lcsDiff 最長共通部分列による差分
+func lcsDiff(a, b []string) []diffLine {
+	var lines []diffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, s := range a {
+			lines = append(lines, diffLine{'-', s})
+		}
+		for _, s := range b {
+			lines = append(lines, diffLine{'+', s})
+		}
+		return lines
+	}
+
+	// lcs[i][j] は a[i:] と b[j:] の最長共通部分列の長さ
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
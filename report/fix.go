@@ -0,0 +1,297 @@
+package report
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FileChange 修正を適用したファイルの変更内容（-fix）
+type FileChange struct {
+	Path    string // 変更前のパス
+	NewPath string // 名前を変更する場合の変更後のパス（変更しない場合はPathと同じ）
+	Before  []byte
+	After   []byte // 修正を適用しgofmtで整形した内容
+	Fixes   int    // このファイルの違反のうち修正したものの数
+}
+
+// fileFix ファイルごとに集めた修正
+type fileFix struct {
+	edits  []TextEdit
+	rename string
+	fixes  int
+}
+
+// PlanFixes 違反の修正をファイルごとにまとめ、適用後の内容を求める（ファイルは変更しない）
+// 既に採用した修正と範囲が重なる修正は適用しない。修正によって使われなくなったimportは削除する
+// 戻り値のskippedは重なりのため、または整形できない結果になったため適用しなかった修正の数
+func PlanFixes(violations []Violation) (changes []*FileChange, skipped int, err error) {
+	files, skipped := collectFixes(violations)
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		ff := files[p]
+		before, err := os.ReadFile(p)
+		if err != nil {
+			return nil, skipped, err
+		}
+		after, err := applyEdits(before, ff.edits)
+		if err != nil {
+			skipped += ff.fixes
+			continue
+		}
+		change := &FileChange{Path: p, NewPath: p, Before: before, After: after, Fixes: ff.fixes}
+		if ff.rename != "" {
+			change.NewPath = ff.rename
+		}
+		changes = append(changes, change)
+	}
+	return changes, skipped, nil
+}
+
+// collectFixes 重ならない修正をファイルごとに集める（重なったため採用しなかった修正の数も返す）
+func collectFixes(violations []Violation) (map[string]*fileFix, int) {
+	files := make(map[string]*fileFix)
+	get := func(path string) *fileFix {
+		if files[path] == nil {
+			files[path] = &fileFix{}
+		}
+		return files[path]
+	}
+
+	skipped := 0
+	for _, v := range violations {
+		if v.Fix == nil || (len(v.Fix.Edits) == 0 && v.Fix.Rename == "") {
+			continue
+		}
+		if conflicts(files, v) {
+			skipped++
+			continue
+		}
+		for _, e := range v.Fix.Edits {
+			ff := get(editFile(v, e))
+			if !containsEdit(ff.edits, e) {
+				ff.edits = append(ff.edits, e)
+			}
+		}
+		ff := get(v.File)
+		if v.Fix.Rename != "" {
+			ff.rename = v.Fix.Rename
+		}
+		ff.fixes++
+	}
+	return files, skipped
+}
+
+// editFile 編集の対象のファイル
+func editFile(v Violation, e TextEdit) string {
+	if e.File != "" {
+		return e.File
+	}
+	return v.File
+}
+
+// conflicts 違反の修正が既に採用した修正と重なるか（同じ編集は重なりとみなさない）
+func conflicts(files map[string]*fileFix, v Violation) bool {
+	if ff := files[v.File]; ff != nil && v.Fix.Rename != "" && ff.rename != "" && ff.rename != v.Fix.Rename {
+		return true
+	}
+	for _, e := range v.Fix.Edits {
+		ff := files[editFile(v, e)]
+		if ff == nil {
+			continue
+		}
+		for _, other := range ff.edits {
+			if e != other && overlaps(e, other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlaps 2つの編集の範囲が重なるか（同じ位置への挿入も順序が決まらないため重なりとみなす）
+func overlaps(a, b TextEdit) bool {
+	if a.Start == a.End && b.Start == b.End {
+		return a.Start == b.Start
+	}
+	if a.Start == a.End {
+		return b.Start < a.Start && a.Start < b.End
+	}
+	if b.Start == b.End {
+		return a.Start < b.Start && b.Start < a.End
+	}
+	return a.Start < b.End && b.Start < a.End
+}
+
+// containsEdit 同じ編集が既にあるか
+func containsEdit(edits []TextEdit, e TextEdit) bool {
+	for _, other := range edits {
+		if other.Start == e.Start && other.End == e.End && other.NewText == e.NewText {
+			return true
+		}
+	}
+	return false
+}
+
+// applyEdits 編集を適用し、使われなくなったimportを削除してgofmtで整形する
+func applyEdits(src []byte, edits []TextEdit) ([]byte, error) {
+	sorted := append([]TextEdit(nil), edits...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	var out []byte
+	last := 0
+	for _, e := range sorted {
+		if e.Start < last || e.End > len(src) || e.Start > e.End {
+			return nil, fmt.Errorf("invalid edit [%d, %d)", e.Start, e.End)
+		}
+		out = append(out, src[last:e.Start]...)
+		out = append(out, e.NewText...)
+		last = e.End
+	}
+	out = append(out, src[last:]...)
+	if len(edits) == 0 {
+		return out, nil
+	}
+	return format.Source(removeUnusedImports(src, out))
+}
+
+// versionSuffixRe importパスのメジャーバージョンの要素（/v2）
+var versionSuffixRe = regexp.MustCompile(`^v[0-9]+$`)
+
+// importName importパスから推定したパッケージ名
+func importName(importPath string) string {
+	elems := strings.Split(importPath, "/")
+	name := elems[len(elems)-1]
+	if versionSuffixRe.MatchString(name) && len(elems) > 1 {
+		name = elems[len(elems)-2]
+	}
+	name = strings.TrimPrefix(name, "go-")
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// usedPackages ファイル内で pkg.Name の形で参照している名前
+func usedPackages(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+	return used
+}
+
+// unusedImports import宣言のうち、修正前は参照していたが修正後に参照しなくなったもの
+func unusedImports(gd *ast.GenDecl, usedBefore, usedAfter map[string]bool) []ast.Spec {
+	var unused []ast.Spec
+	for _, spec := range gd.Specs {
+		imp := spec.(*ast.ImportSpec)
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := importName(p)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name != "_" && name != "." && usedBefore[name] && !usedAfter[name] {
+			unused = append(unused, spec)
+		}
+	}
+	return unused
+}
+
+// removeUnusedImports 修正前は参照していたが、修正後に参照しなくなったimportを削除する
+func removeUnusedImports(before, after []byte) []byte {
+	oldFile, err := parser.ParseFile(token.NewFileSet(), "", before, 0)
+	if err != nil {
+		return after
+	}
+	fset := token.NewFileSet()
+	newFile, err := parser.ParseFile(fset, "", after, parser.ParseComments)
+	if err != nil {
+		return after
+	}
+	usedBefore, usedAfter := usedPackages(oldFile), usedPackages(newFile)
+
+	type span struct{ start, end int }
+	var spans []span
+	lineSpan := func(node ast.Node) span {
+		tf := fset.File(node.Pos())
+		start := tf.Offset(tf.LineStart(tf.Line(node.Pos())))
+		end := fset.Position(node.End()).Offset
+		if end < len(after) && after[end] == '\n' {
+			end++
+		}
+		return span{start, end}
+	}
+	for _, decl := range newFile.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		unused := unusedImports(gd, usedBefore, usedAfter)
+		if len(unused) > 0 && len(unused) == len(gd.Specs) {
+			spans = append(spans, lineSpan(gd))
+			continue
+		}
+		for _, spec := range unused {
+			spans = append(spans, lineSpan(spec))
+		}
+	}
+
+	for i := len(spans) - 1; i >= 0; i-- {
+		after = append(after[:spans[i].start:spans[i].start], after[spans[i].end:]...)
+	}
+	return after
+}
+
+// Apply 変更をファイルに書き込み、名前の変更があればファイル名を変更する
+// 変更後の名前のファイルが既にある場合は上書きせずエラーを返す
+func (fc *FileChange) Apply() error {
+	if string(fc.Before) != string(fc.After) {
+		info, err := os.Stat(fc.Path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(fc.Path, fc.After, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if fc.NewPath == fc.Path {
+		return nil
+	}
+	if _, err := os.Stat(fc.NewPath); err == nil {
+		return fmt.Errorf("%s: already exists", fc.NewPath)
+	}
+	return os.Rename(fc.Path, fc.NewPath)
+}
+
+// Diff 変更のunified diff（パスはプロジェクトからの相対パス）
+func (r *Report) Diff(fc *FileChange) string {
+	oldName, newName := r.relPath(fc.Path), r.relPath(fc.NewPath)
+	var sb strings.Builder
+	if oldName != newName {
+		sb.WriteString("rename " + oldName + " => " + newName + "\n")
+	}
+	sb.WriteString(unifiedDiff(path.Join("a", oldName), path.Join("b", newName), string(fc.Before), string(fc.After)))
+	return sb.String()
+}
@@ -0,0 +1,155 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 出力形式
+// ========================================
+
+// Formatter レポートの出力形式
+// RegisterFormatterで登録すると settings.report_format・-format・アップロードの format で指定できる
+type Formatter interface {
+	// Format レポートを出力形式のバイト列にする
+	Format(r *Report) ([]byte, error)
+	// ContentType アップロード時のContent-Type
+	ContentType() string
+}
+
+// formatterFunc 関数とContent-TypeのFormatter
+type formatterFunc struct {
+	format      func(r *Report) ([]byte, error)
+	contentType string
+}
+
+func (f formatterFunc) Format(r *Report) ([]byte, error) { return f.format(r) }
+func (f formatterFunc) ContentType() string              { return f.contentType }
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{
+		"text": formatterFunc{func(r *Report) ([]byte, error) { return []byte(r.ToText()), nil }, "text/plain; charset=utf-8"},
+		"json": formatterFunc{func(r *Report) ([]byte, error) {
+			data, err := r.ToJSON()
+			return []byte(data + "\n"), err
+		}, "application/json"},
+		"html": formatterFunc{func(r *Report) ([]byte, error) {
+			data, err := r.ToHTML()
+			return []byte(data), err
+		}, "text/html; charset=utf-8"},
+		"junit":      formatterFunc{(*Report).ToJUnit, "application/xml"},
+		"checkstyle": formatterFunc{(*Report).ToCheckstyle, "application/xml"},
+		"github":     formatterFunc{(*Report).ToGitHub, "text/plain; charset=utf-8"},
+		"rdjson":     formatterFunc{(*Report).ToRDJSON, "application/json"},
+		"gitlab":     formatterFunc{(*Report).ToGitLab, "application/json"},
+		"line":       formatterFunc{(*Report).ToLines, "text/plain; charset=utf-8"},
+	}
+)
+
+// RegisterFormatter 出力形式を追加する（同じ名前の形式は置き換える）
+func RegisterFormatter(name string, f Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[name] = f
+}
+
+// LookupFormatter 名前に対応する出力形式
+func LookupFormatter(name string) (Formatter, bool) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	f, ok := formatters[name]
+	return f, ok
+}
+
+// FormatNames 登録されている出力形式の名前（名前順）
+func FormatNames() []string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render 指定した形式でレポートを作成し、Content-Typeとともに返す
+func (r *Report) Render(format string) ([]byte, string, error) {
+	f, ok := LookupFormatter(format)
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported report format %q (%s)", format, strings.Join(FormatNames(), ", "))
+	}
+	data, err := f.Format(r)
+	return data, f.ContentType(), err
+}
+
+// WriteFormat 指定した形式でwに書き込む（ctxがキャンセルされた場合は書き込まずにctx.Err()を返す）
+func (r *Report) WriteFormat(ctx context.Context, w io.Writer, format string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, _, err := r.Render(format)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// fileViolations ファイルごとの違反（HTML・JUnit・Checkstyle・注釈の形式で使用）
+type fileViolations struct {
+	Path       string
+	Errors     int
+	Warnings   int
+	Infos      int
+	Violations []Violation
+}
+
+// violationsByFile 違反をファイルごとにまとめる（ファイルはルートからの相対パス順、違反は行順）
+func (r *Report) violationsByFile() []fileViolations {
+	byPath := make(map[string]*fileViolations)
+	var paths []string
+	for _, v := range r.Violations {
+		path := r.relPath(v.File)
+		f := byPath[path]
+		if f == nil {
+			f = &fileViolations{Path: path}
+			byPath[path] = f
+			paths = append(paths, path)
+		}
+		switch v.Severity {
+		case rules.SeverityError:
+			f.Errors++
+		case rules.SeverityWarning:
+			f.Warnings++
+		default:
+			f.Infos++
+		}
+		f.Violations = append(f.Violations, v)
+	}
+	sort.Strings(paths)
+
+	files := make([]fileViolations, 0, len(paths))
+	for _, path := range paths {
+		f := byPath[path]
+		sort.SliceStable(f.Violations, func(i, j int) bool {
+			if f.Violations[i].Line != f.Violations[j].Line {
+				return f.Violations[i].Line < f.Violations[j].Line
+			}
+			return f.Violations[i].Column < f.Violations[j].Column
+		})
+		files = append(files, *f)
+	}
+	return files
+}
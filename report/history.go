@@ -0,0 +1,183 @@
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 実行履歴（トレンド）
+// ========================================
+
+// DefaultHistoryFile 実行履歴ファイルの既定名
+const DefaultHistoryFile = ".gostandards-history.json"
+
+// HistoryEntry 1回の実行のサマリー
+type HistoryEntry struct {
+	Timestamp  time.Time      `json:"timestamp"`
+	Commit     string         `json:"commit,omitempty"` // 実行時のgitのコミット（取得できない場合は空）
+	Project    string         `json:"project"`
+	TotalFiles int            `json:"total_files"`
+	Total      int            `json:"total_violations"`
+	Errors     int            `json:"errors"`
+	Warnings   int            `json:"warnings"`
+	Infos      int            `json:"infos"`
+	Score      float64        `json:"score"`
+	ByCategory map[string]int `json:"by_category,omitempty"`
+}
+
+// WeightedTotal 重要度で重み付けした違反数（error=10・warning=3・info=1）
+func (r *Report) WeightedTotal() int {
+	total := 0
+	for severity, n := range r.Summary.BySeverity {
+		total += severityWeight(rules.Severity(severity)) * n
+	}
+	return total
+}
+
+// Score 重要度で重み付けした準拠スコア（0〜100、違反が無ければ100）
+// チェックしたファイル数に対する違反の重みの割合が大きいほど低くなる
+// ベースライン・変更行で除外した後の違反から求め、重要度のフィルター（Filter）の影響は受けない
+func (r *Report) Score() float64 {
+	weighted := r.weightedViolations()
+	if weighted == 0 {
+		return 100
+	}
+	files := float64(max(r.TotalFiles, 1))
+	score := 100 * files / (files + float64(weighted)/10)
+	return float64(int(score*10+0.5)) / 10
+}
+
+// HistoryEntry レポートのサマリーから履歴を作成（Finalize後に呼び出す）
+func (r *Report) HistoryEntry(commit string, at time.Time) HistoryEntry {
+	byCategory := make(map[string]int, len(r.Summary.ByCategory))
+	for category, n := range r.Summary.ByCategory {
+		byCategory[category] = n
+	}
+	return HistoryEntry{
+		Timestamp:  at,
+		Commit:     commit,
+		Project:    r.ProjectPath,
+		TotalFiles: r.TotalFiles,
+		Total:      r.Summary.TotalViolations,
+		Errors:     r.Summary.BySeverity[string(rules.SeverityError)],
+		Warnings:   r.Summary.BySeverity[string(rules.SeverityWarning)],
+		Infos:      r.Summary.BySeverity[string(rules.SeverityInfo)],
+		Score:      r.Score(),
+		ByCategory: byCategory,
+	}
+}
+
+// LoadHistory 履歴ファイルを読み込む（ファイルが無い場合は空）
+func LoadHistory(path string) ([]HistoryEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries []HistoryEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid history %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// AppendHistory 履歴ファイルに1回分を追記する
+func AppendHistory(path string, entry HistoryEntry) error {
+	entries, err := LoadHistory(path)
+	if err != nil {
+		return err
+	}
+	entries = append(entries, entry)
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// TrendText 履歴の推移をテキスト形式で出力（直近limit件、0以下の場合はすべて、langは出力言語）
+func TrendText(entries []HistoryEntry, limit int, lang string) string {
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	var sb strings.Builder
+	sb.WriteString("📈 Go Standards Checker - Trend\n\n")
+	if len(entries) == 0 {
+		sb.WriteString(localizeText(lang, "履歴がありません（-history を指定して実行すると記録されます）\n", "No history (run with -history to record it)\n"))
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("%-16s  %-8s  %6s  %6s  %8s  %6s  %6s  %7s\n",
+		"Date", "Commit", "Files", "Errors", "Warnings", "Info", "Total", "Score"))
+	sb.WriteString(strings.Repeat("─", 76) + "\n")
+	for i, e := range entries {
+		delta := ""
+		if i > 0 {
+			delta = formatDelta(e.Score - entries[i-1].Score)
+		}
+		line := fmt.Sprintf("%-16s  %-8s  %6d  %6d  %8d  %6d  %6d  %5.1f %s",
+			e.Timestamp.Local().Format("2006-01-02 15:04"), shortCommit(e.Commit),
+			e.TotalFiles, e.Errors, e.Warnings, e.Infos, e.Total, e.Score, delta)
+		sb.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+
+	first, last := entries[0], entries[len(entries)-1]
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("Score:      %s  %.1f → %.1f (%s)\n",
+		sparkline(entries, func(e HistoryEntry) float64 { return e.Score }), first.Score, last.Score, formatDelta(last.Score-first.Score)))
+	sb.WriteString(fmt.Sprintf("Violations: %s  %d → %d (%+d)\n",
+		sparkline(entries, func(e HistoryEntry) float64 { return float64(e.Total) }), first.Total, last.Total, last.Total-first.Total))
+	return sb.String()
+}
+
+// formatDelta スコアの増減（改善は▲、悪化は▼）
+func formatDelta(d float64) string {
+	switch {
+	case d > 0.05:
+		return fmt.Sprintf("▲%.1f", d)
+	case d < -0.05:
+		return fmt.Sprintf("▼%.1f", -d)
+	default:
+		return "±0"
+	}
+}
+
+// shortCommit コミットハッシュの短縮形
+func shortCommit(commit string) string {
+	if len(commit) > 8 {
+		return commit[:8]
+	}
+	if commit == "" {
+		return "-"
+	}
+	return commit
+}
+
+// sparkline 値の推移を1行のグラフで表す
+func sparkline(entries []HistoryEntry, value func(HistoryEntry) float64) string {
+	bars := []rune("▁▂▃▄▅▆▇█")
+	lo, hi := value(entries[0]), value(entries[0])
+	for _, e := range entries {
+		lo = min(lo, value(e))
+		hi = max(hi, value(e))
+	}
+	var sb strings.Builder
+	for _, e := range entries {
+		i := 0
+		if hi > lo {
+			i = int((value(e) - lo) / (hi - lo) * float64(len(bars)-1))
+		}
+		sb.WriteRune(bars[i])
+	}
+	return sb.String()
+}
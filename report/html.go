@@ -0,0 +1,132 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"html/template"
+	"io"
+	"sort"
+)
+
+// htmlTemplate HTMLレポートのテンプレート（CSS・スクリプトを含み、外部のファイルを参照しない）
+//
+//go:embed templates/report.html
+var htmlTemplate string
+
+var htmlReport = template.Must(template.New("report").Parse(htmlTemplate))
+
+// htmlData HTMLレポートのテンプレートに渡す値
+type htmlData struct {
+	Project          string
+	TotalFiles       int
+	SkippedGenerated int
+	Summary          Summary
+	Errors           int
+	Warnings         int
+	Infos            int
+	Status           string // failed / warning / passed
+	ScoreDelta       string // 前回のスコアからの増減（実行履歴が無い場合は空）
+	Categories       []htmlBar
+	Owners           []htmlBar
+	Files            []fileViolations
+	Backlog          []TodoItem
+	Lang             string // html要素のlang属性（出力言語）
+	Labels           htmlLabels
+}
+
+// htmlLabels 出力言語に応じたフィルターの文言
+type htmlLabels struct {
+	Filter   string
+	Expand   string
+	Collapse string
+}
+
+// htmlBar 棒グラフの1本（Percentは最大値に対する割合）
+type htmlBar struct {
+	Label   string
+	Count   int
+	Percent int
+}
+
+// ToHTML HTML形式で出力（1ファイルで完結し、CIの成果物としてそのまま公開できる）
+func (r *Report) ToHTML() (string, error) {
+	var buf bytes.Buffer
+	if err := htmlReport.Execute(&buf, r.htmlData()); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// WriteHTML HTML形式でwに書き込む（ctxがキャンセルされた場合は書き込まずにctx.Err()を返す）
+func (r *Report) WriteHTML(ctx context.Context, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	html, err := r.ToHTML()
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, html)
+	return err
+}
+
+// htmlData テンプレートに渡す値を作成
+func (r *Report) htmlData() htmlData {
+	d := htmlData{
+		Project:          r.ProjectPath,
+		TotalFiles:       r.TotalFiles,
+		SkippedGenerated: r.SkippedGenerated,
+		Summary:          r.Summary,
+		Errors:           r.Summary.BySeverity["error"],
+		Warnings:         r.Summary.BySeverity["warning"],
+		Infos:            r.Summary.BySeverity["info"],
+		Categories:       htmlBars(r.Summary.ByCategory),
+		Owners:           htmlBars(r.Summary.ByOwner),
+		Files:            r.violationsByFile(),
+		Backlog:          r.Backlog,
+		Lang:             r.localize(LanguageJapanese, LanguageEnglish),
+		Labels: htmlLabels{
+			Filter:   r.localize("ファイル・ルール・メッセージで絞り込み", "Filter by file, rule or message"),
+			Expand:   r.localize("すべて開く", "Expand all"),
+			Collapse: r.localize("すべて閉じる", "Collapse all"),
+		},
+	}
+	if r.Summary.PreviousScore != nil {
+		d.ScoreDelta = formatDelta(r.Summary.Score - *r.Summary.PreviousScore)
+	}
+	switch {
+	case d.Errors > 0:
+		d.Status = "failed"
+	case d.Warnings > 0:
+		d.Status = "warning"
+	default:
+		d.Status = "passed"
+	}
+	return d
+}
+
+// htmlBars 件数の多い順の棒グラフ
+func htmlBars(counts map[string]int) []htmlBar {
+	bars := make([]htmlBar, 0, len(counts))
+	peak := 0
+	for label, count := range counts {
+		bars = append(bars, htmlBar{Label: label, Count: count})
+		peak = max(peak, count)
+	}
+	sort.Slice(bars, func(i, j int) bool {
+		if bars[i].Count != bars[j].Count {
+			return bars[i].Count > bars[j].Count
+		}
+		return bars[i].Label < bars[j].Label
+	})
+	for i := range bars {
+		if peak > 0 {
+			bars[i].Percent = bars[i].Count * 100 / peak
+		}
+	}
+	return bars
+}
@@ -0,0 +1,211 @@
+package report
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ========================================
+// 出力言語（組み込みのメッセージのカタログ）
+// ========================================
+
+// 出力言語（settings.language・-lang）
+const (
+	LanguageJapanese = "ja" // 既定（組み込みのルールのメッセージは日本語で記述している）
+	LanguageEnglish  = "en"
+)
+
+// Languages 指定できる出力言語
+func Languages() []string {
+	return []string{LanguageJapanese, LanguageEnglish}
+}
+
+// ValidLanguage 出力言語に指定できるか（空の場合は日本語）
+func ValidLanguage(lang string) bool {
+	return lang == "" || slices.Contains(Languages(), lang)
+}
+
+// SetLanguage 以降に追加する違反のメッセージ・提案・修正の説明と、レポートの文言の言語を設定する
+// カタログに無いメッセージ（custom_rules・project_rulesのmessage等）は記述したまま出力する
+func (r *Report) SetLanguage(lang string) {
+	r.lang = lang
+}
+
+// localize レポートの出力言語に応じた文言
+func (r *Report) localize(ja, en string) string {
+	return localizeText(r.lang, ja, en)
+}
+
+// localizeText 出力言語langに応じた文言
+func localizeText(lang, ja, en string) string {
+	if lang == LanguageEnglish {
+		return en
+	}
+	return ja
+}
+
+// Localize 組み立てた文言（CLIの表示・警告等）を出力言語langに翻訳する（カタログに無い場合はそのまま）
+// 前後の改行・空白は翻訳せずにそのまま残す
+func Localize(lang, s string) string {
+	if lang != LanguageEnglish {
+		return s
+	}
+	body := strings.TrimLeft(s, " \n")
+	lead := s[:len(s)-len(body)]
+	trimmed := strings.TrimRight(body, " \n")
+	return lead + englishCatalog.translate(trimmed) + body[len(trimmed):]
+}
+
+// localizeViolation 違反のメッセージ・提案・修正の説明を出力言語に翻訳する
+func (r *Report) localizeViolation(v Violation) Violation {
+	if r.lang != LanguageEnglish {
+		return v
+	}
+	if message := englishCatalog.translate(v.Message); message != v.Message {
+		v.SourceMessage = v.sourceMessage()
+		v.Message = message
+	}
+	v.Suggestion = englishCatalog.translate(v.Suggestion)
+	if v.Fix != nil && v.Fix.Description != "" {
+		fix := *v.Fix
+		fix.Description = englishCatalog.translate(fix.Description)
+		v.Fix = &fix
+	}
+	return v
+}
+
+// maxCatalogDepth 埋め込まれた文字列を再帰的に翻訳する深さの上限
+const maxCatalogDepth = 4
+
+// catalog 日本語のメッセージのテンプレートから他の言語のメッセージへの対応
+//
+// テンプレートの {*} は任意の文字列に一致し、翻訳先の {1}・{2}… に一致した順に埋め込む
+// 埋め込む文字列が日本語を含む場合は、それ自体もカタログで翻訳する（「関数」「型」等の断片を含む）
+type catalog struct {
+	source map[string]string
+
+	once    sync.Once
+	exact   map[string]string // {*} を含まないテンプレート
+	entries []catalogEntry    // {*} を含むテンプレート（固定の文字列の長い順）
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// catalogEntry {*} を含むテンプレート
+type catalogEntry struct {
+	prefix  string // 最初の {*} より前の固定の文字列（候補の絞り込み用）
+	literal int    // 固定の文字列の長さ（長いほど具体的なため先に試す）
+	pattern *regexp.Regexp
+	text    string
+}
+
+// catalogArg 翻訳先のメッセージの埋め込み位置
+var catalogArg = regexp.MustCompile(`\{(\d)\}`)
+
+// englishCatalog 英語のカタログ（messages_en.go）
+var englishCatalog = &catalog{source: enMessages}
+
+// compile テンプレートを完全一致のものと正規表現のものに分ける
+func (c *catalog) compile() {
+	c.exact = make(map[string]string)
+	c.cache = make(map[string]string)
+	for ja, text := range c.source {
+		parts := strings.Split(ja, "{*}")
+		if len(parts) == 1 {
+			c.exact[ja] = text
+			continue
+		}
+		quoted := make([]string, len(parts))
+		for i, p := range parts {
+			quoted[i] = regexp.QuoteMeta(p)
+		}
+		c.entries = append(c.entries, catalogEntry{
+			prefix:  parts[0],
+			literal: len(ja) - 3*(len(parts)-1),
+			pattern: regexp.MustCompile("^" + strings.Join(quoted, "(.*?)") + "$"),
+			text:    text,
+		})
+	}
+	sort.Slice(c.entries, func(i, j int) bool {
+		if c.entries[i].literal != c.entries[j].literal {
+			return c.entries[i].literal > c.entries[j].literal
+		}
+		return c.entries[i].pattern.String() < c.entries[j].pattern.String()
+	})
+}
+
+// translate メッセージを翻訳する（カタログに無い場合はそのまま）
+func (c *catalog) translate(s string) string {
+	if !hasJapanese(s) {
+		return s
+	}
+	c.once.Do(c.compile)
+	c.mu.Lock()
+	t, ok := c.cache[s]
+	c.mu.Unlock()
+	if ok {
+		return t
+	}
+	t = c.lookup(s, 0)
+	c.mu.Lock()
+	c.cache[s] = t
+	c.mu.Unlock()
+	return t
+}
+
+// lookup 完全一致・テンプレートの順に探し、無ければ「、」で区切った列挙を要素ごとに翻訳する
+// 列挙は要素をすべて翻訳できた場合のみ翻訳する（コードの断片等を途中まで翻訳しない）
+// 埋め込む文字列（depth > 0）の「・」区切りの列挙は「/」区切りにする
+func (c *catalog) lookup(s string, depth int) string {
+	if depth > 0 && !hasJapanese(s) {
+		return strings.ReplaceAll(s, "・", "/")
+	}
+	if depth > maxCatalogDepth || !hasJapanese(s) {
+		return s
+	}
+	if t, ok := c.exact[s]; ok {
+		return t
+	}
+	for _, e := range c.entries {
+		if !strings.HasPrefix(s, e.prefix) {
+			continue
+		}
+		m := e.pattern.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		return catalogArg.ReplaceAllStringFunc(e.text, func(ref string) string {
+			i, _ := strconv.Atoi(ref[1 : len(ref)-1])
+			if i < 1 || i >= len(m) {
+				return ref
+			}
+			return c.lookup(m[i], depth+1)
+		})
+	}
+	if items := strings.Split(s, "、"); len(items) > 1 {
+		for i, item := range items {
+			items[i] = c.lookup(item, depth+1)
+			if hasJapanese(items[i]) {
+				return s
+			}
+		}
+		return strings.Join(items, "; ")
+	}
+	return s
+}
+
+// hasJapanese 平仮名・片仮名・漢字を含むか
+func hasJapanese(s string) bool {
+	for _, r := range s {
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+			return true
+		}
+	}
+	return false
+}
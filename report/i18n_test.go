@@ -0,0 +1,123 @@
+package report
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// formatVerbRe 書式指定子（%%は別に扱う）
+var formatVerbRe = regexp.MustCompile(`%[-+# 0-9.*\[\]]*[a-zA-Z]`)
+
+// renderedMessage 文字列の式を、埋め込む値を "x" として組み立てた文字列
+// 文字列リテラル・+ による連結・fmt.Sprintf の書式を組み立てられた場合はokがtrue
+func renderedMessage(e ast.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			s, err := strconv.Unquote(e.Value)
+			return s, err == nil
+		}
+	case *ast.ParenExpr:
+		return renderedMessage(e.X)
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			x, okX := renderedMessage(e.X)
+			y, okY := renderedMessage(e.Y)
+			return x + y, okX || okY
+		}
+	case *ast.CallExpr:
+		if format, ok := sprintfFormat(e); ok {
+			if s, ok := renderedMessage(format); ok {
+				s = strings.ReplaceAll(s, "%%", "\x00")
+				s = formatVerbRe.ReplaceAllString(s, "x")
+				return strings.ReplaceAll(s, "\x00", "%"), true
+			}
+		}
+	}
+	return "x", false
+}
+
+// sprintfFormat fmt.Sprintf・fmt.Errorfの呼び出しの書式
+func sprintfFormat(call *ast.CallExpr) (ast.Expr, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil, false
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "fmt" || (sel.Sel.Name != "Sprintf" && sel.Sel.Name != "Errorf") {
+		return nil, false
+	}
+	return call.Args[0], true
+}
+
+// builtinMessages ディレクトリのGoファイル（テストを除く）で組み立てている日本語のメッセージ（位置→メッセージ）
+// fmt.Sprintfの引数に渡す断片（「関数」等）も1つのメッセージとして含める
+// 警告・エラーもCLIが出力言語に翻訳して表示するため含める
+func builtinMessages(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	messages := make(map[string]string)
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		// マップのキー（genericWrapPrefixes等）はメッセージではない
+		if kv, ok := n.(*ast.KeyValueExpr); ok {
+			ast.Inspect(kv.Value, visit)
+			return false
+		}
+		e, ok := n.(ast.Expr)
+		if !ok {
+			return true
+		}
+		s, ok := renderedMessage(e)
+		if !ok {
+			return true
+		}
+		if hasJapanese(s) {
+			messages[fset.Position(e.Pos()).String()] = s
+		}
+		// 書式に埋め込む引数の断片も確認する
+		if call, ok := e.(*ast.CallExpr); ok {
+			for _, arg := range call.Args[1:] {
+				ast.Inspect(arg, visit)
+			}
+		}
+		return false
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(file, visit)
+	}
+	return messages
+}
+
+// TestEnglishCatalogCoverage 組み込みのルール・設定が組み立てるメッセージ・エラーがすべて英語のカタログで翻訳できるか
+//
+// メッセージを変更した場合は messages_en.go のテンプレートも合わせて変更する
+func TestEnglishCatalogCoverage(t *testing.T) {
+	for _, dir := range []string{"../checker", "../rules"} {
+		messages := builtinMessages(t, dir)
+		if len(messages) == 0 {
+			t.Fatalf("%s: no messages found", dir)
+		}
+		for pos, msg := range messages {
+			if got := englishCatalog.translate(msg); hasJapanese(got) {
+				t.Errorf("%s: no English translation for %q (got %q)", pos, msg, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package report
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ========================================
+// エディタ向けの1件1行の出力形式
+// ========================================
+
+// ToLines 違反を1件1行の「ファイル:行:列: 重要度 ルール メッセージ」形式で出力
+// （vimのerrorformat・VSCodeのproblemMatcher等で読み込む。行・列を持たない違反は1行目・1列目とする）
+func (r *Report) ToLines() ([]byte, error) {
+	var b strings.Builder
+	for _, f := range r.violationsByFile() {
+		for _, v := range f.Violations {
+			b.WriteString(f.Path + ":" + strconv.Itoa(max(v.Line, 1)) + ":" + strconv.Itoa(max(v.Column, 1)) + ": ")
+			b.WriteString(string(v.Severity) + " " + v.Rule + " " + strings.ReplaceAll(v.Message, "\n", " ") + "\n")
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// KeepFile 指定したファイル（絶対パス）以外の違反を取り除き、取り除いた件数を返す（-stdin）
+func (r *Report) KeepFile(path string) int {
+	kept := r.Violations[:0]
+	excluded := 0
+	for _, v := range r.Violations {
+		if v.File != path {
+			excluded++
+			continue
+		}
+		kept = append(kept, v)
+	}
+	r.Violations = kept
+	return excluded
+}
@@ -0,0 +1,545 @@
+package report
+
+// ========================================
+// 英語のメッセージのカタログ
+// ========================================
+
+// enMessages 組み込みのルールのメッセージ・提案・修正の説明（日本語）と英語のメッセージ
+// {*} は任意の文字列に一致し、英語のメッセージの {1}・{2}… に一致した順に埋め込む（catalog参照）
+var enMessages = map[string]string{
+	// 文の中に埋め込む断片
+	"関数":             "function",
+	"メソッド":           "method",
+	"型":              "type",
+	"変数":             "variable",
+	"定数":             "constant",
+	"関数 '{*}'":       "function '{1}'",
+	"'{*}' への代入":     "assignment to '{1}'",
+	"無名関数":           "anonymous function",
+	"(埋め込み)":         "(embedded)",
+	"文字列連結":          "string concatenation",
+	"値レシーバ":          "value receivers",
+	"ポインタレシーバ":       "pointer receivers",
+	"AWSアクセスキー":      "AWS access key",
+	"GitHubトークン":     "GitHub token",
+	"Google APIキー":   "Google API key",
+	"PEM秘密鍵":         "PEM private key",
+	"Slackトークン":      "Slack token",
+	"Stripeシークレットキー": "Stripe secret key",
+	"{*}（{*}文字）":     "{1} ({2} chars)",
+	"Lambdaハンドラの呼び出しツリー内（{*}）のループ": "a loop in the call tree of Lambda handler ({1})",
+	"Lambdaハンドラの呼び出しツリー内（{*}）":     "the call tree of Lambda handler ({1})",
+
+	// 命名規則
+	"公開関数 '{*}' はPascalCaseで命名してください":         "exported function '{1}' should be named in PascalCase",
+	"エラー変数 '{*}' はErrプレフィックスで命名してください":        "error variable '{1}' should be named with the Err prefix",
+	"インタフェース '{*}' は標準的なサフィックス({*})を使用してください": "interface '{1}' should use a standard suffix ({2})",
+	"'{*}' の略語（{*}）は大文字で記述してください":             "acronyms ({2}) in '{1}' should be upper case",
+	"'{*}' に名前を変更してください":                      "rename to '{1}'",
+	"'{*}{*}' に名前を変更してください":                   "rename to '{1}{2}'",
+	"{*}に名前を変更":                                   "rename to {1}",
+	"ファイル名を{*}に変更":                                "rename file to {1}",
+	"メソッド '{*}' の{*}":                             "method '{1}': {2}",
+	"レシーバ名に '{*}' は使用しないでください":                    "do not use '{1}' as a receiver name",
+	"レシーバ名 '{*}' が長すぎます（上限: {*}文字）":               "receiver name '{1}' is too long (max: {2} chars)",
+	"レシーバ名 '{*}' が型 '{*}' の他のメソッド（'{*}'）と異なります":   "receiver name '{1}' differs from other methods of type '{2}' ('{3}')",
+	"レシーバ名を '{*}' にしてください":                        "use '{1}' as the receiver name",
+	"メソッド '{*}' は{*}です（型 '{*}' の他のメソッドと統一してください）": "method '{1}' uses {2} (be consistent with other methods of type '{3}')",
+	"レシーバの型を '{*}' にしてください":                       "change the receiver type to '{1}'",
+	"ポインタレシーバを使用してください":                           "use a pointer receiver",
+
+	// ドキュメント・コメント
+	"公開された{*} '{*}' にドキュメントコメントがありません":                     "exported {1} '{2}' has no doc comment",
+	"{*} '{*}' のドキュメントコメントが名前で始まっていません":                    "doc comment of {1} '{2}' does not start with its name",
+	"// {*} ... の形式でドキュメントコメントを追加してください":                   "add a doc comment in the form // {1} ...",
+	"コメントを '{*} ...' で始めてください":                             "start the comment with '{1} ...'",
+	"パッケージ '{*}' にパッケージコメントがありません":                         "package '{1}' has no package comment",
+	"パッケージ '{*}' のパッケージコメントが 'Package {*}' で始まっていません":      "package comment of package '{1}' does not start with 'Package {2}'",
+	"パッケージ '{*}' のパッケージコメントが doc.go にありません":                "package comment of package '{1}' is not in doc.go",
+	"パッケージコメントを doc.go に移動してください":                          "move the package comment to doc.go",
+	"// Package {*} ... の形式で記述してください":                      "write it in the form // Package {1} ...",
+	"doc.go を作成し、// Package {*} ... の形式でパッケージの概要を記述してください": "create doc.go and describe the package in the form // Package {1} ...",
+	"{*}: {*}":              "{1}: {2}",
+	"担当者がありません":             "no assignee",
+	"チケット番号がありません":          "no ticket number",
+	"チケット番号 '{*}' の形式が不正です": "ticket number '{1}' has an invalid format",
+	"{*}(担当者): JIRA-123 本文 の形式で記載してください": "write it in the form {1}(assignee): JIRA-123 description",
+	"抑制コメントに一致する違反がありません（{*}）":           "no violation matches the suppression comment ({1})",
+	"不要になった抑制コメントを削除してください":              "remove the unused suppression comment",
+
+	// エラーハンドリング
+	"{*} の戻り値のエラーを確認してください（意図的に無視する場合は //standards:ignore no_ignored_errors で理由を明記）": "check the error returned by {1} (to ignore it intentionally, state the reason with //standards:ignore no_ignored_errors)",
+	"エラーを適切にハンドリングしてください":                                   "handle the error properly",
+	"エラーを返却してください":                                          "return an error",
+	"fmt.Errorfでエラーを%v・%sで埋め込んでいるため、元のエラーをラップしていません":        "fmt.Errorf embeds the error with %v/%s and does not wrap the original error",
+	"errors.New({*}.Error())はエラーを作り直しているため、元のエラーをラップしていません": "errors.New({1}.Error()) recreates the error and does not wrap the original error",
+	"%wでラップ": "wrap with %w",
+	"エラーをラップする際は操作の説明を付与してください（例: \"ユーザー取得: %w\"）":                           "add a description of the operation when wrapping errors (e.g. \"get user: %w\")",
+	"ラップメッセージ '{*}' は汎用的すぎます。失敗した操作を説明してください":                                "wrap message '{1}' is too generic; describe the operation that failed",
+	"ラップメッセージ '{*}' が呼び出し先の関数名の繰り返しになっています。操作の説明を付与してください":                   "wrap message '{1}' just repeats the callee's name; describe the operation",
+	"fmt.Errorf(\"failed to load user %s: %w\", id, err) のように操作と対象を記述してください": "describe the operation and its target, e.g. fmt.Errorf(\"failed to load user %s: %w\", id, err)",
+	"書式指定子のないfmt.Errorfはerrors.Newを使用してください":                                 "use errors.New for fmt.Errorf without format verbs",
+	"errors.New(fmt.Sprintf(...))はfmt.Errorfを使用してください":                       "use fmt.Errorf instead of errors.New(fmt.Sprintf(...))",
+	"errors.Newに置換": "replace with errors.New",
+	"fmt.Errorfに置換": "replace with fmt.Errorf",
+
+	// 関数・ファイルの構造
+	"関数 '{*}' は{*}行あります（上限: {*}行）":                                    "function '{1}' has {2} lines (max: {3})",
+	"関数 '{*}' のネストレベルは{*}です（上限: {*}）":                                 "function '{1}' has nesting level {2} (max: {3})",
+	"関数 '{*}' のパラメータ数は{*}個です（上限: {*}個）":                               "function '{1}' has {2} parameters (max: {3})",
+	"関数 '{*}' の戻り値数は{*}個です（上限: {*}個）":                                 "function '{1}' has {2} return values (max: {3})",
+	"関数 '{*}' の循環的複雑度は{*}です（上限: {*}）":                                 "function '{1}' has cyclomatic complexity {2} (max: {3})",
+	"ファイルは{*}行あります（上限: {*}行）":                                         "file has {1} lines (max: {2})",
+	"関数を分割してください":                                                     "split the function",
+	"責務ごとにファイルを分割してください":                                              "split the file by responsibility",
+	"早期リターンを使用してネストを浅くしてください":                                         "use early returns to reduce nesting",
+	"パラメータを構造体にまとめることを検討してください":                                       "consider grouping the parameters into a struct",
+	"戻り値を構造体にまとめることを検討してください":                                         "consider grouping the return values into a struct",
+	"条件分岐を小さな関数に分割するか、テーブル駆動・早期リターンで分岐を減らしてください":                      "split the branches into smaller functions, or reduce them with table-driven code or early returns",
+	"必須ディレクトリ '{*}' が見つかりません":                                         "required directory '{1}' not found",
+	"推奨ディレクトリ '{*}' が見つかりません":                                         "recommended directory '{1}' not found",
+	"{*} を作成してください":                                                   "create {1}",
+	"init関数は暗黙に実行されるため使用しないでください":                                     "do not use init functions because they run implicitly",
+	"初期化処理を関数にして、mainや呼び出し元から明示的に呼び出してください":                           "move the initialization into a function and call it explicitly from main or the caller",
+	"パッケージレベルの変数 '{*}' は変更可能なグローバル状態です":                               "package-level variable '{1}' is mutable global state",
+	"定数にするか、構造体のフィールドとして依存を注入してください":                                  "make it a constant, or inject the dependency as a struct field",
+	"マジックナンバー {*} を直接使用しています":                                         "magic number {1} is used directly",
+	"意味の分かる名前の定数（const）にしてください":                                       "use a named constant (const) that explains its meaning",
+	"名前を付けた定数にするか、設定（環境変数・設定ファイル）から取得してください":                          "use a named constant, or read it from configuration (environment variables or config files)",
+	"URL '{*}' がハードコードされています":                                         "URL '{1}' is hardcoded",
+	"{*}()を直接呼び出しています":                                                "{1}() is called directly",
+	"Now() time.Time を持つ時計のインタフェースを構造体に注入し、テストでは固定の時刻を返す実装に差し替えてください": "inject a clock interface with Now() time.Time into the struct and replace it with a fixed-time implementation in tests",
+	".protoを変更してprotocで再生成してください（生成コードは直接編集しない）":                      "change the .proto and regenerate with protoc (do not edit generated code directly)",
+	"{*} に生成マーカー（// Code generated ... DO NOT EDIT.）がありません":           "{1} has no generated-code marker (// Code generated ... DO NOT EDIT.)",
+	"{*} は生成後に編集されています（{*} のハッシュと一致しません）":                             "{1} was edited after generation (does not match the hash in {2})",
+
+	// アーキテクチャ・プロジェクト固有ルール
+	"レイヤー '{*}' から '{*}' をimportしています（can_import: {*}）":    "layer '{1}' imports '{2}' (can_import: {3})",
+	"レイヤー '{*}' から '{*}' をimportしています（cannot_import）":      "layer '{1}' imports '{2}' (cannot_import)",
+	"'{*}' への依存はインタフェースを '{*}' 側に定義して注入する等、依存方向を逆転させてください": "invert the dependency on '{1}', e.g. by defining an interface on the '{2}' side and injecting it",
+	"'{*}' はimportできません":                  "'{1}' must not be imported",
+	"'{*}' は {*} でのみimportできます":           "'{1}' may only be imported in {2}",
+	"'{*}' のimportを削除し、代替のパッケージを使用してください": "remove the import of '{1}' and use an alternative package",
+	"'{*}' の使用は禁止されています":                  "use of '{1}' is forbidden",
+	"'{*}' の呼び出しは禁止されています":                "calling '{1}' is forbidden",
+	"'{*}' を使用しない実装に変更してください":             "change the implementation not to use '{1}'",
+	"'{*}' を呼び出さない実装に変更してください":            "change the implementation not to call '{1}'",
+	"関数 '{*}' で {*} のいずれも呼び出していません":       "function '{1}' calls none of {2}",
+	"'{*}' の中で {*} のいずれかを呼び出してください":       "call one of {2} in '{1}'",
+
+	// インタフェース
+	"インタフェース '{*}' のメソッドが{*}個あります（上限: {*}）":         "interface '{1}' has {2} methods (max: {3})",
+	"インタフェース '{*}' の実装は同じパッケージの '{*}' のみです":         "the only implementation of interface '{1}' is '{2}' in the same package",
+	"インタフェース '{*}' のメソッド '{*}' の引数に名前がありません":        "parameters of method '{2}' in interface '{1}' have no names",
+	"利用者ごとに必要なメソッドのみを持つ小さなインタフェースに分割してください":         "split it into small interfaces with only the methods each consumer needs",
+	"インタフェースは利用する側のパッケージで宣言するか、型 '{*}' を直接使用してください": "declare the interface in the consuming package, or use type '{1}' directly",
+	"引数の役割が分かる名前を付けてください":                           "name the parameters after their roles",
+
+	// 構造体タグ
+	"JSONタグ '{*}' は{*}で命名してください":       "JSON tag '{1}' should be named in {2}",
+	"{*}タグ '{*}' は{*}で命名してください":        "{1} tag '{2}' should be named in {3}",
+	"jsonタグの名前を{*}に変更":                 "rename the json tag to {1}",
+	"'{*}' にしてください":                    "change it to '{1}'",
+	"'{*}' の誤りではありませんか":                "did you mean '{1}'?",
+	"'{*}' のフィールド '{*}' にjsonタグがありません": "field '{2}' of '{1}' has no json tag",
+	"'{*}' のフィールド '{*}' に{*}":          "field '{2}' of '{1}' has {3}",
+	"{*}タグがありません":                      "no {1} tag",
+	"{*}のいずれのタグもありません":                 "none of the {1} tags",
+	"{*} を付与してください":                    "add {1}",
+	"jsonタグを追加":                        "add a json tag",
+	"jsonタグの値をクォート":                    "quote the json tag value",
+	"json:\"{*}\" を付与するか、シリアライズしない場合は json:\"-\" を付与してください":   "add json:\"{1}\", or json:\"-\" if the field is not serialized",
+	"'{*}' の省略可能なフィールド '{*}' のjsonタグにomitemptyがありません":         "json tag of optional field '{2}' of '{1}' has no omitempty",
+	"json:\"{*},omitempty\" にしてください":                          "change it to json:\"{1},omitempty\"",
+	"jsonタグにomitemptyを追加":                                     "add omitempty to the json tag",
+	"設定構造体 '{*}' のフィールド '{*}' に{*}のいずれのタグもありません":              "field '{2}' of config struct '{1}' has none of the {3} tags",
+	"{*}:\"{*}\" を付与してください":                                   "add {1}:\"{2}\"",
+	"フィールド '{*}' の{*}タグ '{*}' がフィールド名と一致しません（期待値: '{*}'）":     "{2} tag '{3}' of field '{1}' does not match the field name (expected: '{4}')",
+	"フィールド '{*}' の{*}タグ '{*}' はフィールド名を小文字にしただけです（期待値: '{*}'）": "{2} tag '{3}' of field '{1}' is just the lowercased field name (expected: '{4}')",
+	"{*}:\"{*}\" にするか、意図した名前であればallowedに追加してください":             "change it to {1}:\"{2}\", or add it to allowed if the name is intended",
+	"フィールド '{*}' の{*}タグ '{*}' はフィールド '{*}' と重複しています":          "{2} tag '{3}' of field '{1}' duplicates field '{4}'",
+	"どちらかのフィールドのタグを別の名前にしてください":                               "rename the tag of one of the fields",
+	"タグの書式: {*}":                         "tag format: {1}",
+	"バッククォートで囲まれていません":                   "not enclosed in backquotes",
+	"キーの順序が {*} と異なります":                  "key order differs from {1}",
+	"key:\"value\" の区切りが半角スペース1つではありません": "key:\"value\" pairs are not separated by a single space",
+	"key:\"value\" の形式ではありません":           "not in key:\"value\" form",
+	"バッククォートで囲み、半角スペース1つで区切った key:\"value\" をキーの順序どおりに並べてください": "enclose in backquotes and list key:\"value\" pairs separated by a single space in key order",
+	"タグを整形": "format the tag",
+	"validateタグの '{*}' は不明なバリデーターです":                                          "'{1}' in the validate tag is an unknown validator",
+	"validateタグに空のバリデーターがあります（連続した , や | 、末尾の , ）":                            "the validate tag has an empty validator (consecutive , or |, or a trailing ,)",
+	"バリデーター '{*}' には引数が必要です":                                                  "validator '{1}' requires an argument",
+	"バリデーター '{*}' は引数を取りません":                                                  "validator '{1}' takes no argument",
+	"バリデーター '{*}' の引数 '{*}' が数値ではありません":                                       "argument '{2}' of validator '{1}' is not a number",
+	"'{*}=10' のように数値（または時間）を指定してください":                                         "specify a number (or duration) like '{1}=10'",
+	"'{*}=<値>' の形式で指定してください":                                                  "specify it in the form '{1}=<value>'",
+	"余分な区切り文字を削除してください":                                                       "remove the extra separators",
+	"go-playground/validatorのバリデーター名を確認するか、独自のバリデーターであればvalidatorsに追加してください":  "check the go-playground/validator validator name, or add it to validators if it is a custom validator",
+	"'{*}'（{*}）をデコードしていますが、バリデーションを呼び出していません":                                 "'{1}' ({2}) is decoded but never validated",
+	"デコード後、使用する前に validate.Struct({*}) や {*}.Validate() でvalidateタグを検証してください": "after decoding, validate the validate tags with validate.Struct({1}) or {2}.Validate() before use",
+
+	// パフォーマンス
+	"整数の文字列変換にfmt.Sprintfは不要です":                                       "fmt.Sprintf is unnecessary for converting an integer to a string",
+	"文字列の連結にfmt.Sprintfは不要です":                                         "fmt.Sprintf is unnecessary for concatenating strings",
+	"strconv.Itoa / strconv.FormatInt / strconv.FormatUint を使用してください": "use strconv.Itoa, strconv.FormatInt or strconv.FormatUint",
+	"{*} のように連結してください":                                                "concatenate like {1}",
+	"strconvに置換": "replace with strconv",
+	"{*}に置き換え":   "replace with {1}",
+
+	// セキュリティ
+	"'{*}' に認証情報らしき文字列がハードコードされています":      "a credential-like string is hardcoded in '{1}'",
+	"{*}がハードコードされています":                    "{1} is hardcoded",
+	"高エントロピーな文字列（{*}ビット/文字）がハードコードされています": "a high-entropy string ({1} bits/char) is hardcoded",
+	"{*}（値: {*}）": "{1} (value: {2})",
+	"環境変数やシークレットマネージャーから取得してください":                                                                                "read it from environment variables or a secret manager",
+	"（誤検出の場合は {*} に sha256:{*} を追加してください）":                                                                       " (if this is a false positive, add sha256:{2} to {1})",
+	"環境変数やシークレットマネージャーから取得してください（誤検出の場合は {*} に sha256:{*} を追加してください）":                                            "read it from environment variables or a secret manager (if this is a false positive, add sha256:{2} to {1})",
+	"{*}に渡すクエリが{*}で組み立てられています":                                                                                   "the query passed to {1} is built with {2}",
+	"プレースホルダ（? / $1）とパラメータ、またはプリペアドステートメントを使用してください":                                                             "use placeholders (? / $1) with parameters, or prepared statements",
+	"{*}でシェル経由（-c）に動的なコマンド文字列を渡しています":                                                                            "{1} passes a dynamic command string through a shell (-c)",
+	"{*}の引数にユーザー入力（{*}）を連結した文字列を渡しています":                                                                          "{1} is passed a string concatenated with user input ({2})",
+	"シェルを介さずにプログラムと引数を個別に渡してください（exec.Command(\"prog\", arg1, arg2)）":                                            "pass the program and arguments separately without a shell (exec.Command(\"prog\", arg1, arg2))",
+	"入力値は独立した引数として渡し、許可リストで検証してください":                                                                             "pass input values as separate arguments and validate them against an allowlist",
+	"{*}:{*}: sha256: または regex: で始めてください":                                                                       "{1}:{2}: entries must start with sha256: or regex:",
+	"{*}でmath/randを使用しています（予測可能な乱数）":                                                                             "{1} uses math/rand (predictable random numbers)",
+	"秘密情報・トークンの生成にはcrypto/rand（rand.Read, rand.Text）を使用してください":                                                   "use crypto/rand (rand.Read, rand.Text) to generate secrets and tokens",
+	"InsecureSkipVerify: true により証明書の検証が無効化されています":                                                               "certificate verification is disabled by InsecureSkipVerify: true",
+	"証明書の検証は無効化せず、必要であればRootCAsに社内CAを追加してください":                                                                   "do not disable certificate verification; add your internal CA to RootCAs if needed",
+	"MinVersionに{*}が指定されています":                                                                                    "MinVersion is set to {1}",
+	"MinVersionはtls.{*}以上を指定してください":                                                                              "set MinVersion to tls.{1} or higher",
+	"外部への接続先に平文のURL（{*}）が指定されています":                                                                               "a plaintext URL ({1}) is used for an external connection",
+	"https:// を使用してください":                                                                                         "use https://",
+	"{*}のパーミッション0{*}は上限0{*}を超えています":                                                                              "permission 0{2} of {1} exceeds the limit 0{3}",
+	"os.Chmodでパーミッションを0{*}に緩めています（上限0{*}）":                                                                       "os.Chmod relaxes the permission to 0{1} (limit 0{2})",
+	"必要最小限のパーミッション（{*}等）を指定してください":                                                                               "specify the minimum required permission (e.g. {1})",
+	"パーミッションを{*}に変更":                                                                                             "change the permission to {1}",
+	"http.DefaultClientを使用しています":                                                                                 "http.DefaultClient is used",
+	"http.DefaultTransportを共有しています":                                                                              "http.DefaultTransport is shared",
+	"{*}.{*}はタイムアウトの無いhttp.DefaultClientを使用します":                                                                  "{1}.{2} uses http.DefaultClient, which has no timeout",
+	"{*}{}に{*}が設定されていません":                                                                                        "{2} is not set in {1}{}",
+	"{*}{{*}: 30 * time.Second, ...} のようにタイムアウトを設定してください":                                                        "set a timeout like {1}{{2}: 30 * time.Second, ...}",
+	"Timeoutと専用のTransport（http.DefaultTransport.(*http.Transport).Clone()等）を設定した *http.Client を明示的に生成して使用してください": "explicitly create and use an *http.Client with a Timeout and its own Transport (e.g. http.DefaultTransport.(*http.Transport).Clone())",
+	"機密情報の可能性がある '{*}' をログに出力しています":                                                                              "possibly sensitive '{1}' is written to the log",
+	"値をマスクするか、ログ出力から除外してください":                                                                                    "mask the value or exclude it from the log output",
+
+	// ロギング
+	"標準logパッケージの log.{*} は使用しないでください":                       "do not use log.{1} from the standard log package",
+	"構造化ログライブラリ（zerolog等）を使用してください":                         "use a structured logging library (e.g. zerolog)",
+	"構造化ログライブラリ（zerolog・zap・slog等）を使用してください":                "use a structured logging library (e.g. zerolog, zap, slog)",
+	"{*}()はmainパッケージ・テスト以外では使用しないでください":                     "do not use {1}() outside the main package and tests",
+	"デバッグ出力を削除するか、構造化ログライブラリを使用してください":                      "remove the debug output, or use a structured logging library",
+	"組み込み関数{*}はfmtもロガーも経由しないため使用しないでください":                   "do not use the builtin {1}, which bypasses both fmt and loggers",
+	"{*}への直接書き込みはmain/cmdパッケージ以外では使用しないでください":               "do not write directly to {1} outside main/cmd packages",
+	"ロガーを使用するか、io.Writerを引数で受け取ってください":                      "use a logger, or accept an io.Writer as a parameter",
+	"ロギングライブラリ '{*}'（{*}）は承認されていません":                        "logging library '{1}' ({2}) is not approved",
+	"{*} のいずれかを使用してください":                                    "use one of {1}",
+	"ロギングライブラリが混在しています（{*}）。標準は{*}です":                       "logging libraries are mixed ({1}); the standard is {2}",
+	"{*} を使用してください":                                         "use {1}",
+	"ctxに紐付いたロガーを取得して使用してください":                              "use the logger bound to ctx",
+	"{*}に統一してください":                                          "use {1} consistently",
+	"ログフィールドキー '{*}' は{*}で命名してください":                         "log field key '{1}' should be named in {2}",
+	"ログフィールドキー '{*}' が同じログ呼び出し内で重複しています":                    "log field key '{1}' is duplicated in the same log call",
+	"contextを受け取る関数 '{*}' では{*}のcontext対応ロガーを使用してください（{*}）": "use the context-aware logger of {2} in function '{1}', which receives a context ({3})",
+
+	// context
+	"公開{*} '{*}' がcontext.Contextを受け取っていません":                                                               "exported {1} '{2}' does not accept a context.Context",
+	"関数 '{*}' のcontext.Contextが第{*}引数になっています":                                                              "context.Context is parameter #{2} of function '{1}'",
+	"関数 '{*}' のcontext.Context引数名が '{*}' です":                                                               "context.Context parameter of function '{1}' is named '{2}'",
+	"context.Contextは第1引数で受け取ってください":                                                                       "accept context.Context as the first parameter",
+	"context.Contextの引数名はctxにしてください":                                                                       "name the context.Context parameter ctx",
+	"呼び出し元からcontext.Contextを受け取ってください":                                                                     "accept a context.Context from the caller",
+	"第1引数で ctx context.Context を受け取り、下位の呼び出しへ伝播してください":                                                     "accept ctx context.Context as the first parameter and propagate it to downstream calls",
+	"構造体 '{*}' のフィールド {*} にcontext.Contextを保持しています":                                                        "struct '{1}' holds a context.Context in field {2}",
+	"contextは構造体に保持せず、各メソッドの第1引数で受け取ってください":                                                                "do not store contexts in structs; accept them as the first parameter of each method",
+	"context.WithValueのキーに{*}型が使われています":                                                                    "a {1} type is used as a context.WithValue key",
+	"type ctxKey struct{} のような非公開のキー型を定義して使用してください":                                                        "define and use an unexported key type such as type ctxKey struct{}",
+	"{*}が利用可能な関数内で{*}()を使用しています":                                                                           "{2}() is used in a function where {1} is available",
+	"{*}を伝播してください（キャンセルを切り離す場合はcontext.WithoutCancel({*})）":                                                "propagate {1} (use context.WithoutCancel({2}) to detach cancellation)",
+	"受け取ったctxを渡し、デッドライン・キャンセル・メタデータを伝播させてください":                                                             "pass the received ctx to propagate deadlines, cancellation and metadata",
+	"http.NewRequestWithContext(ctx, ...) を使用してください":                                                       "use http.NewRequestWithContext(ctx, ...)",
+	"ハンドラ '{*}' がタイムアウトを設定せずに外部呼び出し（{*}）をしています":                                                            "handler '{1}' makes an external call ({2}) without setting a timeout",
+	"ctx, cancel := context.WithTimeout(r.Context(), ...) と defer cancel() でタイムアウトを設定し、ctxを外部呼び出しに渡してください": "set a timeout with ctx, cancel := context.WithTimeout(r.Context(), ...) and defer cancel(), and pass ctx to the external call",
+	"ハンドラ '{*}' のエラー分岐がエラーレスポンスを返さずにreturnしています":                                                           "an error branch of handler '{1}' returns without writing an error response",
+	"http.Error({*}, ...) または {*}.WriteHeader(<4xx/5xx>) でエラーを返却してください":                                    "return an error with http.Error({1}, ...) or {2}.WriteHeader(<4xx/5xx>)",
+
+	// 並行処理
+	"goroutine（{*}）の終了を管理する仕組みがありません":                                                  "nothing manages the termination of the goroutine ({1})",
+	"ctx.Done()をselectで監視するか、errgroup/sync.WaitGroupで終了を待機してください":                      "watch ctx.Done() in a select, or wait for completion with errgroup/sync.WaitGroup",
+	"goroutineから共有map '{*}' に同期なしで書き込んでいます":                                            "goroutine writes to shared map '{1}' without synchronization",
+	"sync.Mutex/RWMutexで保護するか、sync.Mapを使用してください":                                       "protect it with sync.Mutex/RWMutex, or use sync.Map",
+	"goroutineが非バッファチャネル '{*}' に送信しますが、起動側で受信していません":                                   "goroutine sends to unbuffered channel '{1}' but the launching side never receives",
+	"起動側で受信するか、バッファ付きチャネルを使用してください":                                                    "receive on the launching side, or use a buffered channel",
+	"ループ内で並行数の上限なくgoroutineを起動しています":                                                   "goroutines are started in a loop without a concurrency limit",
+	"errgroup.SetLimit、セマフォ（バッファ付きチャネル）、ワーカープールで並行数を制限してください":                          "limit concurrency with errgroup.SetLimit, a semaphore (buffered channel), or a worker pool",
+	"受信のみを行う関数 '{*}' でチャネル '{*}' をcloseしています":                                          "function '{1}' only receives from channel '{2}' but closes it",
+	"チャネルは送信側（所有者）がcloseしてください":                                                        "the sender (owner) should close the channel",
+	"引数 '{*}' は受信のみに使われています":                                                           "parameter '{1}' is only used for receiving",
+	"引数 '{*}' は送信のみに使われています":                                                           "parameter '{1}' is only used for sending",
+	"受信専用チャネル（<-chan）として宣言してください":                                                      "declare it as a receive-only channel (<-chan)",
+	"送信専用チャネル（chan<-）として宣言してください":                                                      "declare it as a send-only channel (chan<-)",
+	"関数 '{*}' の引数がロック（{*}）を値で受け取っています":                                                 "a parameter of function '{1}' receives a lock ({2}) by value",
+	"関数 '{*}' がロック（{*}）を含む値を返しています":                                                    "function '{1}' returns a value containing a lock ({2})",
+	"代入によりロック（{*}）がコピーされています":                                                          "the assignment copies a lock ({1})",
+	"引数としてロック（{*}）を含む値がコピーされています":                                                      "a value containing a lock ({1}) is copied as an argument",
+	"メソッド '{*}' の値レシーバがロック（{*}）をコピーしています":                                              "the value receiver of method '{1}' copies a lock ({2})",
+	"ポインタで受け渡してください":                                                                   "pass it by pointer",
+	"ポインタを返してください":                                                                     "return a pointer",
+	"ポインタを代入してください":                                                                    "assign a pointer",
+	"ループ内でtime.Tickを呼び出しています（反復ごとに停止できないTickerが作られます）":                                 "time.Tick is called in a loop (each iteration creates a Ticker that cannot be stopped)",
+	"ループの外でtime.NewTickerを作成し、defer ticker.Stop()で停止してください":                            "create a time.NewTicker outside the loop and stop it with defer ticker.Stop()",
+	"selectでのチャネル '{*}' への送信にdefault・タイムアウト・キャンセルのケースがありません":                           "the send to channel '{1}' in select has no default, timeout or cancellation case",
+	"case <-ctx.Done(): または case <-time.After(d): を追加するか、defaultで送信できない場合を処理してください":    "add case <-ctx.Done(): or case <-time.After(d):, or handle the case where the send cannot proceed with default",
+	"リトライループで固定間隔のtime.Sleepを使用しています":                                                  "a retry loop uses time.Sleep with a fixed interval",
+	"指数バックオフを用い、select { case <-ctx.Done(): case <-time.After(d): } でキャンセル可能に待機してください": "use exponential backoff and wait cancellably with select { case <-ctx.Done(): case <-time.After(d): }",
+	"{*}.{*}() ループの後で {*}.Err() を確認していません":                                             "{3}.Err() is not checked after the {1}.{2}() loop",
+	"if err := {*}.Err(); err != nil { ... } をループの後に追加してください":                          "add if err := {1}.Err(); err != nil { ... } after the loop",
+
+	// テスト
+	"{*} に対応するテストファイルがありません":                                                                       "{1} has no corresponding test file",
+	"パッケージ '{*}' にテストファイル（*_test.go）がありません":                                                        "package '{1}' has no test files (*_test.go)",
+	"パッケージ '{*}' のテストの比率が{*}です（{*}、下限: {*}）":                                                       "test ratio of package '{1}' is {2} ({3}, min: {4})",
+	"本番コード: {*}行、テストコード: {*}行":                                                                     "production code: {1} lines, test code: {2} lines",
+	"本番コード: {*}行、Test関数: {*}個":                                                                     "production code: {1} lines, Test functions: {2}",
+	"{*} にテストを追加してください":                                                                            "add tests to {1}",
+	"テスト '{*}' で {*} の呼び出しが{*}回繰り返されています（上限: {*}）":                                                 "test '{1}' repeats calls to {2} {3} times (max: {4})",
+	"入力と期待値を []struct{...} のテストケースにまとめ、for _, tt := range tests { t.Run(tt.name, ...) } で実行してください": "group inputs and expected values into []struct{...} test cases and run them with for _, tt := range tests { t.Run(tt.name, ...) }",
+	"テスト '{*}' にはアサーションが{*}個あります（上限: {*}）":                                                         "test '{1}' has {2} assertions (max: {3})",
+	"テスト '{*}' で{*}.Parallel()を呼び出していません":                                                          "test '{1}' does not call {2}.Parallel()",
+	"テスト関数の先頭で {*}.Parallel() を呼び出してください":                                                          "call {1}.Parallel() at the beginning of the test function",
+	"サブテストで{*}.Parallel()を呼び出していません":                                                               "subtest does not call {1}.Parallel()",
+	"サブテストの関数の先頭で {*}.Parallel() を呼び出してください":                                                       "call {1}.Parallel() at the beginning of the subtest function",
+	"テストヘルパー '{*}' は{*}個の関数から呼び出されていますが {*}.Helper() を呼び出していません":                                   "test helper '{1}' is called from {2} functions but does not call {3}.Helper()",
+	"関数の先頭で {*}.Helper() を呼び出し、失敗時に呼び出し元の行が報告されるようにしてください":                                         "call {1}.Helper() at the beginning of the function so failures report the caller's line",
+	"{*}.Helper()を追加": "add {1}.Helper()",
+	"関数 '{*}' は{*}の後が小文字のため、go testで実行されません":                "function '{1}' is not run by go test because the character after {2} is lower case",
+	"テスト関数 '{*}' の名前がパターン {*} に一致しません":                      "test function name '{1}' does not match pattern {2}",
+	"テスト関数の命名規則に従った名前に変更してください":                             "rename it according to the test function naming convention",
+	"テストは内部テストパッケージ（package {*}）で記述してください":                  "write tests in the internal test package (package {1})",
+	"公開APIのみを使用するテストは外部テストパッケージ（package {*}_test）で記述してください": "write tests that only use the public API in the external test package (package {1}_test)",
+	"package {*} に変更し、{*}. の修飾を外してください":                     "change to package {1} and remove the {2}. qualifiers",
+	"package {*}_test に変更し、{*} をimportしてください":               "change to package {1}_test and import {2}",
+
+	// AWS Lambda
+	"Lambdaハンドラ '{*}' がcontext.Contextを受け取っていません":                                                                    "Lambda handler '{1}' does not accept a context.Context",
+	"Lambdaハンドラ '{*}' が受け取ったcontext.Context '{*}' を使用していません":                                                         "Lambda handler '{1}' does not use the received context.Context '{2}'",
+	"Lambdaハンドラ '{*}' が受け取ったcontext.Contextを破棄しています":                                                                 "Lambda handler '{1}' discards the received context.Context",
+	"ハンドラの第1引数で ctx context.Context を受け取り、AWS SDK・HTTP等の呼び出しに渡してください":                                                "accept ctx context.Context as the handler's first parameter and pass it to AWS SDK, HTTP and other calls",
+	"ctxを引数名で受け取り、リポジトリ・クライアント等の呼び出しに渡してください":                                                                        "name the ctx parameter and pass it to repository, client and other calls",
+	"ハンドラが受け取ったctxを引き回し、タイムアウトとX-Rayトレースを伝播させてください":                                                                  "thread the handler's ctx through to propagate timeouts and X-Ray traces",
+	"Lambdaハンドラ '{*}' 内で {*} を呼び出しています（呼び出しごとに初期化されます）":                                                              "Lambda handler '{1}' calls {2} (initialized on every invocation)",
+	"AWSクライアントはinit()・main()またはパッケージ変数で一度だけ初期化してください":                                                                "initialize AWS clients only once in init(), main() or a package variable",
+	"Lambdaハンドラの呼び出しツリー内（{*}）でcontextを受け取らない{*}を使用しています":                                                             "the call tree of Lambda handler ({1}) uses {2}, which does not accept a context",
+	"Lambdaハンドラの呼び出しツリー内（{*}）で{*}()を使用しています":                                                                         "the call tree of Lambda handler ({1}) uses {2}()",
+	"{*}で{*}を呼び出しています（呼び出しごとに読み出されます）":                                                                               "{2} is called in {1} (read on every invocation)",
+	"環境変数はinit()・main()で一度だけ読み出して設定構造体に格納してください":                                                                     "read environment variables only once in init() or main() and store them in a config struct",
+	"起動時に読み出した環境変数（{*}）が未設定かどうか検証されていません":                                                                            "environment variables read at startup ({1}) are not checked for being unset",
+	"init()・main()で if {*} == \"\" { ... } のように検証し、未設定であれば起動時に失敗させてください":                                             "validate them in init() or main() like if {1} == \"\" { ... } and fail at startup if unset",
+	"SQSハンドラ '{*}' が events.SQSEventResponse を返していません":                                                               "SQS handler '{1}' does not return events.SQSEventResponse",
+	"SQSハンドラ '{*}' がBatchItemFailuresに失敗したレコードを追加していません":                                                             "SQS handler '{1}' does not add failed records to BatchItemFailures",
+	"戻り値を (events.SQSEventResponse, error) とし、失敗したレコードをBatchItemFailuresに追加してください（ReportBatchItemFailuresの有効化も必要です）": "return (events.SQSEventResponse, error) and add failed records to BatchItemFailures (ReportBatchItemFailures must also be enabled)",
+	"レコードごとのエラー時に events.SQSBatchItemFailure{ItemIdentifier: record.MessageId} を追加してください":                            "on a per-record error, add events.SQSBatchItemFailure{ItemIdentifier: record.MessageId}",
+
+	// gRPC
+	"gRPCメソッド '{*}' がコードを持たないエラー（{*}）を返しています":                      "gRPC method '{1}' returns an error without a code ({2})",
+	"status.Error(codes.XXX, ...) / status.Errorf でコードを付けて返してください": "return it with a code using status.Error(codes.XXX, ...) / status.Errorf",
+	"gRPCメソッド '{*}' が受け取ったctxを下流の呼び出しに渡していません":                     "gRPC method '{1}' does not pass the received ctx to downstream calls",
+	"gRPCメソッド '{*}' でpanicを呼び出しています":                               "gRPC method '{1}' calls panic",
+	"status.Error(codes.Internal, ...) を返してください":                   "return status.Error(codes.Internal, ...)",
+	"gRPCメソッド '{*}' で{*}()を使用しています":                                "gRPC method '{1}' uses {2}()",
+	"pb_edited: {*} を読み込めません: {*}":                                 "pb_edited: cannot read {1}: {2}",
+
+	// 型情報付き解析（-typed）
+	"typed: {*} のパッケージを読み込めないため、依存をソースからimportして型チェックします: {*}":           "typed: cannot load the packages in {1}, type-checking with dependencies imported from source: {2}",
+	"typed: {*} の型チェックでエラーがありました（型が得られない式は型情報を使うルールの対象外になります）: {*}":      "typed: type-checking {1} reported an error (expressions without types are skipped by type-aware rules): {2}",
+	"typed: {*} の型チェックで{*}件のエラーがありました（型が得られない式は型情報を使うルールの対象外になります）: {*}": "typed: type-checking {1} reported {2} errors (expressions without types are skipped by type-aware rules): {3}",
+
+	// 設定ファイルの既定のメッセージ
+	"パッケージ名は小文字のみ":                                                    "package names in lower case only",
+	"ファイル名はスネークケース":                                                   "file names in snake_case",
+	"関数は50行以内":                                                        "functions within 50 lines",
+	"ネストは3レベル以内":                                                      "nesting within 3 levels",
+	"循環的複雑度は15以内":                                                     "cyclomatic complexity within 15",
+	"エラーを無視しないでください":                                                  "do not ignore errors",
+	"パッケージ名は小文字のみで構成してください":                                           "package names should consist of lower case letters only",
+	"ファイル名はスネークケース小文字で命名してください":                                       "file names should be lower-case snake_case",
+	"公開シンボルはPascalCaseで命名してください":                                      "exported symbols should be named in PascalCase",
+	"略語は大文字を維持してください":                                                 "keep acronyms upper case",
+	"略語は大文字を維持してください (例: userID, httpClient)":                         "keep acronyms upper case (e.g. userID, httpClient)",
+	"インタフェース名は標準的なサフィックスを使用してください":                                    "interface names should use a standard suffix",
+	"インタフェース名は動詞+er形式または標準的なサフィックスを使用してください":                          "interface names should use the verb+er form or a standard suffix",
+	"センチネルエラーはErrプレフィックスで定義してください":                                    "define sentinel errors with the Err prefix",
+	"レシーバ名は型の略称（1〜2文字）で統一してください":                                      "use a consistent receiver name abbreviating the type (1-2 chars)",
+	"レシーバはポインタ・値のいずれかに統一してください":                                       "use either pointer or value receivers consistently",
+	"関数は{*}行以内を目安にしてください":                                             "keep functions within about {1} lines",
+	"ネストは{*}レベル以内を目安にしてください":                                          "keep nesting within about {1} levels",
+	"関数のパラメータは{*}個以内を目安にしてください":                                       "keep function parameters within about {1}",
+	"関数の戻り値は{*}個以内を目安にしてください":                                         "keep function return values within about {1}",
+	"循環的複雑度は{*}以内を目安にしてください":                                          "keep cyclomatic complexity within about {1}",
+	"ファイルは{*}行以内を目安に分割してください":                                         "split files to keep them within about {1} lines",
+	"インタフェースのメソッドは{*}個以内を目安にしてください":                                   "keep interfaces within about {1} methods",
+	"標準ディレクトリ構成を使用してください":                                             "use the standard directory layout",
+	"レイヤードアーキテクチャに基づくディレクトリ構成を推奨します":                                  "a directory layout based on layered architecture is recommended",
+	"レイヤー間の依存方向を守ってください":                                              "respect the dependency direction between layers",
+	"init関数は使用せず、初期化を明示的に呼び出してください":                                   "do not use init functions; call initialization explicitly",
+	"グローバル変数ではなく依存を注入してください":                                          "inject dependencies instead of using global variables",
+	"数値には意味の分かる名前の定数を使用してください":                                        "use meaningfully named constants for numbers",
+	"URLは定数または設定から取得してください":                                           "take URLs from constants or configuration",
+	"時計のインタフェースを注入してください":                                             "inject a clock interface",
+	"生成されたコードを編集しないでください":                                             "do not edit generated code",
+	"公開シンボルにはドキュメントコメントを記述してください":                                     "write doc comments for exported symbols",
+	"ドキュメントコメントは名前で始めてください":                                           "start doc comments with the name",
+	"パッケージコメントを記述してください":                                              "write a package comment",
+	"TODO/FIXMEには担当者とチケット番号を記載してください":                                 "include an assignee and a ticket number in TODO/FIXME",
+	"TODO/FIXMEには担当者とチケット番号を記載してください (例: TODO(yamada): JIRA-123 ...)": "include an assignee and a ticket number in TODO/FIXME (e.g. TODO(yamada): JIRA-123 ...)",
+	"エラーは必ず明示的にハンドリングしてください":                                          "always handle errors explicitly",
+	"エラーはfmt.Errorf(\"...: %w\", err)でラップしてコンテキストを追加してください":           "wrap errors with fmt.Errorf(\"...: %w\", err) to add context",
+	"エラーをラップする際は失敗した操作の説明を付与してください":                                   "describe the failed operation when wrapping errors",
+	"定数メッセージはerrors.New、書式付きメッセージはfmt.Errorfを使用してください":                "use errors.New for constant messages and fmt.Errorf for formatted ones",
+	"panicの使用は避け、エラーを返却してください":                                        "avoid panic and return an error",
+	"単純な連結・数値変換には+演算子やstrconvを使用してください":                               "use the + operator or strconv for simple concatenation and number conversion",
+	"認証情報をハードコードしないでください":                                             "do not hardcode credentials",
+	"認証情報をハードコードしないでください。環境変数を使用してください":                               "do not hardcode credentials; use environment variables",
+	"SQLはプレースホルダを使用して組み立ててください":                                       "build SQL with placeholders",
+	"外部コマンドにユーザー入力を連結して渡さないでください":                                     "do not pass user input concatenated into external commands",
+	"秘密情報の生成にはcrypto/randを使用してください":                                   "use crypto/rand to generate secrets",
+	"通信は証明書を検証したTLS1.2以上で行ってください":                                     "communicate over TLS 1.2 or higher with certificate verification",
+	"ファイル・ディレクトリは必要最小限のパーミッションで作成してください":                              "create files and directories with the minimum required permissions",
+	"HTTPクライアントはタイムアウトを設定して明示的に生成してください":                              "create HTTP clients explicitly with a timeout",
+	"外部呼び出し・サーバーにはタイムアウトを設定してください":                                    "set timeouts for external calls and servers",
+	"パスワード・トークン等の機密情報をログに出力しないでください":                                  "do not log sensitive information such as passwords and tokens",
+	"標準logパッケージではなく構造化ログ(zerolog等)を使用してください":                          "use structured logging (e.g. zerolog) instead of the standard log package",
+	"本番コードでfmt.Printlnは使用せず、適切なログライブラリを使用してください":                      "do not use fmt.Println in production code; use a proper logging library",
+	"組み込み関数println/printは使用せず、適切なログライブラリを使用してください":                    "do not use the builtins println/print; use a proper logging library",
+	"os.Stdout/os.Stderrへ直接書き込まず、ロガーを使用してください":                        "use a logger instead of writing directly to os.Stdout/os.Stderr",
+	"ロギングライブラリはプロジェクトで1つに統一してください":                                    "use a single logging library across the project",
+	"ログフィールドキーは命名規則に従い、重複させないでください":                                   "follow the naming convention for log field keys and do not duplicate them",
+	"リクエストスコープの関数ではcontextから取得したロガーを使用してください":                         "use the logger from the context in request-scoped functions",
+	"context.Contextは第1引数ctxとして受け取ってください":                             "accept context.Context as the first parameter named ctx",
+	"context.Contextを構造体に保持しないでください":                                  "do not store context.Context in structs",
+	"context.WithValueのキーには非公開の独自型を使用してください":                          "use an unexported custom type for context.WithValue keys",
+	"引数のctxを伝播し、context.Background()/TODO()で新たに作成しないでください":            "propagate the ctx parameter instead of creating a new one with context.Background()/TODO()",
+	"受け取ったctxを下流の呼び出しに渡してください":                                        "pass the received ctx to downstream calls",
+	"ハンドラのエラー分岐ではエラーレスポンスを返却してください":                                   "return an error response in handler error branches",
+	"goroutineは終了を管理できる形で起動してください":                                    "start goroutines in a way that manages their termination",
+	"goroutineから書き込むmapはロックで保護してください":                                 "protect maps written from goroutines with a lock",
+	"goroutineの並行数を制限してください":                                          "limit the number of concurrent goroutines",
+	"チャネルは所有者（送信側）が管理してください":                                          "channels should be managed by their owner (the sender)",
+	"ロックを含む値はコピーせず、ポインタで扱ってください":                                      "do not copy values containing locks; use pointers",
+	"Tickerはループの外でtime.NewTickerで作成し、Stopしてください":                      "create Tickers with time.NewTicker outside loops and Stop them",
+	"selectでの送信にはタイムアウトまたはキャンセルのケースを追加してください":                         "add a timeout or cancellation case to sends in select",
+	"リトライは指数バックオフとcontextのキャンセルに対応させてください":                            "make retries use exponential backoff and honor context cancellation",
+	"イテレーション終了後に.Err()を確認してください":                                      "check .Err() after iteration",
+	"テストファイルを作成してください":                                                "create a test file",
+	"テストを追加してください":                                                    "add tests",
+	"テーブル駆動テストを検討してください":                                              "consider table-driven tests",
+	"テストではt.Parallel()を呼び出してください":                                     "call t.Parallel() in tests",
+	"テストヘルパーではt.Helper()を呼び出してください":                                   "call t.Helper() in test helpers",
+	"テスト関数はgo testが実行する名前（TestXxx・BenchmarkXxx・FuzzXxx）にしてください":       "name test functions so go test runs them (TestXxx, BenchmarkXxx, FuzzXxx)",
+	"テストパッケージの方針に従ってください":                                             "follow the test package policy",
+	"インタフェースは小さく保ち、利用者ごとに分割してください":                                    "keep interfaces small and split them per consumer",
+	"インタフェースは利用する側のパッケージで宣言してください":                                    "declare interfaces in the consuming package",
+	"インタフェースのメソッドの引数には名前を付けてください":                                     "name the parameters of interface methods",
+	"JSONタグはスネークケースで記述してください":                                         "write JSON tags in snake_case",
+	"DTOのフィールドにはjsonタグを付与してください":                                      "add json tags to DTO fields",
+	"リクエスト構造体にはvalidateタグを付与してください":                                   "add validate tags to request structs",
+	"validateタグの記述を確認してください":                                          "check the validate tags",
+	"デコードしたリクエストはバリデーションしてください":                                       "validate decoded requests",
+	"設定構造体のフィールドにはmapstructureまたはenvタグを付与してください":                      "add mapstructure or env tags to config struct fields",
+	"タグの書式を整えてください":                                                   "format the tags",
+	"タグの命名規則に従ってください":                                                 "follow the tag naming convention",
+	"タグの名前をフィールド名に合わせてください":                                           "match tag names to field names",
+	"タグの名前が重複しています":                                                   "tag names are duplicated",
+	"フィールドに必要なタグを付与してください":                                            "add the required tags to fields",
+	"省略可能なフィールドのjsonタグにはomitemptyを付与してください":                           "add omitempty to json tags of optional fields",
+	"AWSクライアントはinit()で初期化してコールドスタートを最適化してください":                        "initialize AWS clients in init() to optimize cold starts",
+	"AWS SDKの呼び出しにはcontextを渡してください":                                   "pass a context to AWS SDK calls",
+	"環境変数は起動時に一度だけ読み出して検証してください":                                      "read and validate environment variables only once at startup",
+	"SQSバッチ処理ではBatchItemFailuresをサポートしてください":                          "support BatchItemFailures in SQS batch processing",
+	"status.Errorでコードを付けたエラーを返してください":                                 "return errors with a code using status.Error",
+	"gRPCメソッドでpanicを使用しないでください":                                       "do not use panic in gRPC methods",
+
+	// 設定ファイルの読み込み
+	"extends が循環しています: {*}":          "extends is circular: {1}",
+	"extends の深さが上限（{*}）を超えました: {*}": "extends exceeds the maximum depth ({1}): {2}",
+	"{*}: 設定はマッピングで記述してください":         "{1}: the configuration must be a mapping",
+
+	// CLIの表示・警告・エラー（Error: ・Warning: の後の文言も翻訳する）
+	"Error: {*}":   "Error: {1}",
+	"Warning: {*}": "Warning: {1}",
+	"設定ファイルの読み込みに失敗しました: {*}": "failed to load config file: {1}",
+	"設定ファイルの生成に失敗しました: {*}":   "failed to generate config file: {1}",
+	"✅ 設定ファイルを生成しました: {*}":    "✅ Generated config file: {1}",
+	"次のステップ:": "Next steps:",
+	"1. go-standards.yaml をプロジェクトに合わせてカスタマイズ":              "1. Customize go-standards.yaml for your project",
+	"2. go-standards-checker を実行してチェック":                    "2. Run go-standards-checker to check",
+	"{*} の読み込みに失敗しました: {*}":                                "failed to read {1}: {2}",
+	"{*} の書き込みに失敗しました: {*}":                                "failed to write {1}: {2}",
+	"{*} の作成に失敗しました: {*}":                                  "failed to create {1}: {2}",
+	"{*} の削除に失敗しました: {*}":                                  "failed to remove {1}: {2}",
+	"{*} のチェックに失敗しました: {*}":                                "failed to check {1}: {2}",
+	"{*} の実行履歴の記録に失敗しました: {*}":                             "failed to record run history of {1}: {2}",
+	"fail_on に指定できるのは error, warning, info, none です: {*}":  "fail_on must be one of error, warning, info, none: {1}",
+	"-fail-on に指定できるのは error, warning, info, none です: {*}": "-fail-on must be one of error, warning, info, none: {1}",
+	"出力形式に指定できるのは {*} です: {*}":                             "output format must be one of {1}: {2}",
+	"言語に指定できるのは {*} です: {*}":                               "language must be one of {1}: {2}",
+	"-baseline write にはベースラインファイルのパスを指定してください":             "-baseline write requires the path of the baseline file",
+	"-baseline は -stream と同時に指定できません":                      "-baseline cannot be used with -stream",
+	"-fix・-fix-dry-run は -stream と同時に指定できません":              "-fix/-fix-dry-run cannot be used with -stream",
+	"-fix・-fix-dry-run は -staged と同時に指定できません":              "-fix/-fix-dry-run cannot be used with -staged",
+	"-stdin は -stream・-fix・-fix-dry-run・-baseline write・-staged・-changed・-diff・-against・-serve と同時に指定できません":                  "-stdin cannot be used with -stream, -fix, -fix-dry-run, -baseline write, -staged, -changed, -diff, -against or -serve",
+	"-diff は -staged・-changed と同時に指定できません":                                                                                   "-diff cannot be used with -staged or -changed",
+	"-against は -staged・-changed・-diff と同時に指定できません":                                                                          "-against cannot be used with -staged, -changed or -diff",
+	"複数のモジュールのチェックは -stdin・-stream・-fix・-fix-dry-run・-staged・-changed・-diff・-against・-baseline・-owners・-history と同時に指定できません": "checking multiple modules cannot be combined with -stdin, -stream, -fix, -fix-dry-run, -staged, -changed, -diff, -against, -baseline, -owners or -history",
+	"ターゲットディレクトリの解決に失敗しました: {*}":                                                                                             "failed to resolve target directory: {1}",
+	"ディレクトリが見つかりません: {*}":                                                                                                    "directory not found: {1}",
+	"{*}.path: ディレクトリが見つかりません: {*}":                                                                                          "{1}.path: directory not found: {2}",
+	"go.mod のあるディレクトリが見つかりません":                                                                                               "no directory with go.mod found",
+	"担当者の割り当てに失敗しました: {*}":                                                                                                   "failed to assign owners: {1}",
+	"変更ファイルの取得に失敗しました: {*}":                                                                                                  "failed to get changed files: {1}",
+	"✅ 変更されたGoファイルはありません":                                                                                                    "✅ No changed Go files",
+	"ステージされた内容の取得に失敗しました: {*}":                                                                                               "failed to get staged contents: {1}",
+	"変更行の取得に失敗しました: {*}":                                                                                                     "failed to get changed lines: {1}",
+	"プロファイルの開始に失敗しました: {*}":                                                                                                  "failed to start profiling: {1}",
+	"メモリプロファイルの書き込みに失敗しました: {*}":                                                                                             "failed to write memory profile: {1}",
+	"中断しました": "interrupted",
+	"中断しました（チェックを終えたファイルの結果のみを出力します）":       "interrupted (reporting results only for files already checked)",
+	"チェックに失敗しました: {*}":                      "check failed: {1}",
+	"中断したため修正しませんでした":                       "interrupted; no fixes were applied",
+	"中断したためベースラインを書き込みませんでした":               "interrupted; the baseline was not written",
+	"修正に失敗しました: {*}":                        "fix failed: {1}",
+	"修正を適用できませんでした: {*}":                    "could not apply fixes: {1}",
+	"ベースラインの読み込みに失敗しました: {*}":               "failed to load baseline: {1}",
+	"ベースラインの書き込みに失敗しました: {*}":               "failed to write baseline: {1}",
+	"レポートの読み込みに失敗しました: {*}":                 "failed to load report: {1}",
+	"レポートの出力に失敗しました: {*}":                   "failed to write report: {1}",
+	"レポートのアップロードに失敗しました: {*}":               "failed to upload report: {1}",
+	"レポートの送信に失敗しました: {*}":                   "failed to send report: {1}",
+	"JSON出力に失敗しました: {*}":                    "failed to write JSON: {1}",
+	"実行履歴の読み込みに失敗しました: {*}":                 "failed to load run history: {1}",
+	"実行履歴の記録に失敗しました: {*}":                   "failed to record run history: {1}",
+	"通知の送信に失敗しました: {*}":                     "failed to send notification: {1}",
+	"❌ 許容する件数を超えました（{*}）":                   "❌ Allowed violation counts exceeded ({1})",
+	"❌ {*}: 許容する件数を超えました（{*}）":              "❌ {1}: allowed violation counts exceeded ({2})",
+	"❌ 違反が増えました（weighted {*} → {*}, {*}）":   "❌ Violations increased (weighted {1} → {2}, {3})",
+	"✅ 違反は増えていません（weighted {*} → {*}, {*}）": "✅ Violations did not increase (weighted {1} → {2}, {3})",
+	"一時的なworktreeの削除に失敗しました: {*}":           "failed to remove temporary worktree: {1}",
+	"キャッシュディレクトリを決定できません（settings.cache_dir または -cache-dir を指定してください）: {*}": "cannot determine the cache directory (specify settings.cache_dir or -cache-dir): {1}",
+	"キャッシュの削除に失敗しました: {*}":                                   "failed to clear cache: {1}",
+	"gitリポジトリが見つかりません: {*}":                                  "git repository not found: {1}",
+	"{*} は設定されていません":                                         "{1} is not installed",
+	"{*} はgo-standards-checkerが設定したhookではありません":              "{1} is not a hook installed by go-standards-checker",
+	"{*} が既に存在します（上書きする場合は -force を指定してください）":                "{1} already exists (use -force to overwrite)",
+	"invalid @every interval {*} (1m以上)":                     "invalid @every interval {1} (must be at least 1m)",
+	"invalid cron expression {*} (分 時 日 月 曜日の5フィールド)":        "invalid cron expression {1} (5 fields: minute hour day month weekday)",
+	"settings.serve.schedules が設定されていません":                    "settings.serve.schedules is not configured",
+	"{*}.name: invalid name {*} (英数字・-・_)":                   "{1}.name: invalid name {2} (letters, digits, - and _)",
+	"デーモンモードの設定が不正です: {*}":                                   "invalid daemon mode configuration: {1}",
+	"HTTPサーバーを起動できません: {*}":                                  "cannot start HTTP server: {1}",
+	"HTTPサーバーの停止に失敗しました: {*}":                                "failed to stop HTTP server: {1}",
+	"レスポンスの送信に失敗しました: {*}":                                   "failed to send response: {1}",
+	"-stdin には -stdin-filename でファイルのパスを指定してください":            "-stdin requires the file path via -stdin-filename",
+	"-stdin-filename にはGoファイルを指定してください: {*}":                 "-stdin-filename must be a Go file: {1}",
+	"-stdin-filename の解決に失敗しました: {*}":                        "failed to resolve -stdin-filename: {1}",
+	"-stdin-filename はターゲットディレクトリ（{*}）配下のファイルを指定してください: {*}": "-stdin-filename must be a file under the target directory ({1}): {2}",
+	"標準入力の読み込みに失敗しました: {*}":                                  "failed to read standard input: {1}",
+}
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// ========================================
+// 複数のモジュール（ターゲット）の集計
+// ========================================
+
+// ModuleSummary モジュールごとの集計（複数のモジュールをチェックした場合）
+type ModuleSummary struct {
+	Path            string         `json:"path"` // 集計したレポートのProjectPathからの相対パス
+	TotalFiles      int            `json:"total_files"`
+	TotalViolations int            `json:"total_violations"`
+	BySeverity      map[string]int `json:"by_severity"`
+	Failures        []string       `json:"failures,omitempty"` // モジュールの設定の終了コードの判定基準を超えた重要度
+}
+
+// AddModule モジュールのレポートを集計に加える（すべてのモジュールを加えた後にFinalizeを呼び出す）
+// failuresはモジュールの設定で判定した PolicyFailures の結果
+func (r *Report) AddModule(path string, m *Report, failures []string) {
+	r.Merge(m)
+	r.Modules = append(r.Modules, ModuleSummary{
+		Path:            path,
+		TotalFiles:      m.TotalFiles,
+		TotalViolations: m.Summary.TotalViolations,
+		BySeverity:      maps.Clone(m.Summary.BySeverity),
+		Failures:        failures,
+	})
+}
+
+// FailedModules 終了コードの判定基準を超えたモジュール
+func (r *Report) FailedModules() []ModuleSummary {
+	var failed []ModuleSummary
+	for _, m := range r.Modules {
+		if len(m.Failures) > 0 {
+			failed = append(failed, m)
+		}
+	}
+	return failed
+}
+
+// ModulesText モジュールごとの件数と全体の件数をテキスト形式で作成（モジュールごとのレポートの後に出力する）
+func (r *Report) ModulesText() string {
+	var sb strings.Builder
+	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	sb.WriteString("                           MODULES SUMMARY                              \n")
+	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	sb.WriteString(fmt.Sprintf(r.localize("📦 Modules:  %d（failed: %d）\n", "📦 Modules:  %d (failed: %d)\n"), len(r.Modules), len(r.FailedModules())))
+	sb.WriteString(fmt.Sprintf("📄 Files:    %d\n", r.TotalFiles))
+	sb.WriteString(fmt.Sprintf("🔴 Errors:   %d\n", r.Summary.BySeverity["error"]))
+	sb.WriteString(fmt.Sprintf("🟡 Warnings: %d\n", r.Summary.BySeverity["warning"]))
+	sb.WriteString(fmt.Sprintf("🔵 Info:     %d\n", r.Summary.BySeverity["info"]))
+	sb.WriteString(fmt.Sprintf("📊 Total:    %d violations\n", r.Summary.TotalViolations))
+	sb.WriteString(r.scoreText() + "\n")
+	sb.WriteString(r.moduleTable())
+	return sb.String()
+}
+
+// moduleTable モジュールごとの件数の一覧（判定基準を超えたモジュールは❌）
+func (r *Report) moduleTable() string {
+	width := 0
+	for _, m := range r.Modules {
+		width = max(width, len(m.Path))
+	}
+	var sb strings.Builder
+	sb.WriteString("By Module:\n")
+	for _, m := range r.Modules {
+		mark, failures := "✅", ""
+		if len(m.Failures) > 0 {
+			mark, failures = "❌", "  ("+strings.Join(m.Failures, ", ")+")"
+		}
+		sb.WriteString(fmt.Sprintf("  %s %-*s  files %4d  🔴 %d  🟡 %d  🔵 %d%s\n", mark, width, m.Path,
+			m.TotalFiles, m.BySeverity["error"], m.BySeverity["warning"], m.BySeverity["info"], failures))
+	}
+	return sb.String()
+}
@@ -0,0 +1,124 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// notifyTimeout Webhookへの送信のタイムアウト
+const notifyTimeout = 10 * time.Second
+
+// defaultNotificationTemplate 通知メッセージの既定テンプレート
+const defaultNotificationTemplate = `❌ Go Standards Checker: {{.Project}}
+🔴 Errors: {{.Errors}} / 🟡 Warnings: {{.Warnings}} / 🔵 Info: {{.Infos}}
+{{range .Violations}}• {{.File}}:{{.Line}} [{{.Rule}}] {{.Message}}
+{{end}}{{if .Omitted}}…ほか {{.Omitted}} 件
+{{end}}`
+
+// defaultNotificationTemplateEn 出力言語が英語の場合の通知メッセージの既定テンプレート
+const defaultNotificationTemplateEn = `❌ Go Standards Checker: {{.Project}}
+🔴 Errors: {{.Errors}} / 🟡 Warnings: {{.Warnings}} / 🔵 Info: {{.Infos}}
+{{range .Violations}}• {{.File}}:{{.Line}} [{{.Rule}}] {{.Message}}
+{{end}}{{if .Omitted}}…and {{.Omitted}} more
+{{end}}`
+
+// NotificationData 通知テンプレートに渡すデータ
+type NotificationData struct {
+	Project    string
+	Total      int
+	Errors     int
+	Warnings   int
+	Infos      int
+	Violations []Violation // 先頭のMaxItems件
+	Omitted    int         // 省略した件数
+}
+
+// Notify 設定された重要度以上の違反があればWebhookへサマリーを送信する
+// 本文は {"text": "..."} 形式（Slack・Teams・MattermostのIncoming Webhookで受け付けられる形式）
+func (r *Report) Notify(ctx context.Context, cfg rules.NotificationConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	url := os.ExpandEnv(cfg.WebhookURL)
+	if url == "" {
+		return fmt.Errorf("webhook_url is empty")
+	}
+
+	minSeverity := rules.SeverityError
+	if cfg.MinSeverity != "" {
+		minSeverity = rules.ParseSeverity(cfg.MinSeverity)
+	}
+	filtered := r.Filter(minSeverity)
+	if len(filtered.Violations) == 0 {
+		return nil
+	}
+
+	text, err := filtered.notificationText(cfg)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: notifyTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// notificationText テンプレートから通知メッセージを作成
+func (r *Report) notificationText(cfg rules.NotificationConfig) (string, error) {
+	src := cfg.Template
+	if src == "" {
+		src = r.localize(defaultNotificationTemplate, defaultNotificationTemplateEn)
+	}
+	tmpl, err := template.New("notification").Parse(src)
+	if err != nil {
+		return "", fmt.Errorf("invalid notification template: %w", err)
+	}
+
+	maxItems := cfg.MaxItems
+	if maxItems <= 0 {
+		maxItems = 10
+	}
+	data := NotificationData{
+		Project:    r.ProjectPath,
+		Total:      r.Summary.TotalViolations,
+		Errors:     r.Summary.BySeverity[string(rules.SeverityError)],
+		Warnings:   r.Summary.BySeverity[string(rules.SeverityWarning)],
+		Infos:      r.Summary.BySeverity[string(rules.SeverityInfo)],
+		Violations: r.Violations,
+	}
+	if len(data.Violations) > maxItems {
+		data.Omitted = len(data.Violations) - maxItems
+		data.Violations = data.Violations[:maxItems]
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid notification template: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,69 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnownedLabel 担当者が見つからない違反の集計上の名前
+const UnownedLabel = "(unowned)"
+
+// AssignOwners 各違反に担当者を割り当て、担当者別の件数を集計し直す
+// ownerが空を返した違反は担当者なし（UnownedLabel）として集計する
+func (r *Report) AssignOwners(owner func(v Violation) string) {
+	for i := range r.Violations {
+		r.Violations[i].Owner = owner(r.Violations[i])
+	}
+	r.Summary.ByOwner = nil
+	r.countOwners()
+	if r.Summary.ByOwner == nil && len(r.Violations) > 0 {
+		r.Summary.ByOwner = map[string]int{UnownedLabel: len(r.Violations)}
+	}
+}
+
+// countOwners 担当者別の件数を集計（いずれかの違反に担当者がある場合のみ）
+func (r *Report) countOwners() {
+	owned := false
+	for _, v := range r.Violations {
+		if v.Owner != "" {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		return
+	}
+	r.Summary.ByOwner = make(map[string]int)
+	for _, v := range r.Violations {
+		owner := v.Owner
+		if owner == "" {
+			owner = UnownedLabel
+		}
+		r.Summary.ByOwner[owner]++
+	}
+}
+
+// ownerTable 担当者別の件数を多い順に並べた表
+func (r *Report) ownerTable() string {
+	owners := make([]string, 0, len(r.Summary.ByOwner))
+	width := 0
+	for owner := range r.Summary.ByOwner {
+		owners = append(owners, owner)
+		width = max(width, len(owner))
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		a, b := r.Summary.ByOwner[owners[i]], r.Summary.ByOwner[owners[j]]
+		if a != b {
+			return a > b
+		}
+		return owners[i] < owners[j]
+	})
+
+	var sb strings.Builder
+	sb.WriteString("By Owner:\n")
+	for _, owner := range owners {
+		sb.WriteString(fmt.Sprintf("  • %-*s %d\n", width, owner, r.Summary.ByOwner[owner]))
+	}
+	return sb.String()
+}
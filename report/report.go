@@ -1,8 +1,10 @@
 package report
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
@@ -19,15 +21,52 @@ type Violation struct {
 	Severity   rules.Severity `json:"severity"`
 	Message    string         `json:"message"`
 	Suggestion string         `json:"suggestion,omitempty"`
-	Code       string         `json:"code,omitempty"` // 該当コード行
+	Code       string         `json:"code,omitempty"`  // 該当コード行
+	Fix        *Fix           `json:"fix,omitempty"`   // 自動修正情報
+	Owner      string         `json:"owner,omitempty"` // 担当者・チーム（CODEOWNERSまたはgit blame）
+
+	// SourceMessage 翻訳前のメッセージ（Messageを出力言語に翻訳した場合のみ）
+	// ベースライン・比較の照合に使い、出力言語が異なるレポートどうしでも同じ違反として扱う
+	SourceMessage string `json:"source_message,omitempty"`
+}
+
+// sourceMessage 出力言語に依存しないメッセージ（翻訳前のメッセージ）
+func (v Violation) sourceMessage() string {
+	if v.SourceMessage != "" {
+		return v.SourceMessage
+	}
+	return v.Message
+}
+
+// Fix 自動修正情報
+type Fix struct {
+	Description string     `json:"description"`
+	Edits       []TextEdit `json:"edits"`
+	Rename      string     `json:"rename,omitempty"` // 編集後にファイルを変更する名前（パス）
+}
+
+// TextEdit ファイル内のバイトオフセット範囲[Start, End)をNewTextで置換する編集
+type TextEdit struct {
+	File    string `json:"file,omitempty"` // 編集するファイル（空の場合は違反のファイル）
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+	NewText string `json:"new_text"`
 }
 
 // Report チェックレポート
 type Report struct {
-	ProjectPath string      `json:"project_path"`
-	TotalFiles  int         `json:"total_files"`
-	Violations  []Violation `json:"violations"`
-	Summary     Summary     `json:"summary"`
+	ProjectPath      string          `json:"project_path"`
+	TotalFiles       int             `json:"total_files"`
+	SkippedGenerated int             `json:"skipped_generated,omitempty"` // 自動生成ファイルとしてチェックしなかったファイル数
+	Violations       []Violation     `json:"violations"`
+	Summary          Summary         `json:"summary"`
+	Backlog          []TodoItem      `json:"backlog,omitempty"` // TODO/FIXMEコメント（comments.todoが有効な場合）
+	Modules          []ModuleSummary `json:"modules,omitempty"` // モジュールごとの集計（複数のモジュールをチェックした場合）
+
+	sink     Sink                              // 設定されている場合は違反を保持せずに渡す
+	streamed map[rules.Severity]map[string]int // sinkに渡した違反の重要度・カテゴリ別件数
+	lang     string                            // 出力言語（SetLanguage参照）
+	repoRoot string                            // 注釈の形式のパスの基準（SetRepoRoot参照）
 }
 
 // Summary サマリー情報
@@ -35,8 +74,14 @@ type Summary struct {
 	TotalViolations int            `json:"total_violations"`
 	ByCategory      map[string]int `json:"by_category"`
 	BySeverity      map[string]int `json:"by_severity"`
+	ByOwner         map[string]int `json:"by_owner,omitempty"`     // 担当者別（担当者を割り当てた場合のみ）
+	Suppressed      int            `json:"suppressed,omitempty"`   // 抑制コメント（//standards:ignore）で取り除いた違反の件数
+	Baselined       int            `json:"baselined,omitempty"`    // ベースライン（-baseline）にあるため取り除いた違反の件数
+	OutsideDiff     int            `json:"outside_diff,omitempty"` // 変更行以外（-diff）にあるため取り除いた違反の件数
 	PassedRules     int            `json:"passed_rules"`
 	FailedRules     int            `json:"failed_rules"`
+	Score           float64        `json:"score"`                    // 重要度で重み付けした準拠スコア（0〜100、Score参照）
+	PreviousScore   *float64       `json:"previous_score,omitempty"` // 実行履歴（-history）の前回のスコア
 }
 
 // NewReport 新しいレポートを作成
@@ -51,11 +96,39 @@ func NewReport(projectPath string) *Report {
 	}
 }
 
-// AddViolation 違反を追加
+// AddViolation 違反を追加（出力言語が英語の場合はメッセージ・提案を翻訳する）
 func (r *Report) AddViolation(v Violation) {
+	v = r.localizeViolation(v)
+	if r.sink != nil {
+		r.stream(v)
+		return
+	}
 	r.Violations = append(r.Violations, v)
 }
 
+// AddSuppressed 抑制コメントで取り除いた違反の件数を加える
+func (r *Report) AddSuppressed(n int) {
+	r.Summary.Suppressed += n
+}
+
+// Merge 他のレポートの違反とファイル数を取り込む（集計はFinalizeで行う）
+func (r *Report) Merge(other *Report) {
+	r.TotalFiles += other.TotalFiles
+	r.SkippedGenerated += other.SkippedGenerated
+	for _, v := range other.Violations {
+		r.AddViolation(v)
+	}
+	r.Backlog = append(r.Backlog, other.Backlog...)
+	r.Summary.Suppressed += other.Summary.Suppressed
+	r.Summary.Baselined += other.Summary.Baselined
+	r.Summary.OutsideDiff += other.Summary.OutsideDiff
+	for severity, categories := range other.streamed {
+		for category, n := range categories {
+			r.countStreamed(severity, category, n)
+		}
+	}
+}
+
 // Finalize レポートを完成させる
 func (r *Report) Finalize() {
 	r.Summary.TotalViolations = len(r.Violations)
@@ -65,6 +138,15 @@ func (r *Report) Finalize() {
 		r.Summary.ByCategory[v.Category]++
 		r.Summary.BySeverity[string(v.Severity)]++
 	}
+	for severity, categories := range r.streamed {
+		for category, n := range categories {
+			r.Summary.TotalViolations += n
+			r.Summary.ByCategory[category] += n
+			r.Summary.BySeverity[string(severity)] += n
+		}
+	}
+	r.countOwners()
+	r.Summary.Score = r.Score()
 
 	// 違反を重要度・ファイル順にソート
 	sort.Slice(r.Violations, func(i, j int) bool {
@@ -76,20 +158,43 @@ func (r *Report) Finalize() {
 		}
 		return r.Violations[i].Line < r.Violations[j].Line
 	})
+	sort.Slice(r.Backlog, func(i, j int) bool {
+		if r.Backlog[i].File != r.Backlog[j].File {
+			return r.Backlog[i].File < r.Backlog[j].File
+		}
+		return r.Backlog[i].Line < r.Backlog[j].Line
+	})
 }
 
 // Filter 重要度でフィルタリング
 func (r *Report) Filter(minSeverity rules.Severity) *Report {
 	filtered := NewReport(r.ProjectPath)
+	filtered.lang = r.lang
+	filtered.repoRoot = r.repoRoot
 	filtered.TotalFiles = r.TotalFiles
+	filtered.SkippedGenerated = r.SkippedGenerated
+	filtered.Backlog = r.Backlog
+	filtered.Summary.Suppressed = r.Summary.Suppressed
+	filtered.Summary.Baselined = r.Summary.Baselined
+	filtered.Summary.OutsideDiff = r.Summary.OutsideDiff
 
 	for _, v := range r.Violations {
 		if v.Severity.Level() >= minSeverity.Level() {
 			filtered.AddViolation(v)
 		}
 	}
+	for severity, categories := range r.streamed {
+		if severity.Level() < minSeverity.Level() {
+			continue
+		}
+		for category, n := range categories {
+			filtered.countStreamed(severity, category, n)
+		}
+	}
 
 	filtered.Finalize()
+	filtered.Summary.Score = r.Score()
+	filtered.Summary.PreviousScore = r.Summary.PreviousScore
 	return filtered
 }
 
@@ -104,6 +209,38 @@ func (r *Report) ToJSON() (string, error) {
 
 // ToText テキスト形式で出力
 func (r *Report) ToText() string {
+	text, _ := r.text(context.Background())
+	return text
+}
+
+// WriteText テキスト形式でwに書き込む（ctxがキャンセルされた場合は書き込まずにctx.Err()を返す）
+func (r *Report) WriteText(ctx context.Context, w io.Writer) error {
+	text, err := r.text(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, text)
+	return err
+}
+
+// WriteJSON JSON形式でwに書き込む（ctxがキャンセルされた場合は書き込まずにctx.Err()を返す）
+func (r *Report) WriteJSON(ctx context.Context, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, err := r.ToJSON()
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, data+"\n")
+	return err
+}
+
+// text テキスト形式のレポートを作成（違反ごとにctxのキャンセルを確認する）
+func (r *Report) text(ctx context.Context) (string, error) {
 	var sb strings.Builder
 
 	// ヘッダー
@@ -112,13 +249,17 @@ func (r *Report) ToText() string {
 	sb.WriteString("╚══════════════════════════════════════════════════════════════════════╝\n\n")
 
 	sb.WriteString(fmt.Sprintf("📁 Project: %s\n", r.ProjectPath))
-	sb.WriteString(fmt.Sprintf("📄 Files Checked: %d\n\n", r.TotalFiles))
+	sb.WriteString(fmt.Sprintf("📄 Files Checked: %d\n", r.TotalFiles))
+	if r.SkippedGenerated > 0 {
+		sb.WriteString(fmt.Sprintf("⚙️  Generated Files Skipped: %d\n", r.SkippedGenerated))
+	}
+	sb.WriteString("\n")
 
 	// サマリー
 	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 	sb.WriteString("                              SUMMARY                                   \n")
 	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-	
+
 	errorCount := r.Summary.BySeverity["error"]
 	warningCount := r.Summary.BySeverity["warning"]
 	infoCount := r.Summary.BySeverity["info"]
@@ -126,7 +267,18 @@ func (r *Report) ToText() string {
 	sb.WriteString(fmt.Sprintf("🔴 Errors:   %d\n", errorCount))
 	sb.WriteString(fmt.Sprintf("🟡 Warnings: %d\n", warningCount))
 	sb.WriteString(fmt.Sprintf("🔵 Info:     %d\n", infoCount))
-	sb.WriteString(fmt.Sprintf("📊 Total:    %d violations\n\n", r.Summary.TotalViolations))
+	sb.WriteString(fmt.Sprintf("📊 Total:    %d violations\n", r.Summary.TotalViolations))
+	sb.WriteString(r.scoreText())
+	if r.Summary.Suppressed > 0 {
+		sb.WriteString(fmt.Sprintf("🔕 Suppressed: %d\n", r.Summary.Suppressed))
+	}
+	if r.Summary.Baselined > 0 {
+		sb.WriteString(fmt.Sprintf("📎 Baselined:  %d\n", r.Summary.Baselined))
+	}
+	if r.Summary.OutsideDiff > 0 {
+		sb.WriteString(fmt.Sprintf("✂️ Outside diff: %d\n", r.Summary.OutsideDiff))
+	}
+	sb.WriteString("\n")
 
 	// カテゴリ別
 	if len(r.Summary.ByCategory) > 0 {
@@ -137,12 +289,26 @@ func (r *Report) ToText() string {
 		sb.WriteString("\n")
 	}
 
+	// 違反の多いルール・ファイル
+	sb.WriteString(r.topText())
+
+	// 担当者別
+	if len(r.Summary.ByOwner) > 0 {
+		sb.WriteString(r.ownerTable())
+		sb.WriteString("\n")
+	}
+
+	// バックログ
+	if len(r.Backlog) > 0 {
+		sb.WriteString(r.backlogText())
+	}
+
 	// 違反がない場合
 	if len(r.Violations) == 0 {
 		sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 		sb.WriteString("✅ Congratulations! No violations found.\n")
 		sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-		return sb.String()
+		return sb.String(), nil
 	}
 
 	// 違反詳細
@@ -152,6 +318,10 @@ func (r *Report) ToText() string {
 
 	currentFile := ""
 	for i, v := range r.Violations {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
 		// ファイルが変わったらヘッダー出力
 		if v.File != currentFile {
 			currentFile = v.File
@@ -170,12 +340,15 @@ func (r *Report) ToText() string {
 
 		// 違反情報
 		sb.WriteString(fmt.Sprintf("%s [%s] Line %d: %s\n", icon, v.Rule, v.Line, v.Message))
-		
+		if v.Owner != "" {
+			sb.WriteString(fmt.Sprintf("   👤 Owner: %s\n", v.Owner))
+		}
+
 		// コードがあれば表示
 		if v.Code != "" {
 			sb.WriteString(fmt.Sprintf("   │ %s\n", strings.TrimSpace(v.Code)))
 		}
-		
+
 		// 提案があれば表示
 		if v.Suggestion != "" {
 			sb.WriteString(fmt.Sprintf("   💡 Suggestion: %s\n", v.Suggestion))
@@ -191,7 +364,7 @@ func (r *Report) ToText() string {
 
 	// フッター
 	sb.WriteString("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-	
+
 	if errorCount > 0 {
 		sb.WriteString("❌ Check FAILED - Please fix errors before committing.\n")
 	} else if warningCount > 0 {
@@ -199,10 +372,10 @@ func (r *Report) ToText() string {
 	} else {
 		sb.WriteString("✅ Check PASSED - Good job!\n")
 	}
-	
+
 	sb.WriteString("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 
-	return sb.String()
+	return sb.String(), nil
 }
 
 // HasErrors エラーがあるか
@@ -215,10 +388,27 @@ func (r *Report) HasWarnings() bool {
 	return r.Summary.BySeverity["warning"] > 0
 }
 
-// ExitCode 終了コードを返す
+// ExitCode 終了コードを返す（エラーがあれば1）
 func (r *Report) ExitCode() int {
-	if r.HasErrors() {
+	return r.ExitCodeFor(rules.ExitPolicy{})
+}
+
+// ExitCodeFor 判定基準に従った終了コードを返す（許容する件数を超えた重要度があれば1）
+func (r *Report) ExitCodeFor(policy rules.ExitPolicy) int {
+	if len(r.PolicyFailures(policy)) > 0 {
 		return 1
 	}
 	return 0
 }
+
+// PolicyFailures 許容する件数を超えた重要度ごとの説明（例: "warning: 25 > 20"）
+func (r *Report) PolicyFailures(policy rules.ExitPolicy) []string {
+	var failures []string
+	for _, s := range []rules.Severity{rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo} {
+		allowed, limited := policy.Allowance(s)
+		if count := r.Summary.BySeverity[string(s)]; limited && count > allowed {
+			failures = append(failures, fmt.Sprintf("%s: %d > %d", s, count, allowed))
+		}
+	}
+	return failures
+}
@@ -0,0 +1,162 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 準拠スコアと違反の多いルール・ファイル
+// ========================================
+
+// topCount テキスト形式のレポートに出力する違反の多いルール・ファイルの件数
+const topCount = 10
+
+// severityWeight 準拠スコアの重要度の重み（error=10・warning=3・info=1）
+func severityWeight(s rules.Severity) int {
+	switch s {
+	case rules.SeverityError:
+		return 10
+	case rules.SeverityWarning:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// weightedViolations 現在の違反（逐次出力した違反を含む）の重み付けした件数
+func (r *Report) weightedViolations() int {
+	total := 0
+	for _, v := range r.Violations {
+		total += severityWeight(v.Severity)
+	}
+	for severity, categories := range r.streamed {
+		for _, n := range categories {
+			total += severityWeight(severity) * n
+		}
+	}
+	return total
+}
+
+// SetPrevious 実行履歴のうち、同じプロジェクトの直近の実行（無ければ直近の実行）のスコアを前回のスコアにする
+func (r *Report) SetPrevious(entries []HistoryEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	prev := entries[len(entries)-1]
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Project == r.ProjectPath {
+			prev = entries[i]
+			break
+		}
+	}
+	score := prev.Score
+	r.Summary.PreviousScore = &score
+}
+
+// scoreText スコアの行（前回のスコアがあれば増減を付ける）
+func (r *Report) scoreText() string {
+	line := fmt.Sprintf("🏆 Score:    %.1f / 100", r.Summary.Score)
+	if r.Summary.PreviousScore != nil {
+		line += " (" + formatDelta(r.Summary.Score-*r.Summary.PreviousScore) + " since last run)"
+	}
+	return line + "\n"
+}
+
+// ruleCount ルールごとの違反の件数
+type ruleCount struct {
+	rule     string
+	category string
+	severity rules.Severity // ルールの違反のうち最も高い重要度
+	count    int
+}
+
+// topRules 違反の多いルール（同数の場合は重要度の高い順、ルール名順）
+func (r *Report) topRules(n int) []ruleCount {
+	byRule := make(map[string]*ruleCount)
+	for _, v := range r.Violations {
+		rc, ok := byRule[v.Rule]
+		if !ok {
+			rc = &ruleCount{rule: v.Rule, category: v.Category, severity: v.Severity}
+			byRule[v.Rule] = rc
+		}
+		rc.count++
+		if v.Severity.Level() > rc.severity.Level() {
+			rc.severity = v.Severity
+		}
+	}
+	counts := make([]ruleCount, 0, len(byRule))
+	for _, rc := range byRule {
+		counts = append(counts, *rc)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		a, b := counts[i], counts[j]
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		if a.severity.Level() != b.severity.Level() {
+			return a.severity.Level() > b.severity.Level()
+		}
+		return a.rule < b.rule
+	})
+	return counts[:min(n, len(counts))]
+}
+
+// fileCount ファイルごとの重み付けした違反の件数
+type fileCount struct {
+	file       string
+	weighted   int
+	bySeverity map[rules.Severity]int
+}
+
+// worstFiles 重み付けした違反の件数の多いファイル（同数の場合はファイル名順）
+// プロジェクト単位の違反（ファイルが "." 等）も1つのファイルとして数える
+func (r *Report) worstFiles(n int) []fileCount {
+	byFile := make(map[string]*fileCount)
+	for _, v := range r.Violations {
+		fc, ok := byFile[v.File]
+		if !ok {
+			fc = &fileCount{file: r.relPath(v.File), bySeverity: make(map[rules.Severity]int)}
+			byFile[v.File] = fc
+		}
+		fc.weighted += severityWeight(v.Severity)
+		fc.bySeverity[v.Severity]++
+	}
+	counts := make([]fileCount, 0, len(byFile))
+	for _, fc := range byFile {
+		counts = append(counts, *fc)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].weighted != counts[j].weighted {
+			return counts[i].weighted > counts[j].weighted
+		}
+		return counts[i].file < counts[j].file
+	})
+	return counts[:min(n, len(counts))]
+}
+
+// topText 違反の多いルール・ファイルの一覧（違反が無い場合は空）
+func (r *Report) topText() string {
+	rulesTop := r.topRules(topCount)
+	if len(rulesTop) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Top %d Rules:\n", len(rulesTop)))
+	for i, rc := range rulesTop {
+		sb.WriteString(fmt.Sprintf("  %2d. %s %s (%s): %d\n", i+1, severityIcon(rc.severity), rc.rule, rc.category, rc.count))
+	}
+	sb.WriteString("\n")
+
+	files := r.worstFiles(topCount)
+	sb.WriteString(fmt.Sprintf("Top %d Worst Files:\n", len(files)))
+	for i, fc := range files {
+		sb.WriteString(fmt.Sprintf("  %2d. %s: weight %d (🔴 %d  🟡 %d  🔵 %d)\n", i+1, fc.file, fc.weighted,
+			fc.bySeverity[rules.SeverityError], fc.bySeverity[rules.SeverityWarning], fc.bySeverity[rules.SeverityInfo]))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// Sink 違反を見つけた順に受け取る関数
+type Sink func(v Violation)
+
+// JSONLinesSink 違反を1行1件のJSON（JSON Lines）としてwに書き込むSink
+// 複数のgoroutineから呼び出してよい
+func JSONLinesSink(w io.Writer) Sink {
+	var mu sync.Mutex
+	enc := json.NewEncoder(w)
+	return func(v Violation) {
+		mu.Lock()
+		defer mu.Unlock()
+		_ = enc.Encode(v)
+	}
+}
+
+// SetSink 違反をレポートに保持せずsinkに渡すようにする
+// 件数（Summary）のみ集計し、Violationsは空のままになる
+func (r *Report) SetSink(sink Sink) {
+	r.sink = sink
+}
+
+// stream 違反をsinkに渡して件数のみ記録する
+func (r *Report) stream(v Violation) {
+	r.sink(v)
+	r.countStreamed(v.Severity, v.Category, 1)
+}
+
+// countStreamed sinkに渡した違反の件数を記録する
+func (r *Report) countStreamed(severity rules.Severity, category string, n int) {
+	if r.streamed == nil {
+		r.streamed = make(map[rules.Severity]map[string]int)
+	}
+	if r.streamed[severity] == nil {
+		r.streamed[severity] = make(map[string]int)
+	}
+	r.streamed[severity][category] += n
+}
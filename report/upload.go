@@ -0,0 +1,302 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// レポートのアップロード
+// ========================================
+
+// uploadTimeout レポートのアップロードのタイムアウト
+const uploadTimeout = time.Minute
+
+// gcsEndpoint Cloud StorageのXML APIのエンドポイント
+var gcsEndpoint = "https://storage.googleapis.com"
+
+// UploadMeta アップロード先のキーのテンプレートに渡す実行環境の情報
+type UploadMeta struct {
+	Repo      string    // リポジトリ名（例: org/repo）
+	Branch    string    // ブランチ名
+	Commit    string    // コミットハッシュ
+	Timestamp time.Time // 実行日時
+}
+
+// uploadKeyData キーのテンプレートに渡すデータ
+type uploadKeyData struct {
+	Repo        string
+	Branch      string
+	Commit      string
+	ShortCommit string
+	Timestamp   string // UTCの「20060102T150405Z」形式
+	Format      string
+}
+
+// Upload 設定された形式でレポートを作成し、アップロード先にPUTする（アップロード先のURLを返す）
+//
+// s3:// はAWS_ACCESS_KEY_ID・AWS_SECRET_ACCESS_KEY（・AWS_SESSION_TOKEN）で署名し、
+// gs:// はGOOGLE_OAUTH_ACCESS_TOKEN（gcloud auth print-access-token の値）で認証する
+func (r *Report) Upload(ctx context.Context, cfg rules.UploadConfig, meta UploadMeta) (string, error) {
+	if !cfg.Enabled {
+		return "", nil
+	}
+	format := cfg.Format
+	if format == "" {
+		format = "json"
+	}
+	body, contentType, err := r.Render(format)
+	if err != nil {
+		return "", err
+	}
+
+	dest, err := uploadURL(os.ExpandEnv(cfg.URL), uploadKeyData{
+		Repo:        meta.Repo,
+		Branch:      meta.Branch,
+		Commit:      meta.Commit,
+		ShortCommit: shortCommit(meta.Commit),
+		Timestamp:   meta.Timestamp.UTC().Format("20060102T150405Z"),
+		Format:      format,
+	})
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(dest)
+	if err != nil {
+		return "", fmt.Errorf("invalid upload url: %w", err)
+	}
+	req, err := uploadRequest(ctx, cfg, u, body)
+	if err != nil {
+		return "", err
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	client := &http.Client{Timeout: uploadTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("upload to %s returned %s", dest, resp.Status)
+	}
+	return dest, nil
+}
+
+// uploadRequest アップロード先のスキームに応じたPUTリクエストを作成
+func uploadRequest(ctx context.Context, cfg rules.UploadConfig, u *url.URL, body []byte) (*http.Request, error) {
+	key := strings.TrimPrefix(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		return s3Request(ctx, cfg, u.Host, key, body)
+	case "gs":
+		return gcsRequest(ctx, u.Host, key, body)
+	case "https", "http":
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range cfg.Headers {
+			req.Header.Set(name, os.ExpandEnv(value))
+		}
+		return req, nil
+	default:
+		return nil, fmt.Errorf("unsupported upload url %q (s3://, gs:// or https://)", u)
+	}
+}
+
+// uploadURL URL中のテンプレートを展開する
+func uploadURL(raw string, data uploadKeyData) (string, error) {
+	if raw == "" {
+		return "", errors.New("upload url is empty")
+	}
+	tmpl, err := template.New("upload").Option("missingkey=error").Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid upload url template: %w", err)
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("invalid upload url template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// gcsRequest Cloud StorageのXML APIへのPUT
+func gcsRequest(ctx context.Context, bucket, key string, body []byte) (*http.Request, error) {
+	token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN")
+	if token == "" {
+		return nil, errors.New("GOOGLE_OAUTH_ACCESS_TOKEN is required for gs:// uploads")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut,
+		gcsEndpoint+"/"+bucket+"/"+awsURIEncode(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req, nil
+}
+
+// s3Request AWS Signature Version 4で署名したS3へのPUT
+func s3Request(ctx context.Context, cfg rules.UploadConfig, bucket, key string, body []byte) (*http.Request, error) {
+	accessKey, secretKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required for s3:// uploads")
+	}
+	region := cfg.Region
+	for _, env := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if region == "" {
+			region = os.Getenv(env)
+		}
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	// エンドポイントを指定した場合はS3互換ストレージ向けにパス形式でアクセスする
+	path := "/" + awsURIEncode(key)
+	endpoint := "https://" + bucket + ".s3." + region + ".amazonaws.com"
+	if cfg.Endpoint != "" {
+		endpoint = strings.TrimSuffix(os.ExpandEnv(cfg.Endpoint), "/")
+		path = "/" + bucket + path
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	signV4(req, region, accessKey, secretKey, body, time.Now().UTC())
+	return req, nil
+}
+
+// signV4 S3へのリクエストにSignature Version 4の署名ヘッダーを設定する
+// リクエストに設定済みのヘッダー（Content-Typeは署名後に設定する）とhostを署名の対象にする
+func signV4(req *http.Request, region, accessKey, secretKey string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	canonical, signedHeaders := canonicalRequestV4(req, payloadHash)
+	scope := now.Format("20060102") + "/" + region + "/s3/aws4_request"
+	signature := signatureV4(secretKey, now.Format("20060102"), region, stringToSignV4(amzDate, scope, canonical))
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// canonicalRequestV4 署名の対象の正規化したリクエストと、署名したヘッダー名の一覧（; 区切り、Authorizationは除く）
+func canonicalRequestV4(req *http.Request, payloadHash string) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	values := map[string]string{"host": host}
+	for name, vs := range req.Header {
+		if strings.EqualFold(name, "Authorization") {
+			continue
+		}
+		trimmed := make([]string, len(vs))
+		for i, v := range vs {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		values[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var headers strings.Builder
+	for _, name := range names {
+		headers.WriteString(name + ":" + values[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+	return strings.Join([]string{
+		req.Method, awsURIEncode(req.URL.Path), canonicalQueryV4(req.URL.Query()), headers.String(), signedHeaders, payloadHash,
+	}, "\n"), signedHeaders
+}
+
+// canonicalQueryV4 名前・値の順に並べ、URIエンコードしたクエリ文字列
+func canonicalQueryV4(query url.Values) string {
+	encode := func(s string) string {
+		return strings.ReplaceAll(awsURIEncode(s), "/", "%2F")
+	}
+	var params [][2]string
+	for name, vs := range query {
+		for _, v := range vs {
+			params = append(params, [2]string{encode(name), encode(v)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	pairs := make([]string, len(params))
+	for i, p := range params {
+		pairs[i] = p[0] + "=" + p[1]
+	}
+	return strings.Join(pairs, "&")
+}
+
+// stringToSignV4 署名する文字列
+func stringToSignV4(amzDate, scope, canonicalRequest string) string {
+	return "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+}
+
+// signatureV4 日付・リージョンから導出した署名鍵による署名
+func signatureV4(secretKey, date, region, stringToSign string) string {
+	signingKey := []byte("AWS4" + secretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+// awsURIEncode オブジェクトキーをURIエンコードする（/ は区切りとして残す）
+func awsURIEncode(key string) string {
+	var sb strings.Builder
+	for i := 0; i < len(key); i++ {
+		ch := key[i]
+		if ch >= 'A' && ch <= 'Z' || ch >= 'a' && ch <= 'z' || ch >= '0' && ch <= '9' ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' || ch == '/' {
+			sb.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", ch)
+		}
+	}
+	return sb.String()
+}
+
+// sha256Hex SHA-256の16進表現
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256 HMAC-SHA256
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
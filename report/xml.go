@@ -0,0 +1,170 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// JUnit XML・Checkstyle XML
+// ========================================
+
+// junitTestSuites JUnit XMLのルート（ファイルごとのtestsuite）
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite 1ファイルの結果（違反したルールごとのtestcase）
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase 1ファイルの1ルールの結果
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure ルールの違反（本文は違反ごとに1行）
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"` // 違反の最も高い重要度
+	Text    string `xml:",chardata"`
+}
+
+// ToJUnit JUnit XML形式で出力（ファイルごとのtestsuite、ファイル内で違反したルールごとのtestcase）
+// 違反が無い場合は成功した1件のtestcaseを出力する（CIが結果を空と扱わないように）
+func (r *Report) ToJUnit() ([]byte, error) {
+	root := junitTestSuites{Name: "go-standards-checker"}
+	for _, f := range r.violationsByFile() {
+		suite := junitTestSuite{Name: f.Path}
+		for _, rule := range groupByRule(f.Violations) {
+			suite.Cases = append(suite.Cases, r.junitCase(f.Path, rule))
+		}
+		suite.Tests, suite.Failures = len(suite.Cases), len(suite.Cases)
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Suites = append(root.Suites, suite)
+	}
+	if len(root.Suites) == 0 {
+		root.Tests = 1
+		root.Suites = []junitTestSuite{{
+			Name:  "go-standards-checker",
+			Tests: 1,
+			Cases: []junitTestCase{{Name: "standards", ClassName: r.ProjectPath}},
+		}}
+	}
+	return marshalXML(root)
+}
+
+// junitCase 1ファイルの1ルールの違反のtestcase
+func (r *Report) junitCase(path string, violations []Violation) junitTestCase {
+	first := violations[0]
+	var text strings.Builder
+	severity := rules.SeverityInfo
+	for _, v := range violations {
+		fmt.Fprintf(&text, "%s:%d:%d: [%s] %s\n", path, v.Line, v.Column, v.Severity, v.Message)
+		if v.Suggestion != "" {
+			text.WriteString("    💡 " + v.Suggestion + "\n")
+		}
+		if v.Severity.Level() > severity.Level() {
+			severity = v.Severity
+		}
+	}
+	message := first.Message
+	if len(violations) > 1 {
+		message = fmt.Sprintf(r.localize("%s（ほか%d件）", "%s (and %d more)"), first.Message, len(violations)-1)
+	}
+	return junitTestCase{
+		Name:      first.Category + "/" + first.Rule,
+		ClassName: path,
+		File:      path,
+		Failure:   &junitFailure{Message: message, Type: string(severity), Text: text.String()},
+	}
+}
+
+// groupByRule 違反をルールごとにまとめる（ルール名順、ルール内は元の順）
+func groupByRule(violations []Violation) [][]Violation {
+	byRule := make(map[string][]Violation)
+	var names []string
+	for _, v := range violations {
+		if _, ok := byRule[v.Rule]; !ok {
+			names = append(names, v.Rule)
+		}
+		byRule[v.Rule] = append(byRule[v.Rule], v)
+	}
+	sort.Strings(names)
+	groups := make([][]Violation, 0, len(names))
+	for _, name := range names {
+		groups = append(groups, byRule[name])
+	}
+	return groups
+}
+
+// checkstyleReport Checkstyle XMLのルート
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+// checkstyleFile 1ファイルの違反
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+// checkstyleError 1件の違反（sourceは go-standards-checker.カテゴリ.ルール）
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// ToCheckstyle Checkstyle XML形式で出力（ファイルはルートからの相対パス）
+func (r *Report) ToCheckstyle() ([]byte, error) {
+	root := checkstyleReport{Version: "4.3"}
+	for _, f := range r.violationsByFile() {
+		file := checkstyleFile{Name: f.Path}
+		for _, v := range f.Violations {
+			file.Errors = append(file.Errors, checkstyleError{
+				Line:     v.Line,
+				Column:   v.Column,
+				Severity: string(v.Severity),
+				Message:  v.Message,
+				Source:   "go-standards-checker." + v.Category + "." + v.Rule,
+			})
+		}
+		root.Files = append(root.Files, file)
+	}
+	return marshalXML(root)
+}
+
+// marshalXML XML宣言付きでインデントしたXMLを作成
+func marshalXML(v any) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.Write(data)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,207 @@
+package rules
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ========================================
+// 設定の継承（extends）
+// ========================================
+
+// presetFS 組み込みのプリセット（presets/<名前>.yaml）
+//
+//go:embed presets/*.yaml
+var presetFS embed.FS
+
+// maxExtendsDepth extendsを辿る深さの上限
+const maxExtendsDepth = 10
+
+// fetchTimeout URLで指定した設定の取得のタイムアウト
+const fetchTimeout = 30 * time.Second
+
+// Presets 組み込みのプリセットの名前（名前順）
+func Presets() []string {
+	entries, _ := presetFS.ReadDir("presets")
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+	return names
+}
+
+// PresetSection 組み込みのプリセットのトップレベルの項目（例: naming）を、直前の見出しのコメントを含めて返す
+// 項目が無い場合はfalse
+func PresetSection(preset, key string) (string, bool) {
+	data, err := presetFS.ReadFile("presets/" + preset + ".yaml")
+	if err != nil {
+		return "", false
+	}
+	lines := strings.Split(string(data), "\n")
+
+	// トップレベルの項目ごとに、直前のコメント行から始まる範囲に分ける
+	var starts []int
+	index := -1
+	for i, line := range lines {
+		if line == "" || line[0] == ' ' || line[0] == '#' {
+			continue
+		}
+		start := i
+		for start > 0 && strings.HasPrefix(lines[start-1], "#") {
+			start--
+		}
+		if strings.HasPrefix(line, key+":") {
+			index = len(starts)
+		}
+		starts = append(starts, start)
+	}
+	if index < 0 {
+		return "", false
+	}
+	end := len(lines)
+	if index+1 < len(starts) {
+		end = starts[index+1]
+	}
+	return strings.TrimRight(strings.Join(lines[starts[index]:end], "\n"), "\n") + "\n", true
+}
+
+// isPreset 組み込みのプリセットの名前か
+func isPreset(ref string) bool {
+	return slices.Contains(Presets(), ref)
+}
+
+// isRemote URLで指定した設定か
+func isRemote(ref string) bool {
+	return strings.HasPrefix(ref, "https://") || strings.HasPrefix(ref, "http://")
+}
+
+// loadConfigNode 設定を読み込み、extendsで指定した設定に上書きしたYAMLのノードを返す
+// chainはここまでに辿った設定（循環の検出用）
+func loadConfigNode(source string, chain []string) (*yaml.Node, error) {
+	if slices.Contains(chain, source) {
+		return nil, fmt.Errorf("extends が循環しています: %s", strings.Join(append(chain, source), " -> "))
+	}
+	if len(chain) > maxExtendsDepth {
+		return nil, fmt.Errorf("extends の深さが上限（%d）を超えました: %s", maxExtendsDepth, source)
+	}
+	data, err := readConfigSource(source)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if len(doc.Content) > 0 {
+		node = doc.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: 設定はマッピングで記述してください", source)
+	}
+
+	ref := mappingValue(node, "extends")
+	if ref == nil || ref.Value == "" {
+		return node, nil
+	}
+	base, err := loadConfigNode(resolveExtends(source, ref.Value), append(chain, source))
+	if err != nil {
+		return nil, err
+	}
+	return mergeNodes(base, node), nil
+}
+
+// readConfigSource プリセット名・URL・ファイルパスで指定した設定の内容を読み込む
+func readConfigSource(source string) ([]byte, error) {
+	switch {
+	case isPreset(source):
+		return presetFS.ReadFile("presets/" + source + ".yaml")
+	case isRemote(source):
+		return fetchConfig(source)
+	}
+	return os.ReadFile(source)
+}
+
+// fetchConfig URLで指定した設定を取得する
+func fetchConfig(rawURL string) ([]byte, error) {
+	client := &http.Client{Timeout: fetchTimeout}
+	resp, err := client.Get(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", rawURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// resolveExtends extendsの値を、それを記述した設定（from）を基準に解決する
+// 相対パスはファイルの場合はそのディレクトリ、URLの場合はそのURLからの相対とする
+func resolveExtends(from, ref string) string {
+	if isPreset(ref) || isRemote(ref) || filepath.IsAbs(ref) {
+		return ref
+	}
+	if isRemote(from) {
+		base, err := url.Parse(from)
+		if err != nil {
+			return ref
+		}
+		rel, err := url.Parse(filepath.ToSlash(ref))
+		if err != nil {
+			return ref
+		}
+		return base.ResolveReference(rel).String()
+	}
+	if isPreset(from) {
+		return ref
+	}
+	return filepath.Join(filepath.Dir(from), ref)
+}
+
+// mergeNodes baseにoverを上書きしたノードを返す
+// マッピングはキーごとに再帰的にマージし、それ以外（スカラー・シーケンス）はoverで置き換える
+func mergeNodes(base, over *yaml.Node) *yaml.Node {
+	if base.Kind != yaml.MappingNode || over.Kind != yaml.MappingNode {
+		return over
+	}
+	merged := *base
+	merged.Content = slices.Clone(base.Content)
+	for i := 0; i+1 < len(over.Content); i += 2 {
+		key, value := over.Content[i], over.Content[i+1]
+		if j := mappingIndex(&merged, key.Value); j >= 0 {
+			merged.Content[j+1] = mergeNodes(merged.Content[j+1], value)
+			continue
+		}
+		merged.Content = append(merged.Content, key, value)
+	}
+	return &merged
+}
+
+// mappingIndex マッピングのキーの位置（無ければ-1）
+func mappingIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// mappingValue マッピングのキーに対応する値（無ければnil）
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if i := mappingIndex(node, key); i >= 0 {
+		return node.Content[i+1]
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ========================================
+// パスごとの設定の上書き（overrides）
+// ========================================
+
+// Override パスに一致するファイルに適用するルール設定の上書き
+//
+//	overrides:
+//	  - paths: ["internal/generated/**"]
+//	    rules:
+//	      max_function_lines: {enabled: false}
+//	  - paths: ["internal/handler/**"]
+//	    rules:
+//	      no_fmt_println: {severity: "error"}
+type Override struct {
+	Paths []string             `yaml:"paths"` // 対象のファイル（ファイル名・ルートからの相対パス・importパスのglob、** 対応）
+	Rules map[string]yaml.Node `yaml:"rules"` // ルール名（またはカテゴリ名）→上書きする項目（指定しない項目は元の設定のまま）
+}
+
+// WithOverrides overridesを順に適用した設定のコピーを返す（元の設定は変更しない）
+// キーがカテゴリ名の場合はカテゴリの設定（enabled等）、組み込みルール以外の名前はrule_settingsを上書きする
+func (c *Config) WithOverrides(overrides []Override) (*Config, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var derived Config
+	if err := yaml.Unmarshal(data, &derived); err != nil {
+		return nil, err
+	}
+	for _, o := range overrides {
+		for name, node := range o.Rules {
+			if err := derived.override(name, node); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	if err := derived.Compile(); err != nil {
+		return nil, err
+	}
+	return &derived, nil
+}
+
+// override カテゴリ名・ルール名の設定に上書きする項目を適用する
+func (c *Config) override(name string, node yaml.Node) error {
+	cfg := reflect.ValueOf(c).Elem()
+	if field, ok := fieldByYAMLName(cfg, name); ok && field.Kind() == reflect.Struct && field.FieldByName("Rules").IsValid() {
+		return node.Decode(field.Addr().Interface())
+	}
+	for i := 0; i < cfg.NumField(); i++ {
+		if cfg.Field(i).Kind() != reflect.Struct {
+			continue
+		}
+		if ruleField, ok := fieldByYAMLName(cfg.Field(i).FieldByName("Rules"), name); ok {
+			return node.Decode(ruleField.Addr().Interface())
+		}
+	}
+
+	// 組み込み以外のルール（設定に無い場合は有効な状態から上書きする）
+	setting, ok := c.RuleSettings[name]
+	if !ok {
+		setting = BaseRule{Enabled: true}
+	}
+	if err := node.Decode(&setting); err != nil {
+		return err
+	}
+	if c.RuleSettings == nil {
+		c.RuleSettings = make(map[string]BaseRule)
+	}
+	c.RuleSettings[name] = setting
+	return nil
+}
@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ========================================
+// 設定中の正規表現
+// ========================================
+
+// Patterns 設定中の正規表現をコンパイルしたもの
+// チェック中に繰り返しコンパイルしないよう、設定の読み込み時に1回だけ作成する
+type Patterns struct {
+	PackageName     *regexp.Regexp   // naming.rules.package_name.pattern
+	FileName        *regexp.Regexp   // naming.rules.file_name.pattern
+	ErrorVar        *regexp.Regexp   // naming.rules.error_var.pattern
+	AllowedErrors   []*regexp.Regexp // error_handling.rules.no_ignored_errors.allowed_patterns
+	TaintSources    []*regexp.Regexp // security.rules.command_injection.taint_sources（空の場合は組み込みの既定値を使う）
+	GeneratedMarker *regexp.Regexp   // settings.generated_marker（空の場合はnil）
+	TodoIssue       *regexp.Regexp   // comments.rules.todo.issue_pattern（空の場合はnil）
+	TodoKeyword     *regexp.Regexp   // comments.rules.todo.keywords の行頭のキーワード・括弧内・本文
+	TestFuncName    *regexp.Regexp   // testing.rules.test_func_name.pattern（空の場合はnil）
+	CustomRules     []*regexp.Regexp // custom_rules[i].pattern（CustomRulesと同じ順）
+	CustomAny       *regexp.Regexp   // 有効なカスタムルールのパターンを連結したもの（2件以上の場合）
+	ProjectFuncs    []*regexp.Regexp // project_rules[i].func_pattern（ProjectRulesと同じ順、空の場合はnil）
+}
+
+// Compile 設定中の正規表現をコンパイルし、不正なパターンがあればすべてをまとめたエラーを返す
+//
+// LoadConfigは読み込み時に呼び出す。読み込み後にプログラムからパターンを変更した場合は再度呼び出す
+func (c *Config) Compile() error {
+	p, err := c.compilePatterns()
+	if err != nil {
+		return err
+	}
+	c.patterns = p
+	return nil
+}
+
+// Patterns コンパイル済みの正規表現（未コンパイルの場合はコンパイルする）
+func (c *Config) Patterns() (*Patterns, error) {
+	if c.patterns != nil {
+		return c.patterns, nil
+	}
+	return c.compilePatterns()
+}
+
+// compilePatterns 設定中の正規表現をコンパイル
+func (c *Config) compilePatterns() (*Patterns, error) {
+	var errs []error
+	compile := func(key, pattern string) *regexp.Regexp {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+		return re
+	}
+	compileOptional := func(key, pattern string) *regexp.Regexp {
+		if pattern == "" {
+			return nil
+		}
+		return compile(key, pattern)
+	}
+	compileAll := func(key string, patterns []string) []*regexp.Regexp {
+		list := make([]*regexp.Regexp, 0, len(patterns))
+		for i, pattern := range patterns {
+			if re := compile(fmt.Sprintf("%s[%d]", key, i), pattern); re != nil {
+				list = append(list, re)
+			}
+		}
+		return list
+	}
+
+	p := &Patterns{}
+	naming := c.Naming.Rules
+	p.PackageName = compile("naming.rules.package_name.pattern", naming.PackageName.Pattern)
+	p.FileName = compile("naming.rules.file_name.pattern", naming.FileName.Pattern)
+	p.ErrorVar = compile("naming.rules.error_var.pattern", naming.ErrorVar.Pattern)
+	p.AllowedErrors = compileAll("error_handling.rules.no_ignored_errors.allowed_patterns",
+		c.ErrorHandling.Rules.NoIgnoredErrors.AllowedPatterns)
+	p.TaintSources = compileAll("security.rules.command_injection.taint_sources",
+		c.Security.Rules.CommandInjection.TaintSources)
+	p.GeneratedMarker = compileOptional("settings.generated_marker", c.Settings.GeneratedMarker)
+	p.TodoIssue = compileOptional("comments.rules.todo.issue_pattern", c.Comments.Rules.Todo.IssuePattern)
+	p.TodoKeyword = todoKeywordPattern(c.Comments.Rules.Todo.Keywords)
+	p.TestFuncName = compileOptional("testing.rules.test_func_name.pattern", c.Testing.Rules.TestFuncName.Pattern)
+
+	errs = append(errs, p.compileCustomRules(c.CustomRules)...)
+	for i, rule := range c.ProjectRules {
+		key := fmt.Sprintf("project_rules[%d] (%s).func_pattern", i, rule.Name)
+		p.ProjectFuncs = append(p.ProjectFuncs, compileOptional(key, rule.FuncPattern))
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid pattern: %w", errors.Join(errs...))
+	}
+	return p, nil
+}
+
+// defaultTodoKeywords TODOとして扱うキーワード（comments.rules.todo.keywords が空の場合）
+var defaultTodoKeywords = []string{"TODO", "FIXME"}
+
+// todoKeywordPattern キーワード・括弧内（担当者またはチケット番号）・本文を取り出す正規表現
+// キーワードはメタ文字をエスケープするため、コンパイルに失敗しない
+func todoKeywordPattern(keywords []string) *regexp.Regexp {
+	if len(keywords) == 0 {
+		keywords = defaultTodoKeywords
+	}
+	quoted := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		quoted[i] = regexp.QuoteMeta(keyword)
+	}
+	return regexp.MustCompile(`^(` + strings.Join(quoted, "|") + `)(?:\(([^)]*)\))?(?::|\s|$)\s*(.*)$`)
+}
+
+// compileCustomRules カスタムルールのパターンと、有効なルールのパターンを連結した正規表現をコンパイル
+// 連結した正規表現は、どのルールにも一致しない行を1回の照合で除外するために使う
+func (p *Patterns) compileCustomRules(customRules []CustomRule) []error {
+	var errs []error
+	var alts []string
+	for i, rule := range customRules {
+		re, err := rule.Compile()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("custom_rules[%d] (%s).pattern: %w", i, rule.Name, err))
+		}
+		p.CustomRules = append(p.CustomRules, re)
+		if re != nil && rule.Enabled {
+			alts = append(alts, "(?:"+rule.Pattern+")")
+		}
+	}
+	if len(alts) > 1 {
+		p.CustomAny = regexp.MustCompile(strings.Join(alts, "|"))
+	}
+	return errs
+}
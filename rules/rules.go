@@ -1,25 +1,39 @@
 package rules
 
 import (
-	"os"
+	"reflect"
 	"regexp"
-
-	"gopkg.in/yaml.v3"
+	"strings"
 )
 
 // Config 全体設定
 type Config struct {
-	Settings      Settings           `yaml:"settings"`
-	Naming        NamingConfig       `yaml:"naming"`
-	Structure     StructureConfig    `yaml:"structure"`
+	Extends       string              `yaml:"extends"` // 継承元の設定（プリセット名・ファイルパス・URL。LoadConfigで解決する）
+	Settings      Settings            `yaml:"settings"`
+	Naming        NamingConfig        `yaml:"naming"`
+	Structure     StructureConfig     `yaml:"structure"`
 	ErrorHandling ErrorHandlingConfig `yaml:"error_handling"`
-	Logging       LoggingConfig      `yaml:"logging"`
-	Architecture  ArchitectureConfig `yaml:"architecture"`
-	Directory     DirectoryConfig    `yaml:"directory"`
-	StructTags    StructTagsConfig   `yaml:"struct_tags"`
-	AWSLambda     AWSLambdaConfig    `yaml:"aws_lambda"`
-	CustomRules   []CustomRule       `yaml:"custom_rules"`
-	ProjectRules  []ProjectRule      `yaml:"project_rules"`
+	Logging       LoggingConfig       `yaml:"logging"`
+	Performance   PerformanceConfig   `yaml:"performance"`
+	Context       ContextConfig       `yaml:"context"`
+	Concurrency   ConcurrencyConfig   `yaml:"concurrency"`
+	Security      SecurityConfig      `yaml:"security"`
+	Architecture  ArchitectureConfig  `yaml:"architecture"`
+	Directory     DirectoryConfig     `yaml:"directory"`
+	StructTags    StructTagsConfig    `yaml:"struct_tags"`
+	AWSLambda     AWSLambdaConfig     `yaml:"aws_lambda"`
+	Testing       TestingConfig       `yaml:"testing"`
+	GRPC          GRPCConfig          `yaml:"grpc"`
+	Comments      CommentsConfig      `yaml:"comments"`
+	Documentation DocumentationConfig `yaml:"documentation"`
+	Interfaces    InterfacesConfig    `yaml:"interfaces"`
+	CustomRules   []CustomRule        `yaml:"custom_rules"`
+	ProjectRules  []ProjectRule       `yaml:"project_rules"`
+	RuleSettings  map[string]BaseRule `yaml:"rule_settings"` // 組み込み以外（組み込み先・プラグイン）のルールの設定
+	Plugins       []PluginConfig      `yaml:"plugins"`
+	Overrides     []Override          `yaml:"overrides"` // パスごとのルール設定の上書き（後の要素ほど優先）
+
+	patterns *Patterns // Compileでコンパイルした正規表現
 }
 
 // Settings 基本設定
@@ -28,6 +42,94 @@ type Settings struct {
 	ExcludePatterns []string `yaml:"exclude_patterns"`
 	ReportFormat    string   `yaml:"report_format"`
 	MinSeverity     string   `yaml:"min_severity"`
+	Typed           bool     `yaml:"typed"`            // 型情報付き解析（依存パッケージをソースから読み込むため低速）
+	Workers         int      `yaml:"workers"`          // 並行してチェックするファイル数（0の場合はCPU数）
+	FollowSymlinks  bool     `yaml:"follow_symlinks"`  // ルート外を指すシンボリックリンクのディレクトリを辿る
+	SkipHiddenDirs  bool     `yaml:"skip_hidden_dirs"` // "." で始まるディレクトリを走査しない
+	SkipTestdata    bool     `yaml:"skip_testdata"`    // testdataディレクトリを走査しない（goコマンドと同じ）
+	SkipGenerated   bool     `yaml:"skip_generated"`   // 自動生成ファイル（"// Code generated ... DO NOT EDIT."）をチェックしない
+	GeneratedMarker string   `yaml:"generated_marker"` // 自動生成ファイルを判定する正規表現（空の場合は標準のマーカー）
+	Owners          string   `yaml:"owners"`           // 違反の担当者の求め方（codeowners / blame、空の場合は求めない）
+	Baseline        string   `yaml:"baseline"`         // ベースラインファイル（記録済みの違反を報告しない、空の場合は使わない）
+	CacheDir        string   `yaml:"cache_dir"`        // 解析結果のキャッシュディレクトリ（空の場合は ~/.cache/go-standards-checker）
+	Language        string   `yaml:"language"`         // 違反のメッセージ・レポートの言語（ja / en、空の場合はja）
+
+	ReportUnusedSuppressions bool `yaml:"report_unused_suppressions"` // 違反を抑制しなかった //standards:ignore を報告する
+
+	ExitPolicy `yaml:",inline"`
+
+	Notifications []NotificationConfig `yaml:"notifications"`
+	Upload        UploadConfig         `yaml:"upload"`
+	Serve         ServeConfig          `yaml:"serve"`
+}
+
+// UploadConfig チェック後にレポートをアップロードする先
+//
+// URLのパスはtext/template形式で {{.Repo}} {{.Branch}} {{.Commit}} {{.ShortCommit}} {{.Timestamp}} {{.Format}} を参照できる
+type UploadConfig struct {
+	Enabled  bool              `yaml:"enabled"`
+	URL      string            `yaml:"url"`      // s3://bucket/key・gs://bucket/key・https://...（HTTPS PUT）
+	Format   string            `yaml:"format"`   // アップロードするレポートの形式（report_formatと同じ形式、デフォルト: json）
+	Headers  map[string]string `yaml:"headers"`  // HTTPS PUTの追加ヘッダー（認証等。${ENV} 形式で環境変数を参照可能）
+	Region   string            `yaml:"region"`   // S3のリージョン（デフォルト: AWS_REGION、未設定の場合はus-east-1）
+	Endpoint string            `yaml:"endpoint"` // S3互換ストレージのエンドポイント（例: https://minio.example.com）
+}
+
+// ServeConfig デーモンモード（-serve）の設定
+type ServeConfig struct {
+	HistoryDir string           `yaml:"history_dir"` // スケジュールごとの実行履歴の保存先（デフォルト: .gostandards-serve）
+	Schedules  []ScheduleConfig `yaml:"schedules"`
+}
+
+// ScheduleConfig 定期的に再チェックする対象
+type ScheduleConfig struct {
+	Name string `yaml:"name"` // APIで使う名前（英数字・-・_）
+	Path string `yaml:"path"` // チェック対象ディレクトリ
+	Cron string `yaml:"cron"` // cron形式（分 時 日 月 曜日）または @hourly・@daily・@weekly・@every <間隔>
+}
+
+// NotificationConfig 違反があった場合にWebhookへ送る通知の設定
+type NotificationConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	WebhookURL  string `yaml:"webhook_url"`  // ${ENV} 形式で環境変数を参照可能
+	MinSeverity string `yaml:"min_severity"` // この重要度以上の違反がある場合に通知（デフォルト: error）
+	Template    string `yaml:"template"`     // text/template形式のメッセージ（省略時は既定のサマリー）
+	MaxItems    int    `yaml:"max_items"`    // メッセージに含める違反の上限（デフォルト: 10）
+}
+
+// ExitPolicy 終了コードの判定基準
+// 重要度ごとに許容する件数を求め、超えた重要度があれば失敗とする
+type ExitPolicy struct {
+	FailOn      string `yaml:"fail_on"`      // この重要度以上の違反があれば失敗（error / warning / info / none、デフォルト: error）
+	MaxErrors   *int   `yaml:"max_errors"`   // 許容するエラーの件数（指定した場合はfail_onより優先）
+	MaxWarnings *int   `yaml:"max_warnings"` // 許容する警告の件数（指定した場合はfail_onより優先）
+}
+
+// ValidFailOn fail_onに指定できる値か
+func ValidFailOn(s string) bool {
+	switch s {
+	case "", "error", "warning", "info", "none":
+		return true
+	}
+	return false
+}
+
+// Allowance 重要度の違反を許容する件数（limitedがfalseの場合は無制限）
+func (p ExitPolicy) Allowance(s Severity) (allowed int, limited bool) {
+	switch {
+	case s == SeverityError && p.MaxErrors != nil:
+		return *p.MaxErrors, true
+	case s == SeverityWarning && p.MaxWarnings != nil:
+		return *p.MaxWarnings, true
+	}
+	failOn := p.FailOn
+	if failOn == "" {
+		failOn = "error"
+	}
+	if failOn == "none" || s.Level() < ParseSeverity(failOn).Level() {
+		return 0, false
+	}
+	return 0, true
 }
 
 // Severity 重要度
@@ -68,17 +170,26 @@ func (s Severity) Level() int {
 // ========================================
 
 type NamingConfig struct {
-	Enabled bool             `yaml:"enabled"`
+	Enabled bool              `yaml:"enabled"`
 	Rules   NamingRulesConfig `yaml:"rules"`
 }
 
 type NamingRulesConfig struct {
-	PackageName   PatternRule   `yaml:"package_name"`
-	ExportedNames BaseRule      `yaml:"exported_names"`
-	Acronyms      AcronymsRule  `yaml:"acronyms"`
-	FileName      PatternRule   `yaml:"file_name"`
-	InterfaceName SuffixRule    `yaml:"interface_name"`
-	ErrorVar      PatternRule   `yaml:"error_var"`
+	PackageName   PatternRule      `yaml:"package_name"`
+	ExportedNames BaseRule         `yaml:"exported_names"`
+	Acronyms      AcronymsRule     `yaml:"acronyms"`
+	FileName      PatternRule      `yaml:"file_name"`
+	InterfaceName SuffixRule       `yaml:"interface_name"`
+	ErrorVar      PatternRule      `yaml:"error_var"`
+	ReceiverName  ReceiverNameRule `yaml:"receiver_name"`
+	ReceiverType  BaseRule         `yaml:"receiver_type"`
+}
+
+// ReceiverNameRule メソッドのレシーバ名（短い名前・型ごとに統一・this/selfの禁止）
+type ReceiverNameRule struct {
+	BaseRule  `yaml:",inline"`
+	MaxLength int      `yaml:"max_length"` // レシーバ名の最大文字数（0の場合は2）
+	Forbidden []string `yaml:"forbidden"`  // 使用しない名前（例: this, self）
 }
 
 type BaseRule struct {
@@ -107,15 +218,41 @@ type SuffixRule struct {
 // ========================================
 
 type StructureConfig struct {
-	Enabled bool               `yaml:"enabled"`
+	Enabled bool                 `yaml:"enabled"`
 	Rules   StructureRulesConfig `yaml:"rules"`
 }
 
 type StructureRulesConfig struct {
-	MaxFunctionLines LimitRule `yaml:"max_function_lines"`
-	MaxNestingLevel  LimitRule `yaml:"max_nesting_level"`
-	MaxParameters    LimitRule `yaml:"max_parameters"`
-	MaxReturnValues  LimitRule `yaml:"max_return_values"`
+	MaxFunctionLines LimitRule           `yaml:"max_function_lines"`
+	MaxNestingLevel  LimitRule           `yaml:"max_nesting_level"`
+	MaxParameters    LimitRule           `yaml:"max_parameters"`
+	MaxReturnValues  LimitRule           `yaml:"max_return_values"`
+	MaxCyclomatic    LimitRule           `yaml:"max_cyclomatic_complexity"`
+	MaxFileLines     LimitRule           `yaml:"max_file_lines"`
+	NoGlobalVars     NoGlobalVarsRule    `yaml:"no_global_vars"`
+	NoInitFunc       AllowedInRule       `yaml:"no_init_func"`
+	NoMagicNumbers   NoMagicNumbersRule  `yaml:"no_magic_numbers"`
+	NoHardcodedURLs  NoHardcodedURLsRule `yaml:"no_hardcoded_urls"`
+}
+
+// NoMagicNumbersRule 定数宣言以外で直接使われている数値リテラルを禁止するルール（allowed_inは対象外のファイル）
+type NoMagicNumbersRule struct {
+	AllowedInRule  `yaml:",inline"`
+	AllowedNumbers []string `yaml:"allowed_numbers"` // 許可する数値（例: 0, 1, -1）
+}
+
+// NoHardcodedURLsRule 定数宣言以外の文字列リテラルのhttp(s)のURLを禁止するルール（allowed_inは対象外のファイル）
+type NoHardcodedURLsRule struct {
+	AllowedInRule `yaml:",inline"`
+	AllowedHosts  []string `yaml:"allowed_hosts"` // 許可するホストのglob（例: localhost、*.example.com）
+}
+
+// NoGlobalVarsRule パッケージレベルの変更可能な変数を禁止するルール（allowed_inは対象外のファイル）
+type NoGlobalVarsRule struct {
+	AllowedInRule `yaml:",inline"`
+	ExportedOnly  bool     `yaml:"exported_only"` // 公開された変数のみを報告する
+	AllowedNames  []string `yaml:"allowed_names"` // 許可する変数名のglob（例: Err*、*Config）
+	AllowedCalls  []string `yaml:"allowed_calls"` // 変更しない値を作る初期化の呼び出し（例: errors.New、regexp.MustCompile）
 }
 
 type LimitRule struct {
@@ -128,14 +265,23 @@ type LimitRule struct {
 // ========================================
 
 type ErrorHandlingConfig struct {
-	Enabled bool                    `yaml:"enabled"`
+	Enabled bool                     `yaml:"enabled"`
 	Rules   ErrorHandlingRulesConfig `yaml:"rules"`
 }
 
 type ErrorHandlingRulesConfig struct {
-	NoIgnoredErrors IgnoredErrorsRule `yaml:"no_ignored_errors"`
-	ErrorWrapping   BaseRule          `yaml:"error_wrapping"`
-	NoPanic         AllowedInRule     `yaml:"no_panic"`
+	NoIgnoredErrors  IgnoredErrorsRule `yaml:"no_ignored_errors"`
+	ErrorWrapping    BaseRule          `yaml:"error_wrapping"`
+	NoPanic          NoPanicRule       `yaml:"no_panic"`
+	ErrorConstructor BaseRule          `yaml:"error_constructor"`
+	WrapContext      BaseRule          `yaml:"wrap_context"`
+	HTTPErrorResp    HTTPErrorRespRule `yaml:"http_error_response"`
+	IteratorErr      BaseRule          `yaml:"iterator_err"`
+}
+
+type HTTPErrorRespRule struct {
+	BaseRule `yaml:",inline"`
+	Helpers  []string `yaml:"helpers"`
 }
 
 type IgnoredErrorsRule struct {
@@ -143,23 +289,213 @@ type IgnoredErrorsRule struct {
 	AllowedPatterns []string `yaml:"allowed_patterns"`
 }
 
+// AllowedInRule 許可対象をglobで指定するルール
+// パターンはファイル名・ルートからの相対パス・パッケージのimportパスと照合し、**で任意階層にマッチする
 type AllowedInRule struct {
 	BaseRule  `yaml:",inline"`
 	AllowedIn []string `yaml:"allowed_in"`
 }
 
+type NoPanicRule struct {
+	AllowedInRule    `yaml:",inline"`
+	AllowedFunctions []string `yaml:"allowed_functions"`
+}
+
 // ========================================
 // ログ設定
 // ========================================
 
 type LoggingConfig struct {
-	Enabled bool              `yaml:"enabled"`
+	Enabled bool               `yaml:"enabled"`
 	Rules   LoggingRulesConfig `yaml:"rules"`
 }
 
 type LoggingRulesConfig struct {
-	NoStdLog     BaseRule `yaml:"no_std_log"`
-	NoFmtPrintln BaseRule `yaml:"no_fmt_println"`
+	NoStdLog       NoStdLogRule      `yaml:"no_std_log"`
+	NoFmtPrintln   NoFmtPrintlnRule  `yaml:"no_fmt_println"`
+	NoBuiltinPrint BaseRule          `yaml:"no_builtin_print"`
+	FieldKeys      StyleRule         `yaml:"log_field_keys"`
+	SensitiveData  SensitiveDataRule `yaml:"sensitive_data"`
+	ContextLogger  ContextLoggerRule `yaml:"context_logger"`
+	NoStdWrite     AllowedInRule     `yaml:"no_std_write"`
+	MixedLoggers   MixedLoggersRule  `yaml:"mixed_loggers"`
+}
+
+type NoStdLogRule struct {
+	BaseRule        `yaml:",inline"`
+	AllowedIn       []string `yaml:"allowed_in"`       // 標準logを許可するファイル・パッケージ（ファイル名・相対パス・importパスのglob）
+	ApprovedLoggers []string `yaml:"approved_loggers"` // 使用を認めるロギングライブラリ（zerolog・zap・slog等の名前またはimportパス、空の場合は標準logのみ報告）
+}
+
+type NoFmtPrintlnRule struct {
+	BaseRule          `yaml:",inline"`
+	Replacement       string `yaml:"replacement"`        // -fix で置き換えるロガーの呼び出し（例: slog.Info、空の場合は修正しない）
+	ReplacementImport string `yaml:"replacement_import"` // 置き換え後に必要なimport（例: log/slog）
+}
+
+type MixedLoggersRule struct {
+	BaseRule  `yaml:",inline"`
+	Canonical string `yaml:"canonical"` // 空の場合は最も多く使われているライブラリ
+}
+
+type SensitiveDataRule struct {
+	BaseRule `yaml:",inline"`
+	Patterns []string `yaml:"patterns"`
+}
+
+type ContextLoggerRule struct {
+	BaseRule  `yaml:",inline"`
+	Libraries []ContextLoggerLibrary `yaml:"libraries"`
+}
+
+// ContextLoggerLibrary ロギングライブラリごとのcontext非対応呼び出しと推奨呼び出し
+type ContextLoggerLibrary struct {
+	Name       string   `yaml:"name"`
+	Calls      []string `yaml:"calls"`
+	Suggestion string   `yaml:"suggestion"`
+}
+
+type StyleRule struct {
+	BaseRule `yaml:",inline"`
+	Style    string `yaml:"style"`
+}
+
+// ========================================
+// パフォーマンス設定
+// ========================================
+
+type PerformanceConfig struct {
+	Enabled bool                   `yaml:"enabled"`
+	Rules   PerformanceRulesConfig `yaml:"rules"`
+}
+
+type PerformanceRulesConfig struct {
+	SprintfConcat BaseRule `yaml:"sprintf_concat"`
+}
+
+// ========================================
+// context.Context設定
+// ========================================
+
+type ContextConfig struct {
+	Enabled bool               `yaml:"enabled"`
+	Rules   ContextRulesConfig `yaml:"rules"`
+}
+
+type ContextRulesConfig struct {
+	FirstParam ContextParamRule      `yaml:"context_first_param"`
+	InStruct   AllowedInRule         `yaml:"context_in_struct"`
+	Background ContextBackgroundRule `yaml:"context_background"`
+	TypedKey   BaseRule              `yaml:"context_key_type"`
+}
+
+type ContextParamRule struct {
+	BaseRule  `yaml:",inline"`
+	RequireIn []string `yaml:"require_in"` // 公開関数・メソッドにcontextを要求するパッケージ（glob）
+}
+
+type ContextBackgroundRule struct {
+	AllowedInRule `yaml:",inline"`
+	OutsideMain   bool `yaml:"outside_main"` // ctxが無い関数でも、mainパッケージ・テスト以外での使用を報告する
+}
+
+// ========================================
+// 並行処理設定
+// ========================================
+
+type ConcurrencyConfig struct {
+	Enabled bool                   `yaml:"enabled"`
+	Rules   ConcurrencyRulesConfig `yaml:"rules"`
+}
+
+type ConcurrencyRulesConfig struct {
+	GoroutineLeak       GoroutineLeakRule `yaml:"goroutine_leak"`
+	LockCopy            BaseRule          `yaml:"lock_copy"` // 代入・引数渡しのコピーは型情報が必要（-typed）
+	UnboundedGoroutines HelpersRule       `yaml:"unbounded_goroutines"`
+	SleepRetry          AllowedInRule     `yaml:"sleep_retry"`
+	ChannelOwnership    BaseRule          `yaml:"channel_ownership"`
+	SharedMap           BaseRule          `yaml:"shared_map"`
+	TickInLoop          AllowedInRule     `yaml:"tick_in_loop"`
+	SelectSendTimeout   AllowedInRule     `yaml:"select_send_timeout"`
+}
+
+// GoroutineLeakRule 終了手段を持たないgoroutineのルール
+type GoroutineLeakRule struct {
+	HelpersRule `yaml:",inline"`
+	IncludeMain bool `yaml:"include_main"` // mainパッケージも対象にする（デフォルトはmain以外のパッケージのみ）
+}
+
+// HelpersRule 許可するヘルパー関数（pkg.Func または関数・メソッド名）を指定するルール
+type HelpersRule struct {
+	BaseRule `yaml:",inline"`
+	Helpers  []string `yaml:"helpers"`
+}
+
+// ========================================
+// セキュリティ設定
+// ========================================
+
+type SecurityConfig struct {
+	Enabled bool                `yaml:"enabled"`
+	Rules   SecurityRulesConfig `yaml:"rules"`
+}
+
+type SecurityRulesConfig struct {
+	SQLInjection      SQLInjectionRule     `yaml:"sql_injection"`
+	CommandInjection  CommandInjectionRule `yaml:"command_injection"`
+	InsecureTLS       InsecureTLSRule      `yaml:"insecure_tls"`
+	WeakRandom        WeakRandomRule       `yaml:"weak_random"`
+	HardcodedSecrets  HardcodedSecretsRule `yaml:"hardcoded_secrets"`
+	FilePermissions   FilePermissionsRule  `yaml:"file_permissions"`
+	DefaultHTTPClient AllowedInRule        `yaml:"default_http_client"`
+	MissingTimeout    MissingTimeoutRule   `yaml:"missing_timeout"`
+}
+
+type SQLInjectionRule struct {
+	BaseRule `yaml:",inline"`
+	Methods  []string `yaml:"methods"` // 空の場合はdatabase/sql・sqlx・gormの標準的なメソッド
+}
+
+type CommandInjectionRule struct {
+	BaseRule     `yaml:",inline"`
+	TaintSources []string `yaml:"taint_sources"` // ユーザー入力とみなす識別子名の正規表現
+}
+
+type InsecureTLSRule struct {
+	BaseRule     `yaml:",inline"`
+	MinVersion   string   `yaml:"min_version"`   // "1.2"（デフォルト）または "1.3"
+	AllowedHosts []string `yaml:"allowed_hosts"` // http:// を許可するホスト（空の場合はlocalhostのみ）
+}
+
+type WeakRandomRule struct {
+	BaseRule `yaml:",inline"`
+	Patterns []string `yaml:"patterns"` // 秘密情報を示す関数名・識別子名（部分一致、大文字小文字・区切り文字は無視）
+}
+
+type HardcodedSecretsRule struct {
+	BaseRule   `yaml:",inline"`
+	Patterns   []string `yaml:"patterns"`    // 秘密情報を示すconst/var/フィールド名（部分一致）
+	MinLength  int      `yaml:"min_length"`  // 検出する値の最小長（デフォルト: 8）
+	MinEntropy float64  `yaml:"min_entropy"` // 検出する値の最小エントロピー（ビット/文字、デフォルト: 3.0）
+	AllowedIn  []string `yaml:"allowed_in"`  // テストフィクスチャ等、検出対象外のファイル
+
+	// 名前に関わらず検出する高エントロピーな文字列（英数字と+/=_-のみで、英字と数字を含む値）
+	EntropyThreshold float64 `yaml:"entropy_threshold"`  // 最小エントロピー（ビット/文字、0の場合は検出しない）
+	EntropyMinLength int     `yaml:"entropy_min_length"` // 最小長（デフォルト: 20）
+
+	AllowlistFile string `yaml:"allowlist_file"` // 誤検出として許可する値の一覧（sha256:<指紋> または regex:<正規表現>）
+}
+
+type MissingTimeoutRule struct {
+	BaseRule       `yaml:",inline"`
+	ServerTimeouts []string `yaml:"server_timeouts"` // http.Serverに必須のフィールド（デフォルト: ReadTimeout, WriteTimeout）
+	OutboundCalls  []string `yaml:"outbound_calls"`  // ハンドラ内の外部呼び出し（pkg.Func またはメソッド名）
+}
+
+type FilePermissionsRule struct {
+	BaseRule    `yaml:",inline"`
+	MaxFileMode string `yaml:"max_file_mode"` // ファイルの上限（8進数、デフォルト: "0644"）
+	MaxDirMode  string `yaml:"max_dir_mode"`  // ディレクトリ・Chmodの上限（8進数、デフォルト: "0755"）
 }
 
 // ========================================
@@ -173,6 +509,13 @@ type ArchitectureConfig struct {
 
 type ArchitectureRulesConfig struct {
 	LayerDependencies LayerDependenciesRule `yaml:"layer_dependencies"`
+	ClockInjection    ClockInjectionRule    `yaml:"clock_injection"`
+}
+
+// ClockInjectionRule 対象パッケージでのtime.Now等の直接の使用を禁止するルール（allowed_inは対象外のファイル・パッケージ）
+type ClockInjectionRule struct {
+	AllowedInRule `yaml:",inline"`
+	Packages      []string `yaml:"packages"` // 対象のパッケージ（globパターン、例: **/service/**）
 }
 
 type LayerDependenciesRule struct {
@@ -182,6 +525,7 @@ type LayerDependenciesRule struct {
 
 type LayerRule struct {
 	Name         string   `yaml:"name"`
+	Paths        []string `yaml:"paths"` // レイヤーに属するパッケージ（モジュールからの相対パスのglobパターン、空の場合はレイヤー名と同じ名前のディレクトリ）
 	CanImport    []string `yaml:"can_import"`
 	CannotImport []string `yaml:"cannot_import"`
 }
@@ -215,8 +559,18 @@ type StructTagsConfig struct {
 }
 
 type StructTagsRulesConfig struct {
-	JSONTag       JSONTagRule       `yaml:"json_tag"`
-	ValidationTag ValidationTagRule `yaml:"validation_tag"`
+	JSONTag        JSONTagRule        `yaml:"json_tag"`
+	ValidationTag  ValidationTagRule  `yaml:"validation_tag"`
+	TagStyle       TagStyleRule       `yaml:"tag_style"`
+	ConfigTag      ConfigTagRule      `yaml:"config_tag"`
+	ValidateSyntax ValidateSyntaxRule `yaml:"validate_syntax"`
+	TagFieldName   TagFieldNameRule   `yaml:"tag_field_name"`
+	DTOJSONTag     ValidationTagRule  `yaml:"dto_json_tag"` // required_forに一致する構造体の公開フィールドにjsonタグを要求
+	DuplicateTag   DuplicateTagRule   `yaml:"duplicate_tag"`
+	TagFormat      TagFormatRule      `yaml:"tag_format"`
+	ValidationCall ValidationCallRule `yaml:"validation_call"`
+	RequiredTags   RequiredTagsRule   `yaml:"required_tags"`
+	Omitempty      OmitemptyRule      `yaml:"omitempty"`
 }
 
 type JSONTagRule struct {
@@ -229,6 +583,81 @@ type ValidationTagRule struct {
 	RequiredFor []string `yaml:"required_for"`
 }
 
+// ValidationCallRule デコードしたリクエスト構造体のバリデーションの呼び出しを要求するルール
+type ValidationCallRule struct {
+	BaseRule    `yaml:",inline"`
+	RequiredFor []string `yaml:"required_for"` // 対象の構造体名のパターン（例: *Request）
+	Decoders    []string `yaml:"decoders"`     // デコードする関数・メソッド名（デフォルト: Unmarshal, Decode, Bind, BindJSON, BodyParser）
+	Validators  []string `yaml:"validators"`   // バリデーションの関数・メソッド名（デフォルト: Struct, StructCtx, Validate, ValidateStruct）
+}
+
+// TagFormatRule タグのリテラルの書式（バッククォート、半角スペース区切りの key:"value"、キーの順序）を検証するルール
+type TagFormatRule struct {
+	BaseRule `yaml:",inline"`
+	Order    []string `yaml:"order"` // キーの順序（含まれないキーは後ろに元の順序で並べる、デフォルト: json, yaml, validate）
+}
+
+// DuplicateTagRule 同じ構造体の複数のフィールドが同じタグの名前を持つことを禁止するルール
+type DuplicateTagRule struct {
+	BaseRule `yaml:",inline"`
+	Tags     []string `yaml:"tags"` // 対象のタグのキー（デフォルト: json, yaml, db, gorm, bson）
+}
+
+// TagFieldNameRule タグの名前がフィールド名を変換したものと一致するかを検証するルール
+type TagFieldNameRule struct {
+	BaseRule   `yaml:",inline"`
+	Tags       []string          `yaml:"tags"`       // 対象のタグのキー（デフォルト: json, yaml）
+	Transform  string            `yaml:"transform"`  // フィールド名の変換（snake_case, camelCase, kebab-case、デフォルト: snake_case）
+	Transforms map[string]string `yaml:"transforms"` // タグのキーごとの変換（例: mapstructure: camelCase、無いキーはtransform）
+	Allowed    []string          `yaml:"allowed"`    // 対象外のフィールド（Field または Struct.Field の形式、ワイルドカード可）
+}
+
+// RequiredTagsRule 構造体名のパターンごとに、公開フィールドに必要なタグを要求するルール
+type RequiredTagsRule struct {
+	BaseRule `yaml:",inline"`
+	Structs  []RequiredTags `yaml:"structs"`
+}
+
+// RequiredTags 構造体名のパターンと必要なタグのキー
+type RequiredTags struct {
+	RequiredFor []string `yaml:"required_for"` // 対象の構造体名のパターン（例: *Model）
+	Tags        []string `yaml:"tags"`         // 必要なタグのキー
+	AnyOf       bool     `yaml:"any_of"`       // trueの場合はいずれか1つのタグがあればよい（デフォルトはすべて）
+}
+
+// OmitemptyRule レスポンス等の構造体の省略可能なフィールドのjsonタグにomitemptyを要求するルール
+type OmitemptyRule struct {
+	BaseRule    `yaml:",inline"`
+	RequiredFor []string `yaml:"required_for"` // 対象の構造体名のパターン（例: *Response）
+	Types       []string `yaml:"types"`        // 省略可能とみなすフィールドの型（pointer, slice, map、デフォルト: すべて）
+}
+
+// ValidateSyntaxRule validateタグの構文（既知のバリデーターか、引数の形式が正しいか）を検証するルール
+type ValidateSyntaxRule struct {
+	BaseRule   `yaml:",inline"`
+	Validators []string `yaml:"validators"` // RegisterValidation・RegisterAliasで登録した独自のバリデーター
+}
+
+// ConfigTagRule 設定構造体の公開フィールドに設定の読み込み用タグ（mapstructure・env等）を要求するルール
+type ConfigTagRule struct {
+	BaseRule    `yaml:",inline"`
+	RequiredFor []string `yaml:"required_for"` // 対象の構造体名のパターン（例: *Config）
+	Tags        []string `yaml:"tags"`         // いずれかを要求するタグのキー（デフォルト: mapstructure, env）
+}
+
+// TagStyleRule json以外のタグ（yaml, db, gormのcolumn, bson等）の命名規則
+type TagStyleRule struct {
+	BaseRule `yaml:",inline"`
+	Tags     []TagStyle `yaml:"tags"`
+}
+
+// TagStyle タグのキーごとの命名規則
+type TagStyle struct {
+	Key      string `yaml:"key"`      // タグのキー（gormの場合は column: の値を対象にする）
+	Style    string `yaml:"style"`    // snake_case, camelCase, kebab-case
+	Severity string `yaml:"severity"` // 重要度（省略時はルールの重要度）
+}
+
 // ========================================
 // AWS Lambda設定
 // ========================================
@@ -236,12 +665,174 @@ type ValidationTagRule struct {
 type AWSLambdaConfig struct {
 	Enabled bool                 `yaml:"enabled"`
 	Rules   AWSLambdaRulesConfig `yaml:"rules"`
+	// HandlerWrappers lambda.Startと同様にハンドラを受け取る関数（"pkg.Func" 形式、第1引数をハンドラとみなす）
+	HandlerWrappers []string `yaml:"handler_wrappers"`
 }
 
 type AWSLambdaRulesConfig struct {
-	InitAWSClients     BaseRule `yaml:"init_aws_clients"`
-	ContextPropagation BaseRule `yaml:"context_propagation"`
-	SQSBatchFailures   BaseRule `yaml:"sqs_batch_failures"`
+	InitAWSClients     BaseRule      `yaml:"init_aws_clients"`
+	ContextPropagation BaseRule      `yaml:"context_propagation"`
+	SQSBatchFailures   BaseRule      `yaml:"sqs_batch_failures"`
+	EnvAccess          EnvAccessRule `yaml:"env_access"`
+}
+
+type EnvAccessRule struct {
+	BaseRule          `yaml:",inline"`
+	RequireValidation bool `yaml:"require_validation"` // 起動時に読み出した環境変数の空文字チェックを要求
+}
+
+// ========================================
+// テスト設定
+// ========================================
+
+type TestingConfig struct {
+	Enabled bool               `yaml:"enabled"`
+	Rules   TestingRulesConfig `yaml:"rules"`
+}
+
+type TestingRulesConfig struct {
+	TestFileExists TestFileExistsRule `yaml:"test_file_exists"`
+	THelper        THelperRule        `yaml:"t_helper"`
+	TableDriven    TableDrivenRule    `yaml:"table_driven"`
+	TestPackage    TestPackageRule    `yaml:"test_package"`
+	TestRatio      TestRatioRule      `yaml:"test_ratio"`
+	TestFuncName   TestFuncNameRule   `yaml:"test_func_name"`
+	TParallel      TParallelRule      `yaml:"t_parallel"`
+}
+
+// TestFuncNameRule テスト関数の名前（go testが実行する形式か、patternに一致するか）
+type TestFuncNameRule struct {
+	BaseRule `yaml:",inline"`
+	Pattern  string `yaml:"pattern"` // Test関数の名前の正規表現（空の場合はgo testが実行する形式かのみ）
+}
+
+// TParallelRule テスト関数でのt.Parallel()の呼び出しを要求するルール
+type TParallelRule struct {
+	BaseRule  `yaml:",inline"`
+	RequireIn []string `yaml:"require_in"` // 対象のテストファイル（ファイル名・相対パス・importパスのglob、空の場合はすべて）
+	Subtests  bool     `yaml:"subtests"`   // t.Runのサブテストにもt.Parallel()を要求する
+}
+
+// TestRatioRule パッケージごとの本番コードに対するテストコードの比率の下限（allowed_inは集計しない本番コード）
+type TestRatioRule struct {
+	AllowedInRule `yaml:",inline"`
+	Metric        string  `yaml:"metric"`    // lines（テストの行数 / 本番コードの行数）/ tests（本番コード100行あたりのTest関数の数）、デフォルト: lines
+	MinRatio      float64 `yaml:"min_ratio"` // 比率の下限（デフォルト: lines は 0.5、tests は 1）
+	MinLines      int     `yaml:"min_lines"` // 対象とする本番コードの最小行数（これ未満のパッケージは対象外、デフォルト: 100）
+}
+
+// TestPackageRule テストファイルのパッケージ（外部 x_test / 内部 x）の方針（allowed_inは対象外のファイル）
+type TestPackageRule struct {
+	AllowedInRule `yaml:",inline"`
+	Style         string `yaml:"style"` // external（公開APIのみを使うテストは x_test）/ internal（すべて x）、デフォルト: external
+}
+
+// TableDrivenRule 繰り返しの多いテストにテーブル駆動テストを勧めるルール
+type TableDrivenRule struct {
+	BaseRule        `yaml:",inline"`
+	MaxAssertions   int `yaml:"max_assertions"`    // 1つのテスト関数のアサーション（t.Error・t.Fatal・assert・require）の上限（デフォルト: 5）
+	MaxSimilarCalls int `yaml:"max_similar_calls"` // 同じ関数を呼び出す文の繰り返しの上限（デフォルト: 3）
+}
+
+// THelperRule テストヘルパー関数にt.Helper()を要求するルール
+type THelperRule struct {
+	BaseRule   `yaml:",inline"`
+	MinCallers int `yaml:"min_callers"` // ヘルパーとみなす呼び出し元の関数の最小数（デフォルト: 2）
+}
+
+// TestFileExistsRule テストファイルの存在を要求するルール（allowed_inはテストを要求しないファイル）
+type TestFileExistsRule struct {
+	AllowedInRule `yaml:",inline"`
+	Granularity   string `yaml:"granularity"`   // file（ファイルごとに <name>_test.go）/ package（パッケージに1つ以上の _test.go）、デフォルト: file
+	MinFunctions  int    `yaml:"min_functions"` // テストを要求する関数・メソッドの最小数（これ未満のファイルは対象外、デフォルト: 1）
+}
+
+// ========================================
+// gRPC設定
+// ========================================
+
+type GRPCConfig struct {
+	Enabled bool            `yaml:"enabled"`
+	Servers []string        `yaml:"servers"` // サーバー実装の型名のパターン（Unimplemented*Serverを埋め込む型は常に対象、デフォルト: *Server）
+	Rules   GRPCRulesConfig `yaml:"rules"`
+}
+
+type GRPCRulesConfig struct {
+	ServerContext BaseRule     `yaml:"server_context"`
+	StatusError   HelpersRule  `yaml:"status_error"`
+	ServerPanic   BaseRule     `yaml:"server_panic"`
+	PBEdited      PBEditedRule `yaml:"pb_edited"`
+}
+
+// PBEditedRule 生成された *.pb.go が編集されていないかを検証するルール
+type PBEditedRule struct {
+	BaseRule     `yaml:",inline"`
+	ChecksumFile string `yaml:"checksum_file"` // 生成直後の sha256sum の出力（<ハッシュ>  <パス>）を保存したファイル（ターゲットディレクトリからの相対パス）
+}
+
+// ========================================
+// コメント設定
+// ========================================
+
+type CommentsConfig struct {
+	Enabled bool                `yaml:"enabled"`
+	Rules   CommentsRulesConfig `yaml:"rules"`
+}
+
+type CommentsRulesConfig struct {
+	Todo TodoRule `yaml:"todo"`
+}
+
+// TodoRule TODO/FIXMEコメントの形式を検証し、バックログとしてレポートに集めるルール
+type TodoRule struct {
+	BaseRule     `yaml:",inline"`
+	Keywords     []string `yaml:"keywords"`      // 対象のキーワード（デフォルト: TODO, FIXME）
+	RequireOwner bool     `yaml:"require_owner"` // TODO(owner) の担当者を必須にする
+	RequireIssue bool     `yaml:"require_issue"` // チケット番号を必須にする
+	IssuePattern string   `yaml:"issue_pattern"` // チケット番号の形式（正規表現、デフォルト: [A-Z][A-Z0-9]+-[0-9]+）
+	IssueURL     string   `yaml:"issue_url"`     // チケットのURL（{issue} をチケット番号に置換、空の場合はリンクしない）
+}
+
+// ========================================
+// ドキュメントコメント設定
+// ========================================
+
+type DocumentationConfig struct {
+	Enabled bool                     `yaml:"enabled"`
+	Rules   DocumentationRulesConfig `yaml:"rules"`
+}
+
+type DocumentationRulesConfig struct {
+	ExportedDoc    AllowedInRule      `yaml:"exported_doc"`
+	DocPrefix      AllowedInRule      `yaml:"doc_prefix"`
+	PackageComment PackageCommentRule `yaml:"package_comment"`
+}
+
+// PackageCommentRule main以外のパッケージにパッケージコメントを求めるルール
+type PackageCommentRule struct {
+	AllowedInRule `yaml:",inline"`
+	RequireDocGo  bool `yaml:"require_doc_go"` // パッケージコメントをdoc.goに書くことを求める
+}
+
+// ========================================
+// インタフェース設定
+// ========================================
+
+type InterfacesConfig struct {
+	Enabled bool                  `yaml:"enabled"`
+	Rules   InterfacesRulesConfig `yaml:"rules"`
+}
+
+type InterfacesRulesConfig struct {
+	MaxMethods           MaxMethodsRule `yaml:"max_methods"`
+	SingleImplementation AllowedInRule  `yaml:"single_implementation"`
+	ParamNames           AllowedInRule  `yaml:"param_names"`
+}
+
+// MaxMethodsRule 公開されたインタフェースのメソッド数の上限
+type MaxMethodsRule struct {
+	AllowedInRule `yaml:",inline"`
+	Limit         int `yaml:"limit"` // メソッド数の上限（埋め込んだインタフェースのメソッドは数えない）
 }
 
 // ========================================
@@ -255,6 +846,16 @@ type CustomRule struct {
 	Pattern      string   `yaml:"pattern"`
 	Message      string   `yaml:"message"`
 	ExcludeFiles []string `yaml:"exclude_files"`
+	CodeOnly     bool     `yaml:"code_only"` // 文字列リテラル・コメント内は照合しない
+}
+
+// PluginConfig 外部ルールプラグインの設定
+type PluginConfig struct {
+	Name    string   `yaml:"name"`
+	Enabled bool     `yaml:"enabled"`
+	Type    string   `yaml:"type"` // go（Go pluginの.so） / process（JSON-RPCで通信する外部プロセス）
+	Path    string   `yaml:"path"` // .soファイルまたは実行ファイルのパス
+	Args    []string `yaml:"args"` // 外部プロセスの引数
 }
 
 // Compile パターンをコンパイル
@@ -263,13 +864,76 @@ func (r *CustomRule) Compile() (*regexp.Regexp, error) {
 }
 
 // ProjectRule プロジェクト固有ルール
+// 関数・識別子は importパス.名前（例: time.Sleep、github.com/pkg/errors.Wrap）、組み込み関数・同じパッケージの関数は名前のみで指定する
 type ProjectRule struct {
-	Name     string   `yaml:"name"`
-	Enabled  bool     `yaml:"enabled"`
-	Severity string   `yaml:"severity"`
-	Type     string   `yaml:"type"`
-	Packages []string `yaml:"packages"`
-	Message  string   `yaml:"message"`
+	Name        string   `yaml:"name"`
+	Enabled     bool     `yaml:"enabled"`
+	Severity    string   `yaml:"severity"`
+	Type        string   `yaml:"type"`         // forbidden_import（import_ban） / restricted_import / forbidden_call / forbidden_identifier / required_call_in
+	Packages    []string `yaml:"packages"`     // forbidden_import・restricted_import: 対象のimportパス（サブパッケージを含む）
+	Functions   []string `yaml:"functions"`    // forbidden_call: 禁止する関数、required_call_in: いずれかの呼び出しを必須とする関数
+	Identifiers []string `yaml:"identifiers"`  // forbidden_identifier: 参照を禁止する識別子
+	AllowedIn   []string `yaml:"allowed_in"`   // 対象外のファイル・パッケージ（restricted_importではimportを許可するファイル・パッケージ）
+	Paths       []string `yaml:"paths"`        // required_call_in: 対象のファイル・パッケージ（空の場合はすべて）
+	FuncPattern string   `yaml:"func_pattern"` // required_call_in: 呼び出しを必須とする関数名の正規表現（空の場合は公開された関数・メソッド）
+	Message     string   `yaml:"message"`
+}
+
+// ========================================
+// ルール設定の参照
+// ========================================
+
+// RuleSetting カテゴリ名・ルール名（YAMLのキー）からルールの共通設定を取得
+// 組み込みルールはカテゴリが無効であれば無効として返し、それ以外はrule_settingsを参照する
+// いずれにも無ければfoundはfalse
+func (c *Config) RuleSetting(category, name string) (setting BaseRule, found bool) {
+	cfg := reflect.ValueOf(c).Elem()
+	if field, ok := fieldByYAMLName(cfg, category); ok && field.Kind() == reflect.Struct {
+		enabled := field.FieldByName("Enabled")
+		if ruleField, ok := fieldByYAMLName(field.FieldByName("Rules"), name); ok {
+			if base, ok := findBaseRule(ruleField); ok {
+				base.Enabled = base.Enabled && enabled.IsValid() && enabled.Bool()
+				return base, true
+			}
+		}
+	}
+	if base, ok := c.RuleSettings[name]; ok {
+		return base, true
+	}
+	return BaseRule{}, false
+}
+
+// fieldByYAMLName 構造体からyamlタグ名が一致するフィールドを取得
+func fieldByYAMLName(v reflect.Value, name string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if tag == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// findBaseRule ルール設定に埋め込まれたBaseRuleを取得
+func findBaseRule(v reflect.Value) (BaseRule, bool) {
+	if base, ok := v.Interface().(BaseRule); ok {
+		return base, true
+	}
+	if v.Kind() != reflect.Struct {
+		return BaseRule{}, false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Anonymous {
+			if base, ok := findBaseRule(v.Field(i)); ok {
+				return base, true
+			}
+		}
+	}
+	return BaseRule{}, false
 }
 
 // ========================================
@@ -277,14 +941,21 @@ type ProjectRule struct {
 // ========================================
 
 // LoadConfig 設定ファイルを読み込む
+//
+// extendsを指定した場合は、継承元の設定（組み込みのプリセット・ファイル・URL）に上書きする
 func LoadConfig(path string) (*Config, error) {
-	data, err := os.ReadFile(path)
+	node, err := loadConfigNode(path, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	if err := node.Decode(&config); err != nil {
+		return nil, err
+	}
+
+	// 正規表現は読み込み時にコンパイルし、不正なパターンはここでエラーにする
+	if err := config.Compile(); err != nil {
 		return nil, err
 	}
 
@@ -295,8 +966,11 @@ func LoadConfig(path string) (*Config, error) {
 func DefaultConfig() *Config {
 	return &Config{
 		Settings: Settings{
-			ReportFormat: "text",
-			MinSeverity:  "info",
+			ReportFormat:   "text",
+			MinSeverity:    "info",
+			SkipHiddenDirs: true,
+			SkipTestdata:   true,
+			SkipGenerated:  true,
 			ExcludePatterns: []string{
 				"*_test.go",
 				"vendor/*",
@@ -327,13 +1001,18 @@ func DefaultConfig() *Config {
 					BaseRule: BaseRule{Enabled: true, Severity: "warning", Message: "ネストは3レベル以内"},
 					Limit:    3,
 				},
+				MaxCyclomatic: LimitRule{
+					BaseRule: BaseRule{Enabled: true, Severity: "warning", Message: "循環的複雑度は15以内"},
+					Limit:    15,
+				},
 			},
 		},
 		ErrorHandling: ErrorHandlingConfig{
 			Enabled: true,
 			Rules: ErrorHandlingRulesConfig{
 				NoIgnoredErrors: IgnoredErrorsRule{
-					BaseRule: BaseRule{Enabled: true, Severity: "error", Message: "エラーを無視しないでください"},
+					BaseRule:        BaseRule{Enabled: true, Severity: "error", Message: "エラーを無視しないでください"},
+					AllowedPatterns: []string{`^fmt\.F?[Pp]rint`}, // fmt.Println・fmt.Fprintf等
 				},
 			},
 		},
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/report"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// デーモンモード（-serve）
+// ========================================
+
+// defaultServeHistoryDir スケジュールごとの実行履歴の既定の保存先
+const defaultServeHistoryDir = ".gostandards-serve"
+
+// scheduleName スケジュール名（APIのパスと履歴ファイル名に使う）
+var scheduleName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// server 設定したスケジュールで定期的にチェックし、結果をHTTP APIで公開する
+type server struct {
+	cfg        *rules.Config
+	historyDir string
+	scans      []*scheduledScan
+	byName     map[string]*scheduledScan
+	wg         sync.WaitGroup // 実行中のチェック
+}
+
+// scheduledScan 1つのスケジュールの状態
+type scheduledScan struct {
+	name  string
+	path  string // 絶対パス
+	cron  string
+	sched schedule
+
+	mu       sync.Mutex
+	running  bool
+	lastRun  time.Time
+	duration time.Duration
+	lastErr  string
+	report   *report.Report // 直近のレポート（重要度フィルター適用後）
+	score    float64        // 直近のスコア（履歴と同じくフィルター適用前の違反から求める）
+}
+
+// scanStatus GET /api/schedules の1件
+type scanStatus struct {
+	Name       string     `json:"name"`
+	Path       string     `json:"path"`
+	Cron       string     `json:"cron"`
+	Running    bool       `json:"running"`
+	LastRun    *time.Time `json:"last_run,omitempty"`
+	DurationMs int64      `json:"duration_ms,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	Total      int        `json:"total_violations"`
+	Score      float64    `json:"score,omitempty"`
+}
+
+// newServer 設定のスケジュールを検証してserverを作成
+func newServer(cfg *rules.Config) (*server, error) {
+	s := &server{
+		cfg:        cfg,
+		historyDir: cfg.Settings.Serve.HistoryDir,
+		byName:     make(map[string]*scheduledScan),
+	}
+	if s.historyDir == "" {
+		s.historyDir = defaultServeHistoryDir
+	}
+	if len(cfg.Settings.Serve.Schedules) == 0 {
+		return nil, errors.New("settings.serve.schedules が設定されていません")
+	}
+
+	var errs []error
+	for i, sc := range cfg.Settings.Serve.Schedules {
+		key := fmt.Sprintf("settings.serve.schedules[%d]", i)
+		if _, dup := s.byName[sc.Name]; dup {
+			errs = append(errs, fmt.Errorf("%s.name: duplicate name %q", key, sc.Name))
+			continue
+		}
+		scan, err := newScheduledScan(key, sc)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		s.scans = append(s.scans, scan)
+		s.byName[sc.Name] = scan
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// 前回の起動時の最終実行時刻から再開する（@everyの間隔を保つ）
+	for _, scan := range s.scans {
+		entries, err := report.LoadHistory(s.historyPath(scan))
+		if err == nil && len(entries) > 0 {
+			scan.lastRun = entries[len(entries)-1].Timestamp
+		}
+	}
+	return s, os.MkdirAll(s.historyDir, 0755)
+}
+
+// newScheduledScan スケジュールの設定を検証する
+func newScheduledScan(key string, sc rules.ScheduleConfig) (*scheduledScan, error) {
+	if !scheduleName.MatchString(sc.Name) {
+		return nil, fmt.Errorf("%s.name: invalid name %q (英数字・-・_)", key, sc.Name)
+	}
+	sched, err := parseSchedule(sc.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("%s.cron: %w", key, err)
+	}
+	path, err := filepath.Abs(sc.Path)
+	if err != nil {
+		return nil, fmt.Errorf("%s.path: %w", key, err)
+	}
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("%s.path: ディレクトリが見つかりません: %s", key, path)
+	}
+	return &scheduledScan{name: sc.Name, path: path, cron: sc.Cron, sched: sched}, nil
+}
+
+// runServe デーモンモードで起動し、中断されるまでスケジュールに従ってチェックする
+func runServe(cfg *rules.Config, addr string) int {
+	s, err := newServer(cfg)
+	if err != nil {
+		fprintf(os.Stderr, "Error: デーモンモードの設定が不正です: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:         addr,
+		Handler:      s.handler(ctx),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.ListenAndServe() }()
+	fmt.Fprintf(os.Stderr, "🛰  Serving on %s (%d schedules, history: %s)\n", addr, len(s.scans), s.historyDir)
+
+	s.tick(ctx, time.Now())
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+loop:
+	for {
+		select {
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		case err := <-serveErr:
+			fprintf(os.Stderr, "Error: HTTPサーバーを起動できません: %v\n", err)
+			stop()
+			s.wg.Wait()
+			return 1
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	// 実行中のチェックは中断し、その結果は履歴に記録しない
+	fmt.Fprintln(os.Stderr, "Shutting down...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		fprintf(os.Stderr, "Warning: HTTPサーバーの停止に失敗しました: %v\n", err)
+	}
+	s.wg.Wait()
+	return 0
+}
+
+// tick 実行時刻になったスケジュールのチェックを開始する
+func (s *server) tick(ctx context.Context, now time.Time) {
+	for _, scan := range s.scans {
+		scan.mu.Lock()
+		due := !scan.running && scan.sched.due(now, scan.lastRun)
+		scan.mu.Unlock()
+		if due {
+			s.start(ctx, scan)
+		}
+	}
+}
+
+// start チェックをバックグラウンドで開始する（実行中の場合はfalse）
+func (s *server) start(ctx context.Context, scan *scheduledScan) bool {
+	scan.mu.Lock()
+	if scan.running {
+		scan.mu.Unlock()
+		return false
+	}
+	scan.running = true
+	scan.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(ctx, scan)
+	}()
+	return true
+}
+
+// run 1回分のチェックを実行し、結果を保持して履歴に追記する
+func (s *server) run(ctx context.Context, scan *scheduledScan) {
+	start := time.Now()
+	rep, err := checker.New(s.cfg).Run(ctx, scan.path)
+	if ctx.Err() != nil {
+		scan.mu.Lock()
+		scan.running = false
+		scan.mu.Unlock()
+		return
+	}
+
+	scan.mu.Lock()
+	defer scan.mu.Unlock()
+	scan.running = false
+	scan.lastRun = start
+	scan.duration = time.Since(start)
+	if err != nil {
+		scan.lastErr = err.Error()
+		fprintf(os.Stderr, "Warning: %s のチェックに失敗しました: %v\n", scan.name, err)
+		return
+	}
+	scan.lastErr = ""
+	scan.report = rep.Filter(rules.ParseSeverity(s.cfg.Settings.MinSeverity))
+	scan.score = rep.Score()
+
+	commit, _ := gitOutput(scan.path, "rev-parse", "HEAD")
+	if err := report.AppendHistory(s.historyPath(scan), rep.HistoryEntry(commit, start)); err != nil {
+		fprintf(os.Stderr, "Warning: %s の実行履歴の記録に失敗しました: %v\n", scan.name, err)
+	}
+	fmt.Fprintf(os.Stderr, "✅ %s: %d violations (score %.1f, %s)\n",
+		scan.name, scan.report.Summary.TotalViolations, scan.score, scan.duration.Round(time.Millisecond))
+}
+
+// historyPath スケジュールの履歴ファイル
+func (s *server) historyPath(scan *scheduledScan) string {
+	return filepath.Join(s.historyDir, scan.name+".json")
+}
+
+// status スケジュールの現在の状態
+func (scan *scheduledScan) status() scanStatus {
+	scan.mu.Lock()
+	defer scan.mu.Unlock()
+	st := scanStatus{
+		Name:       scan.name,
+		Path:       scan.path,
+		Cron:       scan.cron,
+		Running:    scan.running,
+		DurationMs: scan.duration.Milliseconds(),
+		Error:      scan.lastErr,
+	}
+	if !scan.lastRun.IsZero() {
+		st.LastRun = &scan.lastRun
+	}
+	if scan.report != nil {
+		st.Total = scan.report.Summary.TotalViolations
+		st.Score = scan.score
+	}
+	return st
+}
+
+// handler HTTP APIのルーティング（POSTで開始したチェックはctxの終了時に中断する）
+//
+//	GET  /api/schedules                 スケジュールの一覧と直近の結果
+//	GET  /api/schedules/{name}/report   直近のレポート（JSON）
+//	GET  /api/schedules/{name}/history  実行履歴
+//	POST /api/schedules/{name}/run      すぐにチェックを開始する
+func (s *server) handler(ctx context.Context) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/schedules", func(w http.ResponseWriter, r *http.Request) {
+		list := make([]scanStatus, 0, len(s.scans))
+		for _, scan := range s.scans {
+			list = append(list, scan.status())
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+	mux.HandleFunc("GET /api/schedules/{name}/report", s.withScan(func(w http.ResponseWriter, r *http.Request, scan *scheduledScan) {
+		scan.mu.Lock()
+		rep := scan.report
+		scan.mu.Unlock()
+		if rep == nil {
+			writeError(w, http.StatusNotFound, "まだチェックが完了していません")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := rep.WriteJSON(r.Context(), w); err != nil {
+			fprintf(os.Stderr, "Warning: レポートの送信に失敗しました: %v\n", err)
+		}
+	}))
+	mux.HandleFunc("GET /api/schedules/{name}/history", s.withScan(func(w http.ResponseWriter, r *http.Request, scan *scheduledScan) {
+		entries, err := report.LoadHistory(s.historyPath(scan))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if entries == nil {
+			entries = []report.HistoryEntry{}
+		}
+		writeJSON(w, http.StatusOK, entries)
+	}))
+	mux.HandleFunc("POST /api/schedules/{name}/run", s.withScan(func(w http.ResponseWriter, r *http.Request, scan *scheduledScan) {
+		// リクエストの終了後も続けるため、デーモンのcontextで実行する
+		if !s.start(ctx, scan) {
+			writeError(w, http.StatusConflict, "チェックを実行中です")
+			return
+		}
+		writeJSON(w, http.StatusAccepted, scan.status())
+	}))
+	return mux
+}
+
+// withScan パスの{name}のスケジュールを求める（見つからない場合は404）
+func (s *server) withScan(h func(http.ResponseWriter, *http.Request, *scheduledScan)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		scan, ok := s.byName[r.PathValue("name")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "スケジュールが見つかりません: "+r.PathValue("name"))
+			return
+		}
+		h(w, r, scan)
+	}
+}
+
+// writeJSON 値をJSONで返す
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		fprintf(os.Stderr, "Warning: レスポンスの送信に失敗しました: %v\n", err)
+	}
+}
+
+// writeError エラーをJSONで返す
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-standards-checker/rules"
+)
+
+// serveConfig targetをcronで定期的にチェックするデーモンモードの設定
+func serveConfig(t *testing.T, schedules ...rules.ScheduleConfig) *rules.Config {
+	t.Helper()
+	cfg := rules.DefaultConfig()
+	cfg.Settings.Serve.HistoryDir = filepath.Join(t.TempDir(), "history")
+	cfg.Settings.Serve.Schedules = schedules
+	return cfg
+}
+
+func TestNewServerValidation(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name      string
+		schedules []rules.ScheduleConfig
+		wantErr   string
+	}{
+		{name: "no schedules", wantErr: "settings.serve.schedules"},
+		{
+			name:      "invalid name",
+			schedules: []rules.ScheduleConfig{{Name: "my repo", Path: dir, Cron: "@hourly"}},
+			wantErr:   "schedules[0].name",
+		},
+		{
+			name:      "invalid cron",
+			schedules: []rules.ScheduleConfig{{Name: "repo", Path: dir, Cron: "61 * * * *"}},
+			wantErr:   "schedules[0].cron",
+		},
+		{
+			name:      "missing path",
+			schedules: []rules.ScheduleConfig{{Name: "repo", Path: filepath.Join(dir, "missing"), Cron: "@hourly"}},
+			wantErr:   "schedules[0].path",
+		},
+		{
+			name: "duplicate name",
+			schedules: []rules.ScheduleConfig{
+				{Name: "repo", Path: dir, Cron: "@hourly"},
+				{Name: "repo", Path: dir, Cron: "@daily"},
+			},
+			wantErr: "schedules[1].name",
+		},
+		{
+			name:      "valid",
+			schedules: []rules.ScheduleConfig{{Name: "repo", Path: dir, Cron: "*/5 * * * *"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newServer(serveConfig(t, tt.schedules...))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("newServer() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServeAPI(t *testing.T) {
+	target := t.TempDir()
+	if err := os.WriteFile(filepath.Join(target, "a.go"), []byte("package bad_pkg\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := newServer(serveConfig(t, rules.ScheduleConfig{Name: "repo", Path: target, Cron: "@daily"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := httptest.NewServer(s.handler(ctx))
+	defer ts.Close()
+
+	do := func(method, path string, wantStatus int, v any) {
+		t.Helper()
+		req, err := http.NewRequest(method, ts.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("%s %s status = %d, want %d", method, path, resp.StatusCode, wantStatus)
+		}
+		if v != nil {
+			if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+				t.Fatalf("%s %s: %v", method, path, err)
+			}
+		}
+	}
+
+	var list []scanStatus
+	do("GET", "/api/schedules", http.StatusOK, &list)
+	if len(list) != 1 || list[0].Name != "repo" || list[0].LastRun != nil {
+		t.Fatalf("schedules before run = %+v", list)
+	}
+	do("GET", "/api/schedules/repo/report", http.StatusNotFound, nil)
+	do("GET", "/api/schedules/missing/report", http.StatusNotFound, nil)
+	do("POST", "/api/schedules/missing/run", http.StatusNotFound, nil)
+	do("GET", "/api/schedules/repo/run", http.StatusMethodNotAllowed, nil)
+
+	do("POST", "/api/schedules/repo/run", http.StatusAccepted, nil)
+	s.wg.Wait()
+
+	do("GET", "/api/schedules", http.StatusOK, &list)
+	if list[0].LastRun == nil || list[0].Running || list[0].Error != "" || list[0].Total == 0 {
+		t.Errorf("schedules after run = %+v", list)
+	}
+	var rep struct {
+		Summary struct {
+			TotalViolations int `json:"total_violations"`
+		} `json:"summary"`
+	}
+	do("GET", "/api/schedules/repo/report", http.StatusOK, &rep)
+	if rep.Summary.TotalViolations != list[0].Total {
+		t.Errorf("report total = %d, want %d", rep.Summary.TotalViolations, list[0].Total)
+	}
+	var history []json.RawMessage
+	do("GET", "/api/schedules/repo/history", http.StatusOK, &history)
+	if len(history) != 1 {
+		t.Errorf("history entries = %d, want 1", len(history))
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-standards-checker/pkg/checker"
+)
+
+// stdinOptions 標準入力の内容をfilenameのファイルとしてチェックするオプション（-stdin）
+//
+// エディタの未保存のバッファをチェックするため、ディスク上の内容の代わりに読み込んだ内容を使う。
+// 戻り値のパスはfilenameの絶対パスで、ターゲットディレクトリ配下のGoファイルでなければエラーにする
+func stdinOptions(r io.Reader, filename, targetDir string) (string, []checker.Option, error) {
+	if filename == "" {
+		return "", nil, errors.New("-stdin には -stdin-filename でファイルのパスを指定してください")
+	}
+	if !strings.HasSuffix(filename, ".go") {
+		return "", nil, fmt.Errorf("-stdin-filename にはGoファイルを指定してください: %s", filename)
+	}
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return "", nil, fmt.Errorf("-stdin-filename の解決に失敗しました: %w", err)
+	}
+	if rel, err := filepath.Rel(targetDir, path); err != nil || strings.HasPrefix(rel, "..") {
+		return "", nil, fmt.Errorf("-stdin-filename はターゲットディレクトリ（%s）配下のファイルを指定してください: %s", targetDir, filename)
+	}
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return "", nil, fmt.Errorf("標準入力の読み込みに失敗しました: %w", err)
+	}
+	return path, []checker.Option{checker.WithFiles(path), checker.WithOverlay(map[string][]byte{path: src})}, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/rules"
+)
+
+// ========================================
+// 設定ファイルのテンプレート（-init）
+// ========================================
+
+// templatePreset ルールの設定を取り出すプリセット（推奨設定）
+const templatePreset = "standard"
+
+// configTemplate 設定ファイルのテンプレート
+// ルールの設定は登録済みのルールのカテゴリ順に、standardプリセットの項目をコメントごと取り出す
+// （ルールを追加した場合もプリセットに設定を追加すればテンプレートに含まれる）
+func configTemplate() string {
+	var sb strings.Builder
+	sb.WriteString(templateHeader)
+	seen := make(map[string]bool)
+	for _, rule := range checker.Rules() {
+		category := rule.Category()
+		if seen[category] || category == "custom" {
+			continue
+		}
+		seen[category] = true
+		// Registerで追加した独自のカテゴリのルールは rule_settings で設定する
+		if section, ok := rules.PresetSection(templatePreset, category); ok {
+			sb.WriteString(section + "\n")
+		}
+	}
+	sb.WriteString(templateFooter)
+	return sb.String()
+}
+
+// templateHeader テンプレートの基本設定
+const templateHeader = `# Go Standards Checker 設定ファイル
+# このファイルをプロジェクトルートに配置してください
+
+# 継承元の設定（strict / standard / relaxed・ファイルパス・URL）。指定した項目のみを上書きする
+# extends: "standard"
+
+# ========================================
+# 基本設定
+# ========================================
+settings:
+  # 除外パターン
+  exclude_patterns:
+    - "*_test.go"      # テストファイル
+    - "vendor/*"       # vendorディレクトリ
+    - ".git/*"         # gitディレクトリ
+    - "*.pb.go"        # Protocol Buffers生成ファイル
+  # レポート形式: text, json, html, junit, checkstyle, github, rdjson, gitlab, line
+  report_format: "text"
+  # 最小重要度: error, warning, info
+  min_severity: "info"
+  fail_on: "error"
+  # ディレクトリの走査
+  follow_symlinks: false   # ルート外を指すシンボリックリンクのディレクトリを辿る
+  skip_hidden_dirs: true   # "." で始まるディレクトリを走査しない
+  skip_testdata: true      # testdataディレクトリを走査しない
+  skip_generated: true     # 自動生成ファイル（"// Code generated ... DO NOT EDIT."）をチェックしない
+  owners: ""               # 違反の担当者の割り当て（codeowners / blame）
+  baseline: ""             # ベースラインファイル（-baseline write で作成、記録済みの違反を報告しない）
+  cache_dir: ""            # 解析結果のキャッシュディレクトリ（空の場合は ~/.cache/go-standards-checker）
+  report_unused_suppressions: false # 違反を抑制しなかった //standards:ignore を報告する
+  language: "ja"           # 違反のメッセージ・レポートの言語（ja / en）
+  # チェック後にレポートをアップロード（s3://・gs://・https://）
+  upload:
+    enabled: false
+    url: "s3://my-bucket/{{.Repo}}/{{.Branch}}/{{.Commit}}.json"
+    format: "json"
+  # デーモンモード（-serve）で定期的にチェックする対象
+  serve:
+    history_dir: ".gostandards-serve"
+    schedules: []
+    #  - name: "api"
+    #    path: "/srv/repos/api"
+    #    cron: "0 3 * * *"        # 分 時 日 月 曜日（@daily・@every 30m 等も可）
+
+`
+
+// templateFooter テンプレートのカスタムルール・プロジェクト固有ルール・パスごとの上書き
+const templateFooter = `# ========================================
+# カスタムルール（正規表現ベース）
+# ========================================
+# 例: time.Sleepの使用警告
+# - name: "no_time_sleep_in_production"
+#   enabled: true
+#   severity: "warning"
+#   pattern: 'time\.Sleep\('
+#   message: "time.Sleepの使用は避けてください"
+#   code_only: true
+custom_rules: []
+
+# ========================================
+# プロジェクト固有ルール
+# ========================================
+# ここに独自ルールを追加してください（type: forbidden_import / restricted_import / forbidden_call / forbidden_identifier / required_call_in）
+# - name: "no_pkg_errors"
+#   enabled: true
+#   severity: "error"
+#   type: "forbidden_import"
+#   packages: ["github.com/pkg/errors", "io/ioutil"]
+#   message: "非推奨パッケージを使用しないでください"
+# - name: "db_driver_in_repository"
+#   enabled: true
+#   severity: "warning"
+#   type: "restricted_import"
+#   packages: ["database/sql"]
+#   allowed_in: ["internal/repository/**"]
+# - name: "no_sleep"
+#   enabled: true
+#   severity: "warning"
+#   type: "forbidden_call"
+#   functions: ["time.Sleep", "os.Exit"]
+#   allowed_in: ["cmd/**"]
+project_rules: []
+
+# ========================================
+# パスごとの設定の上書き
+# ========================================
+# 一致するファイルのルール設定を上書きしてください（キーはルール名またはカテゴリ名）
+# - paths: ["internal/generated/**"]
+#   rules:
+#     max_function_lines: {enabled: false}
+# - paths: ["internal/handler/**"]
+#   rules:
+#     no_fmt_println: {severity: "error"}
+overrides: []
+`
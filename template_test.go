@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-standards-checker/pkg/checker"
+	"github.com/go-standards-checker/rules"
+)
+
+func TestConfigTemplate(t *testing.T) {
+	template := configTemplate()
+	path := filepath.Join(t.TempDir(), "go-standards.yaml")
+	if err := os.WriteFile(path, []byte(template), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rules.LoadConfig(path); err != nil {
+		t.Fatalf("generated template does not load: %v", err)
+	}
+
+	// カテゴリ → rules → ルール名
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(template), &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, rule := range checker.Rules() {
+		if rule.Category() == "custom" {
+			continue
+		}
+		section, _ := doc[rule.Category()].(map[string]any)
+		ruleSettings, _ := section["rules"].(map[string]any)
+		if _, ok := ruleSettings[rule.Name()]; !ok {
+			t.Errorf("rule %s.%s is missing from the template", rule.Category(), rule.Name())
+		}
+	}
+}
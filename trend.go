@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-standards-checker/report"
+)
+
+// runTrend trend サブコマンド（-history で記録した実行履歴の推移を表示）
+func runTrend(args []string) int {
+	fs := flag.NewFlagSet("trend", flag.ExitOnError)
+	historyPath := fs.String("history", report.DefaultHistoryFile, "実行履歴ファイルのパス")
+	limit := fs.Int("n", 20, "表示する直近の件数（0の場合はすべて）")
+	outputJSON := fs.Bool("json", false, "JSON形式で出力")
+	lang := fs.String("lang", report.LanguageJapanese, "出力言語 ("+strings.Join(report.Languages(), ", ")+")")
+	fs.Parse(args)
+	cliLang = *lang
+
+	entries, err := report.LoadHistory(*historyPath)
+	if err != nil {
+		fprintf(os.Stderr, "Error: 実行履歴の読み込みに失敗しました: %v\n", err)
+		return 1
+	}
+
+	if *outputJSON {
+		if *limit > 0 && len(entries) > *limit {
+			entries = entries[len(entries)-*limit:]
+		}
+		if entries == nil {
+			entries = []report.HistoryEntry{}
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			fprintf(os.Stderr, "Error: JSON出力に失敗しました: %v\n", err)
+			return 1
+		}
+		fmt.Println(string(data))
+		return 0
+	}
+	fmt.Print(report.TrendText(entries, *limit, *lang))
+	return 0
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-standards-checker/report"
+)
+
+// uploadMeta アップロード先のキーに使うリポジトリ・ブランチ・コミット
+// CI（GitHub Actions・GitLab CI）の環境変数を優先し、無ければgitから求める
+func uploadMeta(dir string, at time.Time) report.UploadMeta {
+	meta := report.UploadMeta{
+		Repo:      firstEnv("GITHUB_REPOSITORY", "CI_PROJECT_PATH"),
+		Branch:    firstEnv("GITHUB_HEAD_REF", "GITHUB_REF_NAME", "CI_COMMIT_REF_NAME"),
+		Commit:    firstEnv("GITHUB_SHA", "CI_COMMIT_SHA"),
+		Timestamp: at,
+	}
+	if meta.Repo == "" {
+		if top, err := gitOutput(dir, "rev-parse", "--show-toplevel"); err == nil {
+			meta.Repo = filepath.Base(top)
+		} else {
+			meta.Repo = filepath.Base(dir)
+		}
+	}
+	if meta.Branch == "" {
+		meta.Branch, _ = gitOutput(dir, "rev-parse", "--abbrev-ref", "HEAD")
+	}
+	if meta.Commit == "" {
+		meta.Commit, _ = gitOutput(dir, "rev-parse", "HEAD")
+	}
+	return meta
+}
+
+// firstEnv 最初に設定されている環境変数の値
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
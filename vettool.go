@@ -0,0 +1,29 @@
+package main
+
+import (
+	"strings"
+
+	"golang.org/x/tools/go/analysis/unitchecker"
+
+	"github.com/go-standards-checker/analyzers"
+)
+
+// ========================================
+// go vet -vettool からの呼び出し
+// ========================================
+//
+// go vet -vettool=$(which go-standards-checker) ./... で、パッケージごとにチェッカーを呼び出す
+// goコマンドとのやり取りは unitchecker に任せ、ルールは analyzers パッケージのAnalyzerとして実行する
+
+// isVetToolInvocation go vet -vettool からの呼び出しか
+func isVetToolInvocation(args []string) bool {
+	if len(args) == 1 && (args[0] == "-V=full" || args[0] == "-flags") {
+		return true
+	}
+	return len(args) > 0 && strings.HasSuffix(args[len(args)-1], ".cfg")
+}
+
+// runVetTool go vet -vettool からの呼び出しを処理する（終了コードを返さずに終了する）
+func runVetTool() {
+	unitchecker.Main(analyzers.All()...)
+}